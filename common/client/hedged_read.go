@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+var (
+	promMultiNodeHedgedReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_hedged_reads",
+		Help: "The number of reads that were also sent to a second node, because the active node did not respond in time",
+	}, []string{"network", "chainId"})
+	promMultiNodeHedgedReadsWon = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_hedged_reads_won",
+		Help: "The number of hedged reads that were served by the second node before the active node responded",
+	}, []string{"network", "chainId"})
+)
+
+type hedgedResponse[RESULT any] struct {
+	result RESULT
+	err    error
+	hedged bool
+}
+
+// DoHedged calls do with the RPC of the active node. If hedgeDelay is positive and that call has not completed
+// within hedgeDelay, do is called again with the RPC of another alive node. The first successful result is returned
+// and the call still in flight is canceled. If every call fails, the error from the active node is returned.
+// A call to the active node failing before hedgeDelay elapses is not retried, so errors returned by the chain
+// are not masked. do must be idempotent.
+func DoHedged[
+	CHAIN_ID types.ID,
+	RPC any,
+	RESULT any,
+](ctx context.Context, c *MultiNode[CHAIN_ID, RPC], hedgeDelay time.Duration, do func(ctx context.Context, rpc RPC) (RESULT, error)) (result RESULT, err error) {
+	primary, err := c.selectNode()
+	if err != nil {
+		return result, err
+	}
+	if hedgeDelay <= 0 {
+		return do(ctx, primary.RPC())
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered, so that the call that loses the race does not block after we have returned
+	responses := make(chan hedgedResponse[RESULT], 2)
+	call := func(rpc RPC, hedged bool) {
+		r, err := do(ctx, rpc)
+		responses <- hedgedResponse[RESULT]{result: r, err: err, hedged: hedged}
+	}
+	go call(primary.RPC(), false)
+	inFlight := 1
+
+	timer := time.NewTimer(hedgeDelay)
+	defer timer.Stop()
+	var primaryErr, hedgeErr error
+	for {
+		select {
+		case <-timer.C:
+			hedge := c.selectHedgeNode(primary)
+			if hedge == nil {
+				continue // no other node to hedge with, keep waiting for the active one
+			}
+			c.lggr.Debugw("Active node did not respond in time, hedging read", "node", primary.String(), "hedgeNode", hedge.String(), "hedgeDelay", hedgeDelay)
+			promMultiNodeHedgedReads.WithLabelValues(c.chainFamily, c.chainID.String()).Inc()
+			go call(hedge.RPC(), true)
+			inFlight++
+		case resp := <-responses:
+			inFlight--
+			if resp.err == nil {
+				if resp.hedged {
+					promMultiNodeHedgedReadsWon.WithLabelValues(c.chainFamily, c.chainID.String()).Inc()
+				}
+				return resp.result, nil
+			}
+			if resp.hedged {
+				hedgeErr = resp.err
+			} else {
+				primaryErr = resp.err
+			}
+			if inFlight > 0 {
+				continue
+			}
+			if primaryErr != nil {
+				return result, primaryErr
+			}
+			return result, hedgeErr
+		}
+	}
+}
+
+// selectHedgeNode returns the alive node, other than exclude, to send a hedged read to. It prefers the node with the
+// best latency score, and falls back to priority order if no node has been scored yet. Returns nil if there is none.
+func (c *MultiNode[CHAIN_ID, RPC]) selectHedgeNode(exclude Node[CHAIN_ID, RPC]) Node[CHAIN_ID, RPC] {
+	var candidates []Node[CHAIN_ID, RPC]
+	var best Node[CHAIN_ID, RPC]
+	var bestScore time.Duration
+	for _, n := range c.primaryNodes {
+		if n == exclude || n.State() != nodeStateAlive {
+			continue
+		}
+		candidates = append(candidates, n)
+		if score, ok := n.LatencyScore(); ok && (best == nil || score < bestScore) {
+			best, bestScore = n, score
+		}
+	}
+	if best != nil {
+		return best
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return firstOrHighestPriority(candidates)
+}
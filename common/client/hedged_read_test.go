@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestDoHedged(t *testing.T) {
+	t.Parallel()
+
+	type hedgeTestNode struct {
+		node *mockNode[types.ID, multiNodeRPCClient]
+		rpc  multiNodeRPCClient
+	}
+	newHedgeTestNode := func(t *testing.T, name string, state nodeState, order int32) hedgeTestNode {
+		rpc := newMockRPCClient[types.ID, types.Head[Hashable]](t)
+		node := newMockNode[types.ID, multiNodeRPCClient](t)
+		node.On("String").Return(name).Maybe()
+		node.On("State").Return(state).Maybe()
+		node.On("RPC").Return(rpc).Maybe()
+		node.On("Order").Return(order).Maybe()
+		node.On("LatencyScore").Return(time.Duration(0), false).Maybe()
+		return hedgeTestNode{node: node, rpc: rpc}
+	}
+	newHedgedMultiNode := func(t *testing.T, nodes ...hedgeTestNode) testMultiNode {
+		var primaries []Node[types.ID, multiNodeRPCClient]
+		for _, n := range nodes {
+			primaries = append(primaries, n.node)
+		}
+		return newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModePriorityLevel,
+			chainID:       types.RandomID(),
+			nodes:         primaries,
+		})
+	}
+	// respond returns a call that answers with the name of the node serving it, after the given delay for each node.
+	respond := func(delays map[multiNodeRPCClient]time.Duration, errs map[multiNodeRPCClient]error, names map[multiNodeRPCClient]string) func(ctx context.Context, rpc multiNodeRPCClient) (string, error) {
+		return func(ctx context.Context, rpc multiNodeRPCClient) (string, error) {
+			select {
+			case <-time.After(delays[rpc]):
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+			if err := errs[rpc]; err != nil {
+				return "", err
+			}
+			return names[rpc], nil
+		}
+	}
+
+	t.Run("does not hedge when disabled", func(t *testing.T) {
+		t.Parallel()
+		primary := newHedgeTestNode(t, "primary", nodeStateAlive, 1)
+		other := newHedgeTestNode(t, "other", nodeStateAlive, 2)
+		mn := newHedgedMultiNode(t, primary, other)
+		var calls int
+		result, err := DoHedged(tests.Context(t), mn.MultiNode, 0, func(ctx context.Context, rpc multiNodeRPCClient) (string, error) {
+			calls++
+			assert.Equal(t, primary.rpc, rpc)
+			return "primary", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "primary", result)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("returns the response of the active node if it is fast enough", func(t *testing.T) {
+		t.Parallel()
+		primary := newHedgeTestNode(t, "primary", nodeStateAlive, 1)
+		other := newHedgeTestNode(t, "other", nodeStateAlive, 2)
+		mn := newHedgedMultiNode(t, primary, other)
+		do := respond(
+			map[multiNodeRPCClient]time.Duration{primary.rpc: 0, other.rpc: 0},
+			nil,
+			map[multiNodeRPCClient]string{primary.rpc: "primary", other.rpc: "other"},
+		)
+		result, err := DoHedged(tests.Context(t), mn.MultiNode, tests.WaitTimeout(t), do)
+		require.NoError(t, err)
+		assert.Equal(t, "primary", result)
+	})
+
+	t.Run("returns the response of another node if the active one is slow", func(t *testing.T) {
+		t.Parallel()
+		primary := newHedgeTestNode(t, "primary", nodeStateAlive, 1)
+		other := newHedgeTestNode(t, "other", nodeStateAlive, 2)
+		mn := newHedgedMultiNode(t, primary, other)
+		primaryCanceled := make(chan struct{})
+		result, err := DoHedged(tests.Context(t), mn.MultiNode, 10*time.Millisecond, func(ctx context.Context, rpc multiNodeRPCClient) (string, error) {
+			if rpc == other.rpc {
+				return "other", nil
+			}
+			<-ctx.Done()
+			close(primaryCanceled)
+			return "", ctx.Err()
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "other", result)
+		select {
+		case <-primaryCanceled:
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatal("call to the slow node was not canceled")
+		}
+	})
+
+	t.Run("skips nodes that are not alive", func(t *testing.T) {
+		t.Parallel()
+		primary := newHedgeTestNode(t, "primary", nodeStateAlive, 1)
+		unreachable := newHedgeTestNode(t, "unreachable", nodeStateUnreachable, 2)
+		other := newHedgeTestNode(t, "other", nodeStateAlive, 3)
+		mn := newHedgedMultiNode(t, primary, unreachable, other)
+		do := respond(
+			map[multiNodeRPCClient]time.Duration{primary.rpc: tests.WaitTimeout(t)},
+			nil,
+			map[multiNodeRPCClient]string{primary.rpc: "primary", other.rpc: "other"},
+		)
+		result, err := DoHedged(tests.Context(t), mn.MultiNode, 10*time.Millisecond, do)
+		require.NoError(t, err)
+		assert.Equal(t, "other", result)
+	})
+
+	t.Run("waits for the active node without another alive node", func(t *testing.T) {
+		t.Parallel()
+		primary := newHedgeTestNode(t, "primary", nodeStateAlive, 1)
+		outOfSync := newHedgeTestNode(t, "outOfSync", nodeStateOutOfSync, 2)
+		mn := newHedgedMultiNode(t, primary, outOfSync)
+		do := respond(
+			map[multiNodeRPCClient]time.Duration{primary.rpc: 50 * time.Millisecond},
+			nil,
+			map[multiNodeRPCClient]string{primary.rpc: "primary"},
+		)
+		result, err := DoHedged(tests.Context(t), mn.MultiNode, 10*time.Millisecond, do)
+		require.NoError(t, err)
+		assert.Equal(t, "primary", result)
+	})
+
+	t.Run("does not hedge errors returned before the delay", func(t *testing.T) {
+		t.Parallel()
+		primary := newHedgeTestNode(t, "primary", nodeStateAlive, 1)
+		other := newHedgeTestNode(t, "other", nodeStateAlive, 2)
+		mn := newHedgedMultiNode(t, primary, other)
+		expectedErr := errors.New("execution reverted")
+		_, err := DoHedged(tests.Context(t), mn.MultiNode, tests.WaitTimeout(t), func(ctx context.Context, rpc multiNodeRPCClient) (string, error) {
+			assert.Equal(t, primary.rpc, rpc)
+			return "", expectedErr
+		})
+		require.ErrorIs(t, err, expectedErr)
+	})
+
+	t.Run("waits for the other call if the first response is an error", func(t *testing.T) {
+		t.Parallel()
+		primary := newHedgeTestNode(t, "primary", nodeStateAlive, 1)
+		other := newHedgeTestNode(t, "other", nodeStateAlive, 2)
+		mn := newHedgedMultiNode(t, primary, other)
+		do := respond(
+			map[multiNodeRPCClient]time.Duration{primary.rpc: 100 * time.Millisecond, other.rpc: 0},
+			map[multiNodeRPCClient]error{other.rpc: errors.New("connection reset")},
+			map[multiNodeRPCClient]string{primary.rpc: "primary"},
+		)
+		result, err := DoHedged(tests.Context(t), mn.MultiNode, 10*time.Millisecond, do)
+		require.NoError(t, err)
+		assert.Equal(t, "primary", result)
+	})
+
+	t.Run("returns the error of the active node if every call fails", func(t *testing.T) {
+		t.Parallel()
+		primary := newHedgeTestNode(t, "primary", nodeStateAlive, 1)
+		other := newHedgeTestNode(t, "other", nodeStateAlive, 2)
+		mn := newHedgedMultiNode(t, primary, other)
+		primaryErr := errors.New("primary failed")
+		do := respond(
+			map[multiNodeRPCClient]time.Duration{primary.rpc: 50 * time.Millisecond, other.rpc: 0},
+			map[multiNodeRPCClient]error{primary.rpc: primaryErr, other.rpc: errors.New("other failed")},
+			nil,
+		)
+		_, err := DoHedged(tests.Context(t), mn.MultiNode, 10*time.Millisecond, do)
+		require.ErrorIs(t, err, primaryErr)
+	})
+}
+
+func TestMultiNode_selectHedgeNode(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(t *testing.T, state nodeState, order int32, score time.Duration) *mockNode[types.ID, multiNodeRPCClient] {
+		node := newMockNode[types.ID, multiNodeRPCClient](t)
+		node.On("State").Return(state).Maybe()
+		node.On("Order").Return(order).Maybe()
+		node.On("LatencyScore").Return(score, score > 0).Maybe()
+		return node
+	}
+
+	t.Run("prefers the node with the best latency score", func(t *testing.T) {
+		t.Parallel()
+		primary := newNode(t, nodeStateAlive, 1, 10*time.Millisecond)
+		slow := newNode(t, nodeStateAlive, 2, 300*time.Millisecond)
+		fast := newNode(t, nodeStateAlive, 3, 50*time.Millisecond)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModePriorityLevel,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, multiNodeRPCClient]{primary, slow, fast},
+		})
+		assert.Same(t, fast, mn.selectHedgeNode(primary))
+	})
+	t.Run("falls back to priority order without scores", func(t *testing.T) {
+		t.Parallel()
+		primary := newNode(t, nodeStateAlive, 1, 0)
+		low := newNode(t, nodeStateAlive, 3, 0)
+		high := newNode(t, nodeStateAlive, 2, 0)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModePriorityLevel,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, multiNodeRPCClient]{primary, low, high},
+		})
+		assert.Same(t, high, mn.selectHedgeNode(primary))
+	})
+	t.Run("returns nil without another alive node", func(t *testing.T) {
+		t.Parallel()
+		primary := newNode(t, nodeStateAlive, 1, 0)
+		dead := newNode(t, nodeStateUnreachable, 2, 0)
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModePriorityLevel,
+			chainID:       types.RandomID(),
+			nodes:         []Node[types.ID, multiNodeRPCClient]{primary, dead},
+		})
+		assert.Nil(t, mn.selectHedgeNode(primary))
+	})
+}
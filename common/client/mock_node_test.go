@@ -4,9 +4,11 @@ package client
 
 import (
 	context "context"
+	time "time"
 
-	types "github.com/smartcontractkit/chainlink/v2/common/types"
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/smartcontractkit/chainlink/v2/common/types"
 )
 
 // mockNode is an autogenerated mock type for the Node type
@@ -157,6 +159,61 @@ func (_c *mockNode_HighestUserObservations_Call[CHAIN_ID, RPC]) RunAndReturn(run
 	return _c
 }
 
+// LatencyScore provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, RPC]) LatencyScore() (time.Duration, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatencyScore")
+	}
+
+	var r0 time.Duration
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (time.Duration, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// mockNode_LatencyScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatencyScore'
+type mockNode_LatencyScore_Call[CHAIN_ID types.ID, RPC interface{}] struct {
+	*mock.Call
+}
+
+// LatencyScore is a helper method to define mock.On call
+func (_e *mockNode_Expecter[CHAIN_ID, RPC]) LatencyScore() *mockNode_LatencyScore_Call[CHAIN_ID, RPC] {
+	return &mockNode_LatencyScore_Call[CHAIN_ID, RPC]{Call: _e.mock.On("LatencyScore")}
+}
+
+func (_c *mockNode_LatencyScore_Call[CHAIN_ID, RPC]) Run(run func()) *mockNode_LatencyScore_Call[CHAIN_ID, RPC] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockNode_LatencyScore_Call[CHAIN_ID, RPC]) Return(score time.Duration, ok bool) *mockNode_LatencyScore_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(score, ok)
+	return _c
+}
+
+func (_c *mockNode_LatencyScore_Call[CHAIN_ID, RPC]) RunAndReturn(run func() (time.Duration, bool)) *mockNode_LatencyScore_Call[CHAIN_ID, RPC] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *mockNode[CHAIN_ID, RPC]) Name() string {
 	ret := _m.Called()
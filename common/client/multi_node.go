@@ -213,7 +213,16 @@ func (c *MultiNode[CHAIN_ID, RPC]) selectNode() (node Node[CHAIN_ID, RPC], err e
 	node = c.activeNode
 	c.activeMu.RUnlock()
 	if node != nil && node.State() == nodeStateAlive {
-		return // still alive
+		if c.selectionMode != NodeSelectionModeLowestLatency {
+			return // still alive
+		}
+		// Latency changes all the time, so the active node is re-evaluated on every call. The selector is sticky,
+		// so this only switches nodes when another one has been clearly faster for a while.
+		if best := c.nodeSelector.Select(); best != nil && best != node {
+			c.switchActiveNode(node, best)
+			return best, nil
+		}
+		return // still alive and still the best
 	}
 
 	// select a new one
@@ -239,6 +248,19 @@ func (c *MultiNode[CHAIN_ID, RPC]) selectNode() (node Node[CHAIN_ID, RPC], err e
 	return c.activeNode, err
 }
 
+// switchActiveNode makes next the active node, unless the active node is no longer current. Since current is still
+// alive, its subscriptions are kept rather than torn down on every latency-only switch. They move to the new active
+// node once current leaves the alive state, or on the next lease check if LeaseDuration is set.
+func (c *MultiNode[CHAIN_ID, RPC]) switchActiveNode(current, next Node[CHAIN_ID, RPC]) {
+	c.activeMu.Lock()
+	defer c.activeMu.Unlock()
+	if c.activeNode != current {
+		return // another goroutine beat us here
+	}
+	c.lggr.Infof("Switching to lowest latency node from %q to %q", current.String(), next.String())
+	c.activeNode = next
+}
+
 // LatestChainInfo - returns number of live nodes available in the pool, so we can prevent the last alive node in a pool from being marked as out-of-sync.
 // Return highest ChainInfo most recently received by the alive nodes.
 // E.g. If Node A's the most recent block is 10 and highest 15 and for Node B it's - 12 and 14. This method will return 12.
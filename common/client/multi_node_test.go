@@ -389,6 +389,35 @@ func TestMultiNode_selectNode(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, newBest.String(), newActiveNode.String())
 	})
+	t.Run("Re-evaluates healthy active node in LowestLatency mode", func(t *testing.T) {
+		t.Parallel()
+		chainID := types.RandomID()
+		oldBest := newMockNode[types.ID, multiNodeRPCClient](t)
+		oldBest.On("String").Return("oldBest").Maybe()
+		oldBest.On("State").Return(nodeStateAlive)
+		newBest := newMockNode[types.ID, multiNodeRPCClient](t)
+		newBest.On("String").Return("newBest").Maybe()
+		mn := newTestMultiNode(t, multiNodeOpts{
+			selectionMode: NodeSelectionModeLowestLatency,
+			chainID:       chainID,
+			nodes:         []Node[types.ID, multiNodeRPCClient]{oldBest, newBest},
+		})
+		nodeSelector := newMockNodeSelector[types.ID, multiNodeRPCClient](t)
+		nodeSelector.On("Select").Return(oldBest).Twice()
+		mn.nodeSelector = nodeSelector
+		activeNode, err := mn.selectNode()
+		require.NoError(t, err)
+		require.Equal(t, oldBest.String(), activeNode.String())
+		activeNode, err = mn.selectNode()
+		require.NoError(t, err)
+		require.Equal(t, oldBest.String(), activeNode.String())
+		// another node became faster, so we should switch to it even though the active node is healthy, and keep the
+		// subscriptions of the previous node since it is still alive
+		nodeSelector.On("Select").Return(newBest).Once()
+		newActiveNode, err := mn.selectNode()
+		require.NoError(t, err)
+		require.Equal(t, newBest.String(), newActiveNode.String())
+	})
 	t.Run("No active nodes - reports critical error", func(t *testing.T) {
 		t.Parallel()
 		chainID := types.RandomID()
@@ -79,6 +79,9 @@ type Node[
 	ConfiguredChainID() CHAIN_ID
 	// Order - returns priority order configured for the RPC
 	Order() int32
+	// LatencyScore - returns the rolling latency of the RPC, inflated by its error rate. Lower is better. ok is false
+	// if no requests have been timed yet.
+	LatencyScore() (score time.Duration, ok bool)
 	// Start - starts health checks
 	Start(context.Context) error
 	Close() error
@@ -114,6 +117,10 @@ type node[
 	wg sync.WaitGroup
 
 	healthCheckSubs []types.Subscription
+
+	latency latencyScore
+	// rpcReportsTimings is true if the RPC reports the timings of all of its requests, including polls.
+	rpcReportsTimings bool
 }
 
 func NewNode[
@@ -158,6 +165,10 @@ func NewNode[
 	n.lfcLog = logger.Named(lggr, "Lifecycle")
 	n.rpc = rpc
 	n.chainFamily = chainFamily
+	if r, ok := any(rpc).(requestTimingReporter); ok {
+		r.SetRequestObserver(n.latency.recordRequest)
+		n.rpcReportsTimings = true
+	}
 	return n
 }
 
@@ -184,6 +195,10 @@ func (n *node[CHAIN_ID, HEAD, RPC]) RPC() RPC {
 	return n.rpc
 }
 
+func (n *node[CHAIN_ID, HEAD, RPC]) LatencyScore() (time.Duration, bool) {
+	return n.latency.score()
+}
+
 // unsubscribeAllExceptAliveLoop is not thread-safe; it should only be called
 // while holding the stateMu lock.
 func (n *node[CHAIN_ID, HEAD, RPC]) unsubscribeAllExceptAliveLoop() {
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// latencyScoreDecay is the weight of the newest sample in the rolling averages of a latencyScore.
+	latencyScoreDecay = 0.1
+	// latencyScoreErrorPenalty is how much the error rate inflates the latency of a node. A node failing every request
+	// scores like one that is 11 times slower.
+	latencyScoreErrorPenalty = 10
+)
+
+// requestTimingReporter is implemented by RPC clients that report the timings of the requests they serve, so that
+// real traffic, and not only polling, counts towards the latency score of their node.
+type requestTimingReporter interface {
+	// SetRequestObserver sets a function to be called after every request, including polls. light is true for
+	// requests whose duration does not depend on their payload, like fetching the latest block number, so that their
+	// latency can be compared with the polls of the other nodes. Only the errors of the other requests are scored.
+	// err must only be set for failures that are attributable to the RPC, like timeouts or connection errors, and not
+	// for errors returned by the chain.
+	SetRequestObserver(func(duration time.Duration, light bool, err error))
+}
+
+// latencyScore keeps rolling averages of how fast and how reliably a node serves requests. The latency only counts
+// polls and light requests, which every node serves alike, while the error rate counts all requests.
+type latencyScore struct {
+	mu           sync.RWMutex
+	latency      float64
+	errorRate    float64
+	samples      uint64
+	errorSamples uint64
+}
+
+// record records a poll or a light request.
+func (s *latencyScore) record(duration time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.samples == 0 {
+		s.latency = float64(duration)
+	} else {
+		s.latency += latencyScoreDecay * (float64(duration) - s.latency)
+	}
+	s.samples++
+	s.recordError(err)
+}
+
+// recordRequest records a request reported by the RPC, see requestTimingReporter.
+func (s *latencyScore) recordRequest(duration time.Duration, light bool, err error) {
+	if light {
+		s.record(duration, err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recordError(err)
+}
+
+// recordError must be called with mu held.
+func (s *latencyScore) recordError(err error) {
+	var failed float64
+	if err != nil {
+		failed = 1
+	}
+	if s.errorSamples == 0 {
+		s.errorRate = failed
+	} else {
+		s.errorRate += latencyScoreDecay * (failed - s.errorRate)
+	}
+	s.errorSamples++
+}
+
+// score returns the rolling latency, inflated by the rolling error rate. Lower is better. ok is false if no latency
+// has been recorded yet.
+func (s *latencyScore) score() (score time.Duration, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.samples == 0 {
+		return 0, false
+	}
+	return time.Duration(s.latency * (1 + latencyScoreErrorPenalty*s.errorRate)), true
+}
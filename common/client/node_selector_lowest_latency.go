@@ -0,0 +1,83 @@
+package client
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	// lowestLatencySwitchMargin is how much better another node must score before the selector switches to it.
+	lowestLatencySwitchMargin = 0.25
+	// lowestLatencyMinDwell is how long the selector sticks to a node before it considers switching to a better one.
+	lowestLatencyMinDwell = 30 * time.Second
+)
+
+type lowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+] struct {
+	nodes []Node[CHAIN_ID, RPC]
+
+	mu         sync.Mutex
+	current    Node[CHAIN_ID, RPC]
+	selectedAt time.Time
+}
+
+func NewLowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+](nodes []Node[CHAIN_ID, RPC]) NodeSelector[CHAIN_ID, RPC] {
+	return &lowestLatencyNodeSelector[CHAIN_ID, RPC]{
+		nodes: nodes,
+	}
+}
+
+// Select returns the alive node with the best latency score. To avoid flapping between nodes with similar scores,
+// it sticks to the previously selected node for a while, and only switches away from it to a node that scores
+// clearly better. Nodes without a score yet are only selected if no node has one, in order of priority.
+func (s *lowestLatencyNodeSelector[CHAIN_ID, RPC]) Select() Node[CHAIN_ID, RPC] {
+	var aliveNodes []Node[CHAIN_ID, RPC]
+	var best Node[CHAIN_ID, RPC]
+	bestScore := time.Duration(math.MaxInt64)
+	for _, n := range s.nodes {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		aliveNodes = append(aliveNodes, n)
+		score, ok := n.LatencyScore()
+		if !ok {
+			continue
+		}
+		if best == nil || score < bestScore || (score == bestScore && n.Order() < best.Order()) {
+			best, bestScore = n, score
+		}
+	}
+	if len(aliveNodes) == 0 {
+		return nil
+	}
+	if best == nil {
+		best = firstOrHighestPriority(aliveNodes)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current != nil && s.current != best && s.current.State() == nodeStateAlive {
+		if time.Since(s.selectedAt) < lowestLatencyMinDwell {
+			return s.current
+		}
+		if currentScore, ok := s.current.LatencyScore(); ok && float64(bestScore) > float64(currentScore)*(1-lowestLatencySwitchMargin) {
+			return s.current
+		}
+	}
+	if s.current != best {
+		s.current, s.selectedAt = best, time.Now()
+	}
+	return best
+}
+
+func (s *lowestLatencyNodeSelector[CHAIN_ID, RPC]) Name() string {
+	return NodeSelectionModeLowestLatency
+}
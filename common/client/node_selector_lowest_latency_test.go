@@ -0,0 +1,145 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLowestLatencyNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeLowestLatency, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLowestLatency)
+}
+
+func TestLowestLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient RPCClient[types.ID, Head]
+
+	type testNode struct {
+		state nodeState
+		score time.Duration
+		order int32
+	}
+	newNodes := func(t *testing.T, testNodes []*testNode) []Node[types.ID, nodeClient] {
+		var nodes []Node[types.ID, nodeClient]
+		for _, tn := range testNodes {
+			node := newMockNode[types.ID, nodeClient](t)
+			node.EXPECT().State().RunAndReturn(func() nodeState { return tn.state }).Maybe()
+			node.EXPECT().LatencyScore().RunAndReturn(func() (time.Duration, bool) { return tn.score, tn.score > 0 }).Maybe()
+			node.EXPECT().Order().Return(tn.order).Maybe()
+			nodes = append(nodes, node)
+		}
+		return nodes
+	}
+	// expireDwell lets the selector consider switching away from its current node right away.
+	expireDwell := func(selector NodeSelector[types.ID, nodeClient]) {
+		s := selector.(*lowestLatencyNodeSelector[types.ID, nodeClient])
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.selectedAt = time.Now().Add(-lowestLatencyMinDwell)
+	}
+
+	t.Run("selects the alive node with the lowest score", func(t *testing.T) {
+		testNodes := []*testNode{
+			{state: nodeStateOutOfSync, score: 10 * time.Millisecond, order: 1},
+			{state: nodeStateAlive, score: 300 * time.Millisecond, order: 1},
+			{state: nodeStateAlive, score: 50 * time.Millisecond, order: 2},
+		}
+		nodes := newNodes(t, testNodes)
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[2], selector.Select())
+	})
+
+	t.Run("falls back to priority order without scores", func(t *testing.T) {
+		testNodes := []*testNode{
+			{state: nodeStateAlive, order: 3},
+			{state: nodeStateAlive, order: 1},
+			{state: nodeStateUnreachable, order: 0},
+		}
+		nodes := newNodes(t, testNodes)
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("returns nil without alive nodes", func(t *testing.T) {
+		testNodes := []*testNode{
+			{state: nodeStateOutOfSync, score: time.Millisecond},
+			{state: nodeStateUnreachable, score: time.Millisecond},
+		}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, newNodes(t, testNodes))
+		assert.Nil(t, selector.Select())
+	})
+
+	t.Run("sticks to the selected node unless another one is clearly better", func(t *testing.T) {
+		testNodes := []*testNode{
+			{state: nodeStateAlive, score: 100 * time.Millisecond, order: 1},
+			{state: nodeStateAlive, score: 200 * time.Millisecond, order: 1},
+		}
+		nodes := newNodes(t, testNodes)
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[0], selector.Select())
+
+		// slightly better is not enough to switch
+		testNodes[1].score = 90 * time.Millisecond
+		expireDwell(selector)
+		assert.Same(t, nodes[0], selector.Select())
+
+		// clearly better is not enough either, until the selector has stuck to its node for a while
+		testNodes[1].score = 50 * time.Millisecond
+		selector.(*lowestLatencyNodeSelector[types.ID, nodeClient]).selectedAt = time.Now()
+		assert.Same(t, nodes[0], selector.Select())
+
+		expireDwell(selector)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("switches right away when the selected node is no longer alive", func(t *testing.T) {
+		testNodes := []*testNode{
+			{state: nodeStateAlive, score: 100 * time.Millisecond, order: 1},
+			{state: nodeStateAlive, score: 200 * time.Millisecond, order: 1},
+		}
+		nodes := newNodes(t, testNodes)
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, nodes[0], selector.Select())
+
+		testNodes[0].state = nodeStateOutOfSync
+		assert.Same(t, nodes[1], selector.Select())
+	})
+}
+
+func TestLatencyScore(t *testing.T) {
+	t.Parallel()
+
+	var s latencyScore
+	_, ok := s.score()
+	assert.False(t, ok)
+
+	s.record(100*time.Millisecond, nil)
+	score, ok := s.score()
+	assert.True(t, ok)
+	assert.Equal(t, 100*time.Millisecond, score)
+
+	s.record(200*time.Millisecond, nil)
+	score, _ = s.score()
+	assert.Equal(t, 110*time.Millisecond, score)
+
+	// a failure raises the score by more than its latency alone
+	s.record(110*time.Millisecond, errors.New("timeout"))
+	score, _ = s.score()
+	assert.Equal(t, 220*time.Millisecond, score)
+
+	// heavy requests only count towards the error rate
+	var heavy latencyScore
+	heavy.recordRequest(time.Second, false, nil)
+	_, ok = heavy.score()
+	assert.False(t, ok)
+	heavy.recordRequest(100*time.Millisecond, true, nil)
+	heavy.recordRequest(10*time.Second, false, errors.New("timeout"))
+	score, _ = heavy.score()
+	assert.Equal(t, 200*time.Millisecond, score)
+}
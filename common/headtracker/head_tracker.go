@@ -28,6 +28,12 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is EVM.FinalityDepth or greater below the highest seen head)",
 	}, []string{"evmChainID"})
+
+	promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_depth",
+		Help:    "The number of blocks replaced by each reorg detected by the head tracker",
+		Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100, 200, 500},
+	}, []string{"evmChainID"})
 )
 
 // HeadsBufferSize - The buffer is used when heads sampling is disabled, to ensure the callback is run for every head
@@ -53,19 +59,24 @@ type headTracker[
 	services.Service
 	eng *services.Engine
 
-	log             logger.SugaredLogger
-	headBroadcaster HeadBroadcaster[HTH, BLOCK_HASH]
-	headSaver       HeadSaver[HTH, BLOCK_HASH]
-	mailMon         *mailbox.Monitor
-	client          htrktypes.Client[HTH, S, ID, BLOCK_HASH]
-	chainID         types.ID
-	config          htrktypes.Config
-	htConfig        htrktypes.HeadTrackerConfig
+	log              logger.SugaredLogger
+	headBroadcaster  HeadBroadcaster[HTH, BLOCK_HASH]
+	reorgBroadcaster ReorgBroadcaster[BLOCK_HASH]
+	headSaver        HeadSaver[HTH, BLOCK_HASH]
+	mailMon          *mailbox.Monitor
+	client           htrktypes.Client[HTH, S, ID, BLOCK_HASH]
+	chainID          types.ID
+	config           htrktypes.Config
+	htConfig         htrktypes.HeadTrackerConfig
 
 	backfillMB   *mailbox.Mailbox[HTH]
 	broadcastMB  *mailbox.Mailbox[HTH]
 	headListener HeadListener[HTH, BLOCK_HASH]
 	getNilHead   func() HTH
+
+	// lastBackfilled is the head of the longest chain as of the latest successful backfill. It is only accessed by
+	// backfillLoop.
+	lastBackfilled HTH
 }
 
 // NewHeadTracker instantiates a new HeadTracker using HeadSaver to persist new block numbers.
@@ -80,21 +91,24 @@ func NewHeadTracker[
 	config htrktypes.Config,
 	htConfig htrktypes.HeadTrackerConfig,
 	headBroadcaster HeadBroadcaster[HTH, BLOCK_HASH],
+	reorgBroadcaster ReorgBroadcaster[BLOCK_HASH],
 	headSaver HeadSaver[HTH, BLOCK_HASH],
 	mailMon *mailbox.Monitor,
 	getNilHead func() HTH,
 ) HeadTracker[HTH, BLOCK_HASH] {
 	ht := &headTracker[HTH, S, ID, BLOCK_HASH]{
-		headBroadcaster: headBroadcaster,
-		client:          client,
-		chainID:         client.ConfiguredChainID(),
-		config:          config,
-		htConfig:        htConfig,
-		backfillMB:      mailbox.NewSingle[HTH](),
-		broadcastMB:     mailbox.New[HTH](HeadsBufferSize),
-		headSaver:       headSaver,
-		mailMon:         mailMon,
-		getNilHead:      getNilHead,
+		headBroadcaster:  headBroadcaster,
+		reorgBroadcaster: reorgBroadcaster,
+		client:           client,
+		chainID:          client.ConfiguredChainID(),
+		config:           config,
+		htConfig:         htConfig,
+		backfillMB:       mailbox.NewSingle[HTH](),
+		broadcastMB:      mailbox.New[HTH](HeadsBufferSize),
+		headSaver:        headSaver,
+		mailMon:          mailMon,
+		getNilHead:       getNilHead,
+		lastBackfilled:   getNilHead(),
 	}
 	ht.Service, ht.eng = services.Config{
 		Name: "HeadTracker",
@@ -309,6 +323,8 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop(ctx context.Context)
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
 						break
+					} else {
+						ht.checkReorg(ctx, head)
 					}
 				}
 			}
@@ -316,6 +332,38 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop(ctx context.Context)
 	}
 }
 
+// checkReorg compares the chain of the head that was just backfilled with the previous one, and broadcasts the reorg
+// if some blocks of the previous chain were replaced. Backfilling first guarantees that the new chain reaches back to
+// the latest finalized block, so their common ancestor can be found.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) checkReorg(ctx context.Context, head HTH) {
+	// reload the head, as backfill might have connected it to its ancestors
+	headWithChain := ht.headSaver.Chain(head.BlockHash())
+	if !headWithChain.IsValid() {
+		return
+	}
+	prev := ht.lastBackfilled
+	ht.lastBackfilled = headWithChain
+	if !prev.IsValid() || headWithChain.BlockNumber() < prev.BlockNumber() {
+		return
+	}
+
+	reorg, ok := findReorg[BLOCK_HASH](prev, headWithChain)
+	if !ok {
+		return
+	}
+	ht.log.Warnw("Detected reorg",
+		"depth", reorg.Depth,
+		"commonAncestorNumber", reorg.CommonAncestorNumber,
+		"commonAncestorHash", reorg.CommonAncestorHash,
+		"oldHeadNumber", prev.BlockNumber(),
+		"oldHeadHash", prev.BlockHash(),
+		"newHeadNumber", headWithChain.BlockNumber(),
+		"newHeadHash", headWithChain.BlockHash(),
+	)
+	promReorgDepth.WithLabelValues(ht.chainID.String()).Observe(float64(reorg.Depth))
+	ht.reorgBroadcaster.BroadcastReorg(ctx, reorg)
+}
+
 // LatestAndFinalizedBlock - returns latest and latest finalized blocks.
 // NOTE: Returns latest finalized block as is, ignoring the FinalityTagBypass feature flag.
 // TODO: BCI-3321 use cached values instead of making RPC requests
@@ -0,0 +1,197 @@
+package headtracker
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// Reorg describes a reorganization detected by the HeadTracker: the blocks of the previous longest chain above the
+// common ancestor were replaced by the blocks of the new longest chain.
+type Reorg[BLOCK_HASH types.Hashable] struct {
+	// Depth is the number of blocks of the previous longest chain that were replaced.
+	Depth                int64
+	CommonAncestorNumber int64
+	CommonAncestorHash   BLOCK_HASH
+	// OldHashes are the hashes of the replaced blocks, from the previous head down to the common ancestor.
+	OldHashes []BLOCK_HASH
+	// NewHashes are the hashes of the blocks that replaced them, from the new head down to the common ancestor.
+	NewHashes  []BLOCK_HASH
+	DetectedAt time.Time
+}
+
+// OldHeadNumber is the block number of the head of the previous longest chain.
+func (r Reorg[BLOCK_HASH]) OldHeadNumber() int64 {
+	return r.CommonAncestorNumber + int64(len(r.OldHashes))
+}
+
+// NewHeadNumber is the block number of the head of the new longest chain.
+func (r Reorg[BLOCK_HASH]) NewHeadNumber() int64 {
+	return r.CommonAncestorNumber + int64(len(r.NewHashes))
+}
+
+// findReorg returns the reorg that replaced prev as the longest chain with next. ok is false if prev is part of next,
+// or if next does not reach back far enough to find their common ancestor.
+func findReorg[BLOCK_HASH types.Hashable](prev, next types.Head[BLOCK_HASH]) (reorg Reorg[BLOCK_HASH], ok bool) {
+	if next.HashAtHeight(prev.BlockNumber()) == prev.BlockHash() {
+		return reorg, false
+	}
+
+	var ancestor types.Head[BLOCK_HASH]
+	for h := prev; h != nil && h.IsValid(); h = h.GetParent() {
+		if next.HashAtHeight(h.BlockNumber()) == h.BlockHash() {
+			ancestor = h
+			break
+		}
+		reorg.OldHashes = append(reorg.OldHashes, h.BlockHash())
+	}
+	if ancestor == nil {
+		return reorg, false
+	}
+	for h := next; h != nil && h.IsValid() && h.BlockNumber() > ancestor.BlockNumber(); h = h.GetParent() {
+		reorg.NewHashes = append(reorg.NewHashes, h.BlockHash())
+	}
+
+	reorg.Depth = int64(len(reorg.OldHashes))
+	reorg.CommonAncestorNumber = ancestor.BlockNumber()
+	reorg.CommonAncestorHash = ancestor.BlockHash()
+	reorg.DetectedAt = time.Now()
+	return reorg, true
+}
+
+// ReorgTrackable is implemented by services that need to react to reorgs.
+type ReorgTrackable[BLOCK_HASH types.Hashable] interface {
+	// OnReorg is called for every reorg detected by the HeadTracker, after it has been saved.
+	OnReorg(ctx context.Context, reorg Reorg[BLOCK_HASH])
+}
+
+// ReorgSaver keeps the history of reorgs.
+type ReorgSaver[BLOCK_HASH types.Hashable] interface {
+	SaveReorg(ctx context.Context, reorg Reorg[BLOCK_HASH]) error
+}
+
+// ReorgBroadcaster saves the reorgs detected by the HeadTracker and relays them to all subscribers.
+type ReorgBroadcaster[BLOCK_HASH types.Hashable] interface {
+	services.Service
+	// BroadcastReorg saves reorg before it returns, and queues it to be relayed to all subscribers. Reorgs are never
+	// dropped, however slow the subscribers are.
+	BroadcastReorg(ctx context.Context, reorg Reorg[BLOCK_HASH])
+	Subscribe(callback ReorgTrackable[BLOCK_HASH]) (unsubscribe func())
+}
+
+type reorgBroadcaster[BLOCK_HASH types.Hashable] struct {
+	services.Service
+	eng *services.Engine
+
+	saver          ReorgSaver[BLOCK_HASH]
+	mailbox        *mailbox.Mailbox[Reorg[BLOCK_HASH]]
+	mutex          sync.Mutex
+	callbacks      map[int]ReorgTrackable[BLOCK_HASH]
+	lastCallbackID int
+}
+
+// NewReorgBroadcaster creates a new ReorgBroadcaster, which saves every reorg with saver before relaying it.
+func NewReorgBroadcaster[
+	BLOCK_HASH types.Hashable,
+](
+	lggr logger.Logger,
+	saver ReorgSaver[BLOCK_HASH],
+) ReorgBroadcaster[BLOCK_HASH] {
+	rb := &reorgBroadcaster[BLOCK_HASH]{
+		saver:     saver,
+		callbacks: make(map[int]ReorgTrackable[BLOCK_HASH]),
+		// reorgs are rare, so the queue is unbounded rather than dropping any of them
+		mailbox: mailbox.New[Reorg[BLOCK_HASH]](0),
+	}
+	rb.Service, rb.eng = services.Config{
+		Name:  "ReorgBroadcaster",
+		Start: rb.start,
+		Close: rb.close,
+	}.NewServiceEngine(lggr)
+	return rb
+}
+
+func (rb *reorgBroadcaster[BLOCK_HASH]) start(context.Context) error {
+	rb.eng.Go(rb.run)
+	return nil
+}
+
+func (rb *reorgBroadcaster[BLOCK_HASH]) close() error {
+	rb.mutex.Lock()
+	// clear all callbacks
+	rb.callbacks = make(map[int]ReorgTrackable[BLOCK_HASH])
+	rb.mutex.Unlock()
+	return nil
+}
+
+func (rb *reorgBroadcaster[BLOCK_HASH]) BroadcastReorg(ctx context.Context, reorg Reorg[BLOCK_HASH]) {
+	if err := rb.saver.SaveReorg(ctx, reorg); err != nil {
+		rb.eng.Errorw("Failed to save reorg", "depth", reorg.Depth, "commonAncestor", reorg.CommonAncestorHash, "err", err)
+	}
+	rb.mailbox.Deliver(reorg)
+}
+
+// Subscribe subscribes to OnReorg until ReorgBroadcaster is closed, or unsubscribe callback is called explicitly
+func (rb *reorgBroadcaster[BLOCK_HASH]) Subscribe(callback ReorgTrackable[BLOCK_HASH]) (unsubscribe func()) {
+	rb.mutex.Lock()
+	defer rb.mutex.Unlock()
+
+	rb.lastCallbackID++
+	callbackID := rb.lastCallbackID
+	rb.callbacks[callbackID] = callback
+	return func() {
+		rb.mutex.Lock()
+		defer rb.mutex.Unlock()
+		delete(rb.callbacks, callbackID)
+	}
+}
+
+func (rb *reorgBroadcaster[BLOCK_HASH]) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-rb.mailbox.Notify():
+			for {
+				reorg, exists := rb.mailbox.Retrieve()
+				if !exists {
+					break
+				}
+				rb.notify(ctx, reorg)
+			}
+		}
+	}
+}
+
+func (rb *reorgBroadcaster[BLOCK_HASH]) notify(ctx context.Context, reorg Reorg[BLOCK_HASH]) {
+	rb.mutex.Lock()
+	callbacks := make([]ReorgTrackable[BLOCK_HASH], 0, len(rb.callbacks))
+	for _, callback := range rb.callbacks {
+		callbacks = append(callbacks, callback)
+	}
+	rb.mutex.Unlock()
+
+	var wg sync.WaitGroup
+	wg.Add(len(callbacks))
+	for _, callback := range callbacks {
+		go func(trackable ReorgTrackable[BLOCK_HASH]) {
+			defer wg.Done()
+			start := time.Now()
+			cctx, cancel := context.WithTimeout(ctx, TrackableCallbackTimeout)
+			defer cancel()
+			trackable.OnReorg(cctx, reorg)
+			elapsed := time.Since(start)
+			rb.eng.Debugw(fmt.Sprintf("Finished reorg callback in %s", elapsed),
+				"callbackType", reflect.TypeOf(trackable), "depth", reorg.Depth, "time", elapsed)
+		}(callback)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,67 @@
+package headtracker
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+)
+
+// newChain returns heads from..to on top of parent, indexed by block number.
+func newChain(parent *evmtypes.Head, from, to int64) map[int64]*evmtypes.Head {
+	heads := make(map[int64]*evmtypes.Head)
+	for n := from; n <= to; n++ {
+		h := evmtypes.NewHead(big.NewInt(n), utils.NewHash(), common.Hash{}, 0, nil)
+		if parent != nil {
+			h.ParentHash = parent.Hash
+			h.Parent.Store(parent)
+		}
+		heads[n] = &h
+		parent = &h
+	}
+	return heads
+}
+
+func TestFindReorg(t *testing.T) {
+	t.Parallel()
+
+	canonical := newChain(nil, 0, 5)
+
+	t.Run("no reorg when the new chain extends the previous one", func(t *testing.T) {
+		_, ok := findReorg[common.Hash](canonical[3], canonical[5])
+		assert.False(t, ok)
+	})
+
+	t.Run("finds the common ancestor and the replaced blocks", func(t *testing.T) {
+		fork := newChain(canonical[2], 3, 6)
+		reorg, ok := findReorg[common.Hash](canonical[5], fork[6])
+		require.True(t, ok)
+		assert.Equal(t, int64(3), reorg.Depth)
+		assert.Equal(t, int64(2), reorg.CommonAncestorNumber)
+		assert.Equal(t, canonical[2].Hash, reorg.CommonAncestorHash)
+		assert.Equal(t, []common.Hash{canonical[5].Hash, canonical[4].Hash, canonical[3].Hash}, reorg.OldHashes)
+		assert.Equal(t, []common.Hash{fork[6].Hash, fork[5].Hash, fork[4].Hash, fork[3].Hash}, reorg.NewHashes)
+		assert.Equal(t, int64(5), reorg.OldHeadNumber())
+		assert.Equal(t, int64(6), reorg.NewHeadNumber())
+		assert.False(t, reorg.DetectedAt.IsZero())
+	})
+
+	t.Run("replacing the previous head only", func(t *testing.T) {
+		fork := newChain(canonical[4], 5, 5)
+		reorg, ok := findReorg[common.Hash](canonical[5], fork[5])
+		require.True(t, ok)
+		assert.Equal(t, int64(1), reorg.Depth)
+		assert.Equal(t, canonical[4].Hash, reorg.CommonAncestorHash)
+	})
+
+	t.Run("no reorg when the chains do not meet in memory", func(t *testing.T) {
+		unrelated := newChain(nil, 3, 6)
+		_, ok := findReorg[common.Hash](canonical[5], unrelated[6])
+		assert.False(t, ok)
+	})
+}
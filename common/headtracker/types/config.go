@@ -16,4 +16,5 @@ type HeadTrackerConfig interface {
 	FinalityTagBypass() bool
 	MaxAllowedFinalityDepth() uint32
 	PersistenceEnabled() bool
+	ReorgRetention() time.Duration
 }
@@ -58,6 +58,10 @@ var (
 			float64(2 * time.Minute),
 		},
 	}, []string{"chainID"})
+	promNumPreemptedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_preempted_transactions",
+		Help: "Number of unconfirmed transactions which had their sequence taken over by a transaction with a higher priority.",
+	}, []string{"chainID"})
 )
 
 var ErrTxRemoved = errors.New("tx removed")
@@ -379,6 +383,16 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 		return retryable, fmt.Errorf("processUnstartedTxs failed on handleAnyInProgressTx: %w", err)
 	}
 	for {
+		if eb.txConfig.Preemption().Enabled() {
+			preempted, retryable, err := eb.preemptLowerPriorityTx(ctx, fromAddress)
+			if err != nil {
+				return retryable, fmt.Errorf("processUnstartedTxs failed on preemptLowerPriorityTx: %w", err)
+			}
+			if preempted {
+				n++
+				continue
+			}
+		}
 		maxInFlightTransactions := eb.txConfig.MaxInFlight()
 		if maxInFlightTransactions > 0 {
 			nUnconfirmed, err := eb.txStore.CountUnconfirmedTransactions(ctx, fromAddress, eb.chainID)
@@ -408,7 +422,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 		}
 		n++
 
-		if err, retryable := eb.handleUnstartedTx(ctx, etx); err != nil {
+		if err, retryable := eb.handleUnstartedTx(ctx, etx, nil); err != nil {
 			return retryable, fmt.Errorf("processUnstartedTxs failed on handleUnstartedTx: %w", err)
 		}
 	}
@@ -429,12 +443,59 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	return nil, false
 }
 
-func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) handleUnstartedTx(ctx context.Context, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (error, bool) {
+// preemptLowerPriorityTx lets the next unstarted tx take over the sequence of an unconfirmed tx with a lower
+// priority, which has been pending for at least Preemption.MinPendingTime and whose sequence has not been mined yet.
+// This bypasses MaxInFlight, since the number of txes in flight does not change.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) preemptLowerPriorityTx(ctx context.Context, fromAddress ADDR) (preempted bool, retryable bool, err error) {
+	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, false, nil
+	} else if err != nil {
+		return false, true, fmt.Errorf("findNextUnstartedTransactionFromAddress failed: %w", err)
+	}
+	if etx.Priority <= txmgrtypes.TxPriorityLow {
+		return false, false, nil
+	}
+	lowerPriorityTx, err := eb.txStore.FindTxToPreempt(ctx, fromAddress, eb.chainID, etx.Priority, time.Now().Add(-eb.txConfig.Preemption().MinPendingTime()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, false, nil
+	} else if err != nil {
+		return false, true, fmt.Errorf("findTxToPreempt failed: %w", err)
+	}
+	if len(lowerPriorityTx.TxAttempts) == 0 {
+		return false, false, nil
+	}
+	// Txs carrying blobs live in a separate pool of the node, and can neither replace nor be replaced by txs without blobs
+	if len(etx.Blobs) > 0 || len(lowerPriorityTx.Blobs) > 0 {
+		return false, false, nil
+	}
+	// Only replace a sequence which has not been mined yet, otherwise the preempted tx would be executed twice
+	minedSequence, err := eb.client.SequenceAt(ctx, fromAddress, nil)
+	if err != nil {
+		return false, true, fmt.Errorf("failed to fetch latest mined sequence: %w", err)
+	}
+	if minedSequence.Int64() > (*lowerPriorityTx.Sequence).Int64() {
+		return false, false, nil
+	}
+
+	eb.lggr.Infow("Preempting lower priority transaction", "txID", etx.ID, "priority", etx.Priority, "preemptedTxID", lowerPriorityTx.ID,
+		"preemptedPriority", lowerPriorityTx.Priority, "sequence", lowerPriorityTx.Sequence)
+	etx.Sequence = lowerPriorityTx.Sequence
+	if err, retryable = eb.handleUnstartedTx(ctx, etx, lowerPriorityTx); err != nil {
+		return false, retryable, err
+	}
+	promNumPreemptedTxs.WithLabelValues(eb.chainID.String()).Inc()
+	return true, false, nil
+}
+
+// handleUnstartedTx moves etx to in_progress and sends it. If preempted is set, etx takes over its sequence and its
+// first attempt is a bumped replacement of the latest attempt of preempted.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) handleUnstartedTx(ctx context.Context, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], preempted *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (error, bool) {
 	if etx.State != TxUnstarted {
 		return fmt.Errorf("invariant violation: expected transaction %v to be unstarted, it was %s", etx.ID, etx.State), false
 	}
 
-	attempt, _, _, retryable, err := eb.NewTxAttempt(ctx, *etx, eb.lggr)
+	attempt, _, feeLimit, retryable, err := eb.NewTxAttempt(ctx, *etx, eb.lggr)
 	// Mark transaction as fatal if provided gas limit is set too low
 	if errors.Is(err, commonfee.ErrFeeLimitTooLow) {
 		etx.Error = null.StringFrom(commonfee.ErrFeeLimitTooLow.Error())
@@ -442,6 +503,18 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	} else if err != nil {
 		return fmt.Errorf("processUnstartedTxs failed on NewAttempt: %w", err), retryable
 	}
+	if preempted != nil {
+		// The replacement has to pay more than the tx it replaces to be accepted into the mempool
+		previousAttempt := preempted.TxAttempts[0]
+		_, bumpedFee, _, retryable, err := eb.NewBumpTxAttempt(ctx, *etx, previousAttempt, preempted.TxAttempts, eb.lggr)
+		if err != nil {
+			return fmt.Errorf("processUnstartedTxs failed on NewBumpTxAttempt: %w", err), retryable
+		}
+		attempt, retryable, err = eb.NewCustomTxAttempt(ctx, *etx, bumpedFee, feeLimit, previousAttempt.TxType, eb.lggr)
+		if err != nil {
+			return fmt.Errorf("processUnstartedTxs failed on NewCustomTxAttempt: %w", err), retryable
+		}
+	}
 
 	checkerSpec, err := etx.GetChecker()
 	if err != nil {
@@ -472,7 +545,12 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	}
 	cancel()
 
-	if err = eb.txStore.UpdateTxUnstartedToInProgress(ctx, etx, &attempt); errors.Is(err, ErrTxRemoved) {
+	if preempted != nil {
+		err = eb.txStore.PreemptTx(ctx, preempted, etx, &attempt)
+	} else {
+		err = eb.txStore.UpdateTxUnstartedToInProgress(ctx, etx, &attempt)
+	}
+	if errors.Is(err, ErrTxRemoved) {
 		eb.lggr.Debugw("tx removed", "txID", etx.ID, "subject", etx.Subject)
 		return nil, false
 	} else if err != nil {
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"sync"
@@ -70,6 +71,10 @@ var (
 		Name: "tx_manager_fwd_tx_count",
 		Help: "The number of forwarded transaction attempts labeled by status",
 	}, []string{"chainID", "successful"})
+	promNumSequenceGapsHealed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_sequence_gaps_healed",
+		Help: "Number of gaps in the sequences of enabled addresses which were filled by sending a transaction to self",
+	}, []string{"chainID"})
 	promTxAttemptCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "tx_manager_tx_attempt_count",
 		Help: "The number of transaction attempts that are currently being processed by the transaction manager",
@@ -103,6 +108,12 @@ var (
 	}, []string{"chainID"})
 )
 
+// ErrGapHealFeeExceedsCap is returned if the fee to fill a sequence gap is higher than the configured cap
+var ErrGapHealFeeExceedsCap = errors.New("fee to heal sequence gap exceeds the configured cap")
+
+// ErrGapHealUnderpriced is returned if the transaction filling a sequence gap was rejected for being underpriced
+var ErrGapHealUnderpriced = errors.New("transaction to heal sequence gap is underpriced")
+
 type confirmerHeadTracker[HEAD types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface {
 	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized HEAD, err error)
 }
@@ -127,13 +138,15 @@ type Confirmer[
 	lggr    logger.SugaredLogger
 	client  txmgrtypes.TxmClient[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
-	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
-	resumeCallback  ResumeCallback
-	chainConfig     txmgrtypes.ConfirmerChainConfig
-	feeConfig       txmgrtypes.ConfirmerFeeConfig
-	txConfig        txmgrtypes.ConfirmerTransactionsConfig
-	dbConfig        txmgrtypes.ConfirmerDatabaseConfig
-	chainID         CHAIN_ID
+	stuckTxDetector   txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	gapHealing        txmgrtypes.SequenceGapHealingConfig[FEE]
+	resumeCallback    ResumeCallback
+	gapHealedCallback func(heal txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE])
+	chainConfig       txmgrtypes.ConfirmerChainConfig
+	feeConfig         txmgrtypes.ConfirmerFeeConfig
+	txConfig          txmgrtypes.ConfirmerTransactionsConfig
+	dbConfig          txmgrtypes.ConfirmerDatabaseConfig
+	chainID           CHAIN_ID
 
 	ks               txmgrtypes.KeyStore[ADDR, CHAIN_ID, SEQ]
 	enabledAddresses []ADDR
@@ -147,6 +160,9 @@ type Confirmer[
 	isReceiptNil                    func(R) bool
 
 	headTracker confirmerHeadTracker[HEAD, BLOCK_HASH]
+
+	// gapsSeenAt tracks the block number at which each sequence gap of an address was first seen or last filled
+	gapsSeenAt map[ADDR]map[int64]int64
 }
 
 func NewConfirmer[
@@ -171,6 +187,7 @@ func NewConfirmer[
 	isReceiptNil func(R) bool,
 	stuckTxDetector txmgrtypes.StuckTxDetector[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 	headTracker confirmerHeadTracker[HEAD, BLOCK_HASH],
+	gapHealing txmgrtypes.SequenceGapHealingConfig[FEE],
 ) *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	lggr = logger.Named(lggr, "Confirmer")
 	return &Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{
@@ -189,6 +206,8 @@ func NewConfirmer[
 		isReceiptNil:     isReceiptNil,
 		stuckTxDetector:  stuckTxDetector,
 		headTracker:      headTracker,
+		gapHealing:       gapHealing,
+		gapsSeenAt:       make(map[ADDR]map[int64]int64),
 	}
 }
 
@@ -251,6 +270,12 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Set
 	ec.resumeCallback = callback
 }
 
+// SetSequenceGapHealedCallback sets a callback which is called for every sequence gap that is healed automatically,
+// e.g. to record it in the audit log
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SetSequenceGapHealedCallback(callback func(heal txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE])) {
+	ec.gapHealedCallback = callback
+}
+
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Name() string {
 	return ec.lggr.Name()
 }
@@ -325,6 +350,14 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 	ec.lggr.Debugw("Finished CheckForReceipts", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
 	mark = time.Now()
 
+	requeued, err := ec.txStore.ResolvePreemptedTxs(ctx, ec.chainID)
+	if err != nil {
+		return fmt.Errorf("ResolvePreemptedTxs failed: %w", err)
+	}
+	if len(requeued) > 0 {
+		ec.lggr.Infow("Requeued transactions which lost their sequence to a preempting transaction", "txIDs", requeued, "headNum", head.BlockNumber())
+	}
+
 	if err := ec.ProcessStuckTransactions(ctx, head.BlockNumber()); err != nil {
 		return fmt.Errorf("ProcessStuckTransactions failed: %w", err)
 	}
@@ -354,6 +387,15 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 		ec.lggr.Debugw("Finished ResumePendingTaskRuns", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
 	}
 
+	if ec.gapHealing.Enabled() {
+		mark = time.Now()
+		if err := ec.HealSequenceGapsWhereNecessary(ctx, head.BlockNumber()); err != nil {
+			return fmt.Errorf("HealSequenceGapsWhereNecessary failed: %w", err)
+		}
+
+		ec.lggr.Debugw("Finished HealSequenceGapsWhereNecessary", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
+	}
+
 	ec.lggr.Debugw("processHead finish", "headNum", head.BlockNumber(), "id", "confirmer")
 
 	return nil
@@ -1259,6 +1301,147 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) sen
 	return txhash, nil
 }
 
+// HealSequenceGapsWhereNecessary fills the sequence gaps of enabled addresses which persisted for at least
+// NonceGapHealing.Threshold blocks. A gap is a sequence between the latest mined sequence and the highest pending
+// sequence which no stored transaction is using, e.g. because the transaction was purged or dropped. Such a gap blocks
+// every transaction with a higher sequence from being mined.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) HealSequenceGapsWhereNecessary(ctx context.Context, blockNum int64) error {
+	var errs []error
+	for _, address := range ec.enabledAddresses {
+		gaps, err := ec.findSequenceGaps(ctx, address)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, seq := range ec.gapsRequiringHeal(address, gaps, blockNum) {
+			heal, err := ec.healSequenceGap(ctx, address, seq)
+			if errors.Is(err, ErrGapHealFeeExceedsCap) || errors.Is(err, ErrGapHealUnderpriced) {
+				// Try again once the gap persisted for another Threshold blocks
+				ec.lggr.Warnw("Not healing sequence gap", "fromAddress", address, "sequence", seq, "err", err)
+			} else if err != nil {
+				ec.lggr.Errorw("Failed to heal sequence gap", "fromAddress", address, "sequence", seq, "err", err)
+				errs = append(errs, err)
+			} else if heal != nil && ec.gapHealedCallback != nil {
+				ec.gapHealedCallback(*heal)
+			}
+		}
+	}
+	return multierr.Combine(errs...)
+}
+
+// HealSequenceGaps immediately fills all sequence gaps of address, regardless of whether healing is enabled and how
+// long the gaps have persisted. It returns the gaps which were filled.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) HealSequenceGaps(ctx context.Context, address ADDR) (heals []txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE], err error) {
+	enabledAddresses, err := ec.ks.EnabledAddressesForChain(ctx, ec.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load EnabledAddressesForChain: %w", err)
+	}
+	if !slices.Contains(enabledAddresses, address) {
+		return nil, fmt.Errorf("address %s is not enabled for chain %s", address, ec.chainID)
+	}
+	gaps, err := ec.findSequenceGaps(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	for _, seq := range gaps {
+		heal, err := ec.healSequenceGap(ctx, address, seq)
+		if err != nil {
+			return heals, err
+		}
+		if heal != nil {
+			heals = append(heals, *heal)
+		}
+	}
+	return heals, nil
+}
+
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) findSequenceGaps(ctx context.Context, address ADDR) ([]SEQ, error) {
+	minedSequence, err := ec.getMinedSequenceForAddress(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch latest mined sequence for address %s: %w", address, err)
+	}
+	gaps, err := ec.txStore.FindSequenceGaps(ctx, address, ec.chainID, minedSequence)
+	if err != nil {
+		return nil, fmt.Errorf("FindSequenceGaps failed: %w", err)
+	}
+	return gaps, nil
+}
+
+// gapsRequiringHeal records the current gaps of address and returns those which have been open for at least Threshold
+// blocks since they were first seen or last filled.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) gapsRequiringHeal(address ADDR, gaps []SEQ, blockNum int64) (heal []SEQ) {
+	threshold := int64(ec.gapHealing.Threshold())
+	seenAt := make(map[int64]int64, len(gaps))
+	for _, seq := range gaps {
+		since, ok := ec.gapsSeenAt[address][seq.Int64()]
+		if !ok {
+			since = blockNum
+		}
+		if blockNum-since >= threshold {
+			heal = append(heal, seq)
+			since = blockNum
+		}
+		seenAt[seq.Int64()] = since
+	}
+	// Gaps which have been closed in the meantime are forgotten
+	ec.gapsSeenAt[address] = seenAt
+	return heal
+}
+
+// healSequenceGap fills the gap at seq by sending a zero value transaction from address to itself. The fee is
+// estimated and ErrGapHealFeeExceedsCap is returned if it exceeds the configured cap. The transaction is stored once it
+// was sent, so that it is confirmed, bumped and rebroadcast like any other transaction. Returns nil if the gap was
+// filled in the meantime.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) healSequenceGap(ctx context.Context, address ADDR, seq SEQ) (*txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE], error) {
+	now := time.Now()
+	etx := txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{
+		Sequence:           &seq,
+		FromAddress:        address,
+		ToAddress:          address,
+		EncodedPayload:     []byte{},
+		FeeLimit:           ec.feeConfig.LimitDefault(),
+		ChainID:            ec.chainID,
+		State:              TxUnconfirmed,
+		BroadcastAt:        &now,
+		InitialBroadcastAt: &now,
+	}
+	attempt, fee, _, _, err := ec.NewTxAttempt(ctx, etx, ec.lggr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attempt to heal sequence gap: %w", err)
+	}
+	if !ec.gapHealing.FeeWithinCap(fee) {
+		return nil, fmt.Errorf("%w: estimated fee %s", ErrGapHealFeeExceedsCap, fee)
+	}
+
+	errCode, err := ec.client.SendTransactionReturnCode(ctx, etx, attempt, ec.lggr)
+	switch errCode {
+	case client.Successful:
+	case client.TransactionAlreadyKnown:
+		// The sequence has been mined in the meantime
+		ec.lggr.Debugw("Sequence gap was already filled", "fromAddress", address, "sequence", seq)
+		return nil, nil
+	case client.Underpriced:
+		return nil, fmt.Errorf("%w: fee %s: %w", ErrGapHealUnderpriced, fee, err)
+	default:
+		return nil, fmt.Errorf("failed to send transaction to heal sequence gap at %s: %w", seq, err)
+	}
+
+	attempt.State = txmgrtypes.TxAttemptBroadcast
+	inserted, err := ec.txStore.InsertUnconfirmedTxWithAttempt(ctx, &etx, &attempt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save transaction healing sequence gap at %s: %w", seq, err)
+	}
+	if !inserted {
+		// Another transaction took the sequence while the gap was being filled, it is tracked instead
+		ec.lggr.Debugw("Sequence gap was filled by another transaction", "fromAddress", address, "sequence", seq)
+		return nil, nil
+	}
+
+	promNumSequenceGapsHealed.WithLabelValues(ec.chainID.String()).Inc()
+	ec.lggr.Warnw("Healed sequence gap by sending a transaction to self", "fromAddress", address, "sequence", seq, "txID", etx.ID, "txHash", attempt.Hash, "fee", fee)
+	return &txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE]{FromAddress: address, Sequence: seq, TxHash: attempt.Hash, Fee: fee}, nil
+}
+
 // ResumePendingTaskRuns issues callbacks to task runs that are pending waiting for receipts
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ResumePendingTaskRuns(ctx context.Context, latest, finalized int64) error {
 	receiptsPlus, err := ec.txStore.FindTxesPendingCallback(ctx, latest, finalized, ec.chainID)
@@ -721,6 +721,65 @@ func (_c *TxManager_GetTransactionStatus_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLO
 	return _c
 }
 
+// HealSequenceGaps provides a mock function with given fields: ctx, addr
+func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) HealSequenceGaps(ctx context.Context, addr ADDR) ([]txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE], error) {
+	ret := _m.Called(ctx, addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HealSequenceGaps")
+	}
+
+	var r0 []txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR) ([]txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE], error)); ok {
+		return rf(ctx, addr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ADDR) []txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE]); ok {
+		r0 = rf(ctx, addr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ADDR) error); ok {
+		r1 = rf(ctx, addr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxManager_HealSequenceGaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HealSequenceGaps'
+type TxManager_HealSequenceGaps_Call[CHAIN_ID types.ID, HEAD types.Head[BLOCK_HASH], ADDR types.Hashable, TX_HASH types.Hashable, BLOCK_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	*mock.Call
+}
+
+// HealSequenceGaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addr ADDR
+func (_e *TxManager_Expecter[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) HealSequenceGaps(ctx interface{}, addr interface{}) *TxManager_HealSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	return &TxManager_HealSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]{Call: _e.mock.On("HealSequenceGaps", ctx, addr)}
+}
+
+func (_c *TxManager_HealSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Run(run func(ctx context.Context, addr ADDR)) *TxManager_HealSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ADDR))
+	})
+	return _c
+}
+
+func (_c *TxManager_HealSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Return(heals []txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE], err error) *TxManager_HealSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(heals, err)
+	return _c
+}
+
+func (_c *TxManager_HealSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) RunAndReturn(run func(context.Context, ADDR) ([]txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE], error)) *TxManager_HealSequenceGaps_Call[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HealthReport provides a mock function with given fields:
 func (_m *TxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) HealthReport() map[string]error {
 	ret := _m.Called()
@@ -51,6 +51,8 @@ type TxManager[
 	RegisterResumeCallback(fn ResumeCallback)
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
+	// HealSequenceGaps fills every gap in the sequences of addr with a transaction to self
+	HealSequenceGaps(ctx context.Context, addr ADDR) (heals []txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE], err error)
 	// Find transactions by a field in the TxMeta blob and transaction states
 	FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions with a non-null TxMeta field that was provided by transaction states
@@ -248,6 +250,17 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Reset(addr
 	return err
 }
 
+// HealSequenceGaps fills the sequence gaps of addr right away, see Confirmer.HealSequenceGaps
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) HealSequenceGaps(ctx context.Context, addr ADDR) (heals []txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE], err error) {
+	ok := b.IfStarted(func() {
+		heals, err = b.confirmer.HealSequenceGaps(ctx, addr)
+	})
+	if !ok {
+		return nil, errors.New("not started")
+	}
+	return heals, err
+}
+
 // abandon, scoped to the key of this txm:
 // - marks all pending and inflight transactions fatally errored (note: at this point all transactions are either confirmed or fatally errored)
 // this must not be run while Broadcaster or Confirmer are running
@@ -599,6 +612,8 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) SendNative
 		Value:          value,
 		FeeLimit:       gasLimit,
 		Strategy:       NewSendEveryStrategy(),
+		// Only used for transfers requested by the node operator
+		Priority: txmgrtypes.TxPriorityAdmin,
 	}
 	etx, err = b.pruneQueueAndCreateTxn(ctx, txRequest, chainID)
 	if err != nil {
@@ -717,6 +732,10 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Res
 	return nil
 }
 
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) HealSequenceGaps(ctx context.Context, addr ADDR) (heals []txmgrtypes.SequenceGapHeal[ADDR, TX_HASH, SEQ, FEE], err error) {
+	return nil, errors.New(n.ErrMsg)
+}
+
 // SendNativeToken does nothing, null functionality
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error) {
 	return etx, errors.New(n.ErrMsg)
@@ -1,6 +1,10 @@
 package types
 
-import "time"
+import (
+	"time"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+)
 
 type TransactionManagerChainConfig interface {
 	BroadcasterChainConfig
@@ -33,6 +37,12 @@ type BroadcasterFeeConfig interface {
 
 type BroadcasterTransactionsConfig interface {
 	MaxInFlight() uint32
+	Preemption() PreemptionConfig
+}
+
+type PreemptionConfig interface {
+	Enabled() bool
+	MinPendingTime() time.Duration
 }
 
 type BroadcasterListenerConfig interface {
@@ -64,6 +74,15 @@ type ConfirmerTransactionsConfig interface {
 	ForwardersEnabled() bool
 }
 
+// SequenceGapHealingConfig configures how the Confirmer fills gaps in the sequences of its enabled addresses
+type SequenceGapHealingConfig[FEE feetypes.Fee] interface {
+	Enabled() bool
+	// Threshold is the number of blocks a gap has to persist before it is filled, and between attempts to fill it
+	Threshold() uint32
+	// FeeWithinCap returns false if the fee is too high to be paid for filling a gap
+	FeeWithinCap(fee FEE) bool
+}
+
 type ResenderChainConfig interface {
 	RPCDefaultBatchSize() uint32
 }
@@ -31,6 +31,45 @@ type TxStrategy interface {
 	PruneQueue(ctx context.Context, pruneService UnstartedTxQueuePruner) (ids []int64, err error)
 }
 
+// TxPriority is the priority class of a tx. Unstarted txes with a higher priority are broadcast
+// before the ones with a lower priority from the same address, regardless of the order they were created in.
+type TxPriority int16
+
+const (
+	// TxPriorityLow is for txes which are fine to be delayed, such as keeper performs
+	TxPriorityLow TxPriority = iota - 1
+	// TxPriorityNormal is the default priority
+	TxPriorityNormal
+	// TxPriorityHigh is for time sensitive txes, such as OCR transmissions
+	TxPriorityHigh
+	// TxPriorityAdmin is for txes sent by the node operator
+	TxPriorityAdmin
+)
+
+var txPriorityStrings = map[TxPriority]string{
+	TxPriorityLow:    "low",
+	TxPriorityNormal: "normal",
+	TxPriorityHigh:   "high",
+	TxPriorityAdmin:  "admin",
+}
+
+// ParseTxPriority parses the name of a priority class, as returned by TxPriority.String.
+func ParseTxPriority(s string) (TxPriority, error) {
+	for p, str := range txPriorityStrings {
+		if strings.EqualFold(s, str) {
+			return p, nil
+		}
+	}
+	return TxPriorityNormal, fmt.Errorf("unknown tx priority %q: must be one of low, normal, high or admin", s)
+}
+
+func (p TxPriority) String() string {
+	if s, ok := txPriorityStrings[p]; ok {
+		return s
+	}
+	return fmt.Sprintf("TxPriority(%d)", int16(p))
+}
+
 type TxAttemptState int8
 
 type TxState string
@@ -93,6 +132,12 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Priority is the priority class of the tx, TxPriorityNormal if unset.
+	Priority TxPriority
+
+	// Blobs are posted alongside the tx if set. Only supported by EVM chains, where this makes it an EIP-4844 blob tx.
+	Blobs [][]byte
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -151,6 +196,9 @@ type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 	// Used for Keystone Workflows
 	WorkflowExecutionID *string `json:"WorkflowExecutionID,omitempty"`
 
+	// Used by the balance monitor for top-ups of sending keys, tracks the key that is topped up
+	TopUpKey *ADDR `json:"TopUpKey,omitempty"`
+
 	// Used only for forwarded txs, tracks the original destination address.
 	// When this is set, it indicates tx is forwarded through To address.
 	FwdrDestAddress *ADDR `json:"ForwarderDestAddress,omitempty"`
@@ -232,6 +280,12 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	Priority TxPriority
+	Blobs    [][]byte
+	// PreemptedByTxID is the ID of the tx that took over the sequence of this unconfirmed tx. The attempts of this tx
+	// are kept, but no longer bumped, until either tx is confirmed.
+	PreemptedByTxID *int64
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -344,3 +398,11 @@ type ErrorClassifier interface {
 	error
 	IsFatal() bool
 }
+
+// SequenceGapHeal is a gap in the sequences of FromAddress which was filled by sending a transaction to itself
+type SequenceGapHeal[ADDR types.Hashable, TX_HASH types.Hashable, SEQ types.Sequence, FEE feetypes.Fee] struct {
+	FromAddress ADDR
+	Sequence    SEQ
+	TxHash      TX_HASH
+	Fee         FEE
+}
@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTxAttemptState(t *testing.T) {
@@ -48,3 +50,26 @@ func TestTxAttemptState(t *testing.T) {
 		}
 	})
 }
+
+func TestTxPriority(t *testing.T) {
+	for p, s := range txPriorityStrings {
+		t.Run(s, func(t *testing.T) {
+			assert.Equal(t, s, p.String())
+
+			parsed, err := ParseTxPriority(s)
+			require.NoError(t, err)
+			assert.Equal(t, p, parsed)
+
+			parsed, err = ParseTxPriority(strings.ToUpper(s))
+			require.NoError(t, err)
+			assert.Equal(t, p, parsed)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		assert.Equal(t, "TxPriority(42)", TxPriority(42).String())
+
+		_, err := ParseTxPriority("urgent")
+		require.ErrorContains(t, err, `unknown tx priority "urgent"`)
+	})
+}
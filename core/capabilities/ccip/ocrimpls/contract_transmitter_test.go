@@ -428,9 +428,11 @@ func makeTestEvmTxm(
 	}
 
 	chainID := big.NewInt(1337)
+	reorgORM := headtracker.NewReorgORM(*chainID, db)
 	headSaver := headtracker.NewHeadSaver(
 		logger.NullLogger,
 		headtracker.NewORM(*chainID, db),
+		reorgORM,
 		evmConfig,
 		evmConfig.HeadTrackerConfig,
 	)
@@ -439,12 +441,17 @@ func makeTestEvmTxm(
 	require.NoError(t, broadcaster.Start(testutils.Context(t)), "failed to start head broadcaster")
 	t.Cleanup(func() { require.NoError(t, broadcaster.Close()) })
 
+	reorgBroadcaster := headtracker.NewReorgBroadcaster(logger.NullLogger, reorgORM)
+	require.NoError(t, reorgBroadcaster.Start(testutils.Context(t)), "failed to start reorg broadcaster")
+	t.Cleanup(func() { require.NoError(t, reorgBroadcaster.Close()) })
+
 	ht := headtracker.NewHeadTracker(
 		logger.NullLogger,
 		ethClient,
 		evmConfig,
 		evmConfig.HeadTrackerConfig,
 		broadcaster,
+		reorgBroadcaster,
 		headSaver,
 		mailbox.NewMonitor("contract_transmitter_test", logger.NullLogger),
 	)
@@ -478,7 +485,8 @@ func makeTestEvmTxm(
 		lp,
 		keyStore,
 		estimator,
-		ht)
+		ht,
+		nil)
 	require.NoError(t, err, "can't create tx manager")
 
 	_, unsub := broadcaster.Subscribe(txm)
@@ -547,6 +555,10 @@ func (t *TestHeadTrackerConfig) PersistenceEnabled() bool {
 	return true
 }
 
+func (t *TestHeadTrackerConfig) ReorgRetention() time.Duration {
+	return 0
+}
+
 var _ evmconfig.HeadTracker = (*TestHeadTrackerConfig)(nil)
 
 type TestEvmConfig struct {
@@ -618,6 +630,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Composite() evmconfig.CompositeEstimator {
+	return &TestCompositeEstimatorConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
@@ -627,6 +643,7 @@ func (g *TestGasEstimatorConfig) FeeCapDefault() *assets.Wei { return assets.GWe
 func (g *TestGasEstimatorConfig) PriceDefault() *assets.Wei  { return assets.GWei(1) }
 func (g *TestGasEstimatorConfig) TipCapDefault() *assets.Wei { return assets.GWei(1) }
 func (g *TestGasEstimatorConfig) TipCapMin() *assets.Wei     { return assets.GWei(1) }
+func (g *TestGasEstimatorConfig) BlobPriceMax() *assets.Wei  { return assets.GWei(1) }
 func (g *TestGasEstimatorConfig) LimitMax() uint64           { return 0 }
 func (g *TestGasEstimatorConfig) LimitMultiplier() float32   { return 1 }
 func (g *TestGasEstimatorConfig) BumpTxDepth() uint32        { return 42 }
@@ -670,6 +687,10 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestCompositeEstimatorConfig struct {
+	evmconfig.CompositeEstimator
+}
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -683,6 +704,12 @@ func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) Preemption() evmconfig.PreemptionConfig {
+	return &preemptionConfig{}
+}
+func (t *transactionsConfig) NonceGapHealing() evmconfig.NonceGapHealing {
+	return &nonceGapHealingConfig{}
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -690,6 +717,18 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type preemptionConfig struct {
+	evmconfig.PreemptionConfig
+}
+
+func (p *preemptionConfig) Enabled() bool { return false }
+
+type nonceGapHealingConfig struct {
+	evmconfig.NonceGapHealing
+}
+
+func (n *nonceGapHealingConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
 	RpcDefaultBatchSize uint32
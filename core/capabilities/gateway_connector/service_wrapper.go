@@ -77,6 +77,9 @@ func (e *ServiceWrapper) Start(ctx context.Context) error {
 		if idx == -1 {
 			return errors.New("key for configured node address not found")
 		}
+		if _, remote := enabledKeys[idx].SignerKeyID(); remote {
+			return errors.New("key for configured node address is held by the remote signer, the gateway connector requires a local key")
+		}
 		e.signerKey = enabledKeys[idx].ToEcdsaPrivKey()
 		if enabledKeys[idx].ID() != nodeAddress {
 			return errors.New("node address mismatch")
@@ -108,6 +108,7 @@ type chainClient struct {
 	logger       logger.SugaredLogger
 	chainType    chaintype.ChainType
 	clientErrors evmconfig.ClientErrors
+	hedgeDelay   time.Duration
 }
 
 func NewChainClient(
@@ -119,6 +120,7 @@ func NewChainClient(
 	chainID *big.Int,
 	clientErrors evmconfig.ClientErrors,
 	deathDeclarationDelay time.Duration,
+	hedgeDelay time.Duration, // how long idempotent reads wait for the active node before also trying another one; 0 disables hedging
 	chainType chaintype.ChainType,
 ) Client {
 	chainFamily := "EVM"
@@ -152,6 +154,7 @@ func NewChainClient(
 		logger:       logger.Sugared(lggr),
 		chainType:    chainType,
 		clientErrors: clientErrors,
+		hedgeDelay:   hedgeDelay,
 	}
 }
 
@@ -234,11 +237,9 @@ func (c *chainClient) BlockByHash(ctx context.Context, hash common.Hash) (b *typ
 
 // TODO-1663: return custom Block type instead of geth's once client.go is deprecated.
 func (c *chainClient) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Block, err error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return b, err
-	}
-	return r.BlockByNumberGeth(ctx, number)
+	return commonclient.DoHedged(ctx, c.multiNode, c.hedgeDelay, func(ctx context.Context, r *RPCClient) (*types.Block, error) {
+		return r.BlockByNumberGeth(ctx, number)
+	})
 }
 
 func (c *chainClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
@@ -250,11 +251,9 @@ func (c *chainClient) CallContext(ctx context.Context, result interface{}, metho
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.CallContract(ctx, msg, blockNumber)
+	return commonclient.DoHedged(ctx, c.multiNode, c.hedgeDelay, func(ctx context.Context, r *RPCClient) ([]byte, error) {
+		return r.CallContract(ctx, msg, blockNumber)
+	})
 }
 
 func (c *chainClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
@@ -298,11 +297,9 @@ func (c *chainClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (u
 	return r.EstimateGas(ctx, call)
 }
 func (c *chainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.FilterEvents(ctx, q)
+	return commonclient.DoHedged(ctx, c.multiNode, c.hedgeDelay, func(ctx context.Context, r *RPCClient) ([]types.Log, error) {
+		return r.FilterEvents(ctx, q)
+	})
 }
 
 func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *types.Header, err error) {
@@ -314,11 +311,9 @@ func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *ty
 }
 
 func (c *chainClient) HeaderByNumber(ctx context.Context, n *big.Int) (head *types.Header, err error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return head, err
-	}
-	return r.HeaderByNumber(ctx, n)
+	return commonclient.DoHedged(ctx, c.multiNode, c.hedgeDelay, func(ctx context.Context, r *RPCClient) (*types.Header, error) {
+		return r.HeaderByNumber(ctx, n)
+	})
 }
 
 func (c *chainClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.Head, error) {
@@ -330,11 +325,9 @@ func (c *chainClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.
 }
 
 func (c *chainClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.BlockByNumber(ctx, n)
+	return commonclient.DoHedged(ctx, c.multiNode, c.hedgeDelay, func(ctx context.Context, r *RPCClient) (*evmtypes.Head, error) {
+		return r.BlockByNumber(ctx, n)
+	})
 }
 
 func (c *chainClient) IsL2() bool {
@@ -461,12 +454,9 @@ func (c *chainClient) TransactionByHash(ctx context.Context, txHash common.Hash)
 
 // TODO-1663: return custom Receipt type instead of geth's once client.go is deprecated.
 func (c *chainClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return receipt, err
-	}
-	//return rpc.TransactionReceipt(ctx, txHash)
-	return r.TransactionReceiptGeth(ctx, txHash)
+	return commonclient.DoHedged(ctx, c.multiNode, c.hedgeDelay, func(ctx context.Context, r *RPCClient) (*types.Receipt, error) {
+		return r.TransactionReceiptGeth(ctx, txHash)
+	})
 }
 
 func (c *chainClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
@@ -38,10 +38,11 @@ func TestClientConfigBuilder(t *testing.T) {
 	finalityTagEnabled := ptr(true)
 	noNewHeadsThreshold := time.Second
 	newHeadsPollInterval := 0 * time.Second
+	hedgeDelay := 200 * time.Millisecond
 	chainCfg, nodePool, nodes, err := client.NewClientConfigs(selectionMode, leaseDuration, chainTypeStr, nodeConfigs,
 		pollFailureThreshold, pollInterval, syncThreshold, nodeIsSyncingEnabled, noNewHeadsThreshold, finalityDepth,
 		finalityTagEnabled, finalizedBlockOffset, enforceRepeatableRead, deathDeclarationDelay, noNewFinalizedBlocksThreshold,
-		pollInterval, newHeadsPollInterval)
+		pollInterval, newHeadsPollInterval, hedgeDelay)
 	require.NoError(t, err)
 
 	// Validate node pool configs
@@ -55,6 +56,7 @@ func TestClientConfigBuilder(t *testing.T) {
 	require.Equal(t, deathDeclarationDelay, nodePool.DeathDeclarationDelay())
 	require.Equal(t, pollInterval, nodePool.FinalizedBlockPollInterval())
 	require.Equal(t, newHeadsPollInterval, nodePool.NewHeadsPollInterval())
+	require.Equal(t, hedgeDelay, nodePool.HedgeDelay())
 
 	// Validate node configs
 	require.Equal(t, *nodeConfigs[0].Name, *nodes[0].Name)
@@ -103,10 +103,12 @@ var parity = ClientErrors{
 // Geth
 // See: https://github.com/ethereum/go-ethereum/blob/b9df7ecdc3d3685180ceb29665bab59e9f614da5/core/tx_pool.go#L516
 var gethFatal = regexp.MustCompile(`(: |^)(exceeds block gas limit|invalid sender|negative value|oversized data|gas uint64 overflow|intrinsic gas too low)$`)
+
+// The blob pool, which holds EIP-4844 blob transactions, adds details to some of the errors
 var geth = ClientErrors{
-	NonceTooLow:                       regexp.MustCompile(`(: |^)nonce too low$`),
-	NonceTooHigh:                      regexp.MustCompile(`(: |^)nonce too high$`),
-	ReplacementTransactionUnderpriced: regexp.MustCompile(`(: |^)replacement transaction underpriced$`),
+	NonceTooLow:                       regexp.MustCompile(`(: |^)nonce too low(: next nonce \d+, tx nonce \d+)?$`),
+	NonceTooHigh:                      regexp.MustCompile(`(: |^)nonce too high(: tx nonce \d+, gapped nonce \d+)?$`),
+	ReplacementTransactionUnderpriced: regexp.MustCompile(`(: |^)replacement transaction underpriced(: new tx (gas fee cap|gas tip cap|blob gas fee cap) \d+ <= \d+ queued.*)?$`),
 	TransactionAlreadyInMempool:       regexp.MustCompile(`(: |^)(?i)(known transaction|already known)`),
 	TerminallyUnderpriced:             regexp.MustCompile(`(: |^)transaction underpriced$`),
 	InsufficientEth:                   regexp.MustCompile(`(: |^)(insufficient funds for transfer|insufficient funds for gas \* price \+ value|insufficient balance for transfer|transaction would cause overdraft)$`),
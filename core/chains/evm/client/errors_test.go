@@ -35,6 +35,7 @@ func Test_Eth_Errors(t *testing.T) {
 	t.Run("IsNonceTooLowError", func(t *testing.T) {
 		tests := []errorCase{
 			{"nonce too low", true, "Geth"},
+			{"nonce too low: next nonce 5, tx nonce 3", true, "Geth blob pool"},
 			{"nonce too low: address 0x336394A3219e71D9d9bd18201d34E95C1Bb7122C, tx: 8089 state: 8090", true, "Arbitrum"},
 			{"Nonce too low", true, "Besu"},
 			{"nonce too low", true, "Erigon"},
@@ -67,6 +68,7 @@ func Test_Eth_Errors(t *testing.T) {
 			{"call failed: NonceGap, Future nonce. Expected nonce: 10", true, "Nethermind"},
 			{"nonce too high: address 0x336394A3219e71D9d9bd18201d34E95C1Bb7122C, tx: 8089 state: 8090", true, "Arbitrum"},
 			{"nonce too high", true, "Geth"},
+			{"nonce too high: tx nonce 9, gapped nonce 7", true, "Geth blob pool"},
 			{"nonce too high", true, "Erigon"},
 			{"nonce too high. allowed nonce range: 427 - 477, actual: 527", true, "zkSync"},
 			{"client error nonce too high", true, "tomlConfig"},
@@ -102,6 +104,7 @@ func Test_Eth_Errors(t *testing.T) {
 	t.Run("IsReplacementUnderpriced", func(t *testing.T) {
 		tests := []errorCase{
 			{"replacement transaction underpriced", true, "geth"},
+			{"replacement transaction underpriced: new tx blob gas fee cap 20 <= 10 queued + 100% replacement penalty", true, "Geth blob pool"},
 			{"Replacement transaction underpriced", true, "Besu"},
 			{"replacement transaction underpriced", true, "Erigon"},
 			{"replacement transaction underpriced", true, "Klaytn"},
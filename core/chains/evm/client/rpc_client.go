@@ -119,6 +119,9 @@ type RPCClient struct {
 	highestUserObservations commonclient.ChainInfo
 	// most recent chain info observed during current lifecycle (reseted on DisconnectAll)
 	latestChainInfo commonclient.ChainInfo
+
+	// onRequest is called with the timing of every request, see SetRequestObserver
+	onRequest func(duration time.Duration, light bool, err error)
 }
 
 var _ commonclient.RPCClient[*big.Int, *evmtypes.Head] = (*RPCClient)(nil)
@@ -169,6 +172,41 @@ func NewRPCClient(
 	return r
 }
 
+// lightRequests are the requests whose duration does not depend on their payload, so that their latency is comparable
+// across RPCs. They are keyed by JSON-RPC method for CallContext, and by call name for the other wrappers.
+var lightRequests = map[string]bool{
+	"web3_clientVersion":      true,
+	"eth_blockNumber":         true,
+	"eth_chainId":             true,
+	"eth_gasPrice":            true,
+	"eth_getBalance":          true,
+	"eth_getTransactionCount": true,
+	"BlockNumber":             true,
+	"BalanceAt":               true,
+	"NonceAt":                 true,
+	"PendingNonceAt":          true,
+	"SuggestGasPrice":         true,
+	"SuggestGasTipCap":        true,
+}
+
+// SetRequestObserver sets a function that is called after every request, so that the node can score the latency and
+// reliability of the RPC. Only light requests are timed, see lightRequests. Errors returned by the chain, like
+// reverts, are reported as successes, since the RPC did respond. It must be called before the RPCClient is used.
+func (r *RPCClient) SetRequestObserver(fn func(duration time.Duration, light bool, err error)) {
+	r.onRequest = fn
+}
+
+func (r *RPCClient) observeRequest(request string, duration time.Duration, err error) {
+	if r.onRequest == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+	var jsonErr rpc.Error
+	if errors.As(err, &jsonErr) {
+		err = nil
+	}
+	r.onRequest(duration, lightRequests[request], err)
+}
+
 func (r *RPCClient) Ping(ctx context.Context) error {
 	version, err := r.ClientVersion(ctx)
 	if err != nil {
@@ -293,6 +331,10 @@ func (r *RPCClient) logResult(
 	callName string,
 	results ...interface{},
 ) {
+	// CallContext observes its requests by method
+	if callName != "CallContext" {
+		r.observeRequest(callName, callDuration, err)
+	}
 	lggr = logger.With(lggr, "duration", callDuration, "rpcDomain", rpcDomain, "callName", callName)
 	promEVMPoolRPCNodeCalls.WithLabelValues(r.chainID.String(), r.name).Inc()
 	if err == nil {
@@ -362,6 +404,7 @@ func (r *RPCClient) CallContext(ctx context.Context, result interface{}, method
 	}
 	duration := time.Since(start)
 
+	r.observeRequest(method, duration, err)
 	r.logResult(lggr, err, duration, r.getRPCDomain(), "CallContext")
 
 	return err
@@ -727,6 +770,7 @@ func (r *RPCClient) ethGetBlockByNumber(ctx context.Context, number string, resu
 	}
 	duration := time.Since(start)
 
+	r.observeRequest(method, duration, err)
 	r.logResult(lggr, err, duration, r.getRPCDomain(), "CallContext")
 	return err
 }
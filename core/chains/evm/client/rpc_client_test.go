@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
@@ -487,6 +488,51 @@ func TestRPCClient_LatestFinalizedBlock(t *testing.T) {
 	assert.Equal(t, int64(0), latest.FinalizedBlockNumber)
 }
 
+func TestRPCClient_RequestObserver(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(tests.Context(t), tests.WaitTimeout(t))
+	defer cancel()
+
+	chainId := big.NewInt(123456)
+	wsURL := testutils.NewWSServer(t, chainId, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		switch method {
+		case "web3_clientVersion":
+			resp.Result = `"test"`
+		case "eth_call":
+			resp.Error.Code = 3
+			resp.Error.Message = "execution reverted"
+		}
+		return
+	}).WSURL()
+
+	rpc := client.NewRPCClient(client.TestNodePoolConfig{}, logger.Test(t), wsURL, nil, "rpc", 1, chainId, commonclient.Primary, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
+	type request struct {
+		light bool
+		err   error
+	}
+	var observed []request
+	rpc.SetRequestObserver(func(duration time.Duration, light bool, err error) {
+		observed = append(observed, request{light, err})
+	})
+	require.NoError(t, rpc.Dial(ctx))
+	defer rpc.Close()
+
+	_, err := rpc.ClientVersion(ctx)
+	require.NoError(t, err)
+
+	// the RPC responded, so errors returned by the chain are not held against it, and calls are not timed since
+	// their duration depends on their payload
+	_, err = rpc.CallContract(ctx, ethereum.CallMsg{To: &common.Address{}}, nil)
+	require.Error(t, err)
+
+	canceledCtx, cancelRequest := context.WithCancel(ctx)
+	cancelRequest()
+	_, err = rpc.ClientVersion(canceledCtx)
+	require.Error(t, err)
+
+	assert.Equal(t, []request{{light: true}, {light: false}}, observed)
+}
+
 func TestRpcClientLargePayloadTimeout(t *testing.T) {
 	t.Parallel()
 
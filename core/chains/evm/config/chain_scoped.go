@@ -49,7 +49,7 @@ func (e *EVMConfig) TOMLString() (string, error) {
 }
 
 func (e *EVMConfig) BalanceMonitor() BalanceMonitor {
-	return &balanceMonitorConfig{c: e.C.BalanceMonitor}
+	return &balanceMonitorConfig{c: e.C.BalanceMonitor, k: e.C.KeySpecific}
 }
 
 func (e *EVMConfig) Transactions() Transactions {
@@ -92,6 +92,10 @@ func (e *EVMConfig) LogBackfillBatchSize() uint32 {
 	return *e.C.LogBackfillBatchSize
 }
 
+func (e *EVMConfig) LogBackfillConcurrency() uint32 {
+	return *e.C.LogBackfillConcurrency
+}
+
 func (e *EVMConfig) LogPollInterval() time.Duration {
 	return e.C.LogPollInterval.Duration()
 }
@@ -1,11 +1,64 @@
 package config
 
-import "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
 
 type balanceMonitorConfig struct {
 	c toml.BalanceMonitor
+	k toml.KeySpecificConfig
 }
 
 func (b *balanceMonitorConfig) Enabled() bool {
 	return *b.c.Enabled
 }
+
+func (b *balanceMonitorConfig) TopUp() BalanceMonitorTopUp {
+	return &balanceMonitorTopUpConfig{c: b.c.TopUp, k: b.k}
+}
+
+type balanceMonitorTopUpConfig struct {
+	c toml.BalanceMonitorTopUp
+	k toml.KeySpecificConfig
+}
+
+func (t *balanceMonitorTopUpConfig) Enabled() bool {
+	return *t.c.Enabled
+}
+
+func (t *balanceMonitorTopUpConfig) TreasuryKey() *types.EIP55Address {
+	return t.c.TreasuryKey
+}
+
+// ThresholdKey returns the balance below which addr is topped up.
+func (t *balanceMonitorTopUpConfig) ThresholdKey(addr gethcommon.Address) *assets.Wei {
+	if ks := t.keySpecific(addr); ks != nil && ks.TopUpThreshold != nil {
+		return ks.TopUpThreshold
+	}
+	return t.c.Threshold
+}
+
+// TargetKey returns the balance that addr is topped up to.
+func (t *balanceMonitorTopUpConfig) TargetKey(addr gethcommon.Address) *assets.Wei {
+	if ks := t.keySpecific(addr); ks != nil && ks.TopUpTarget != nil {
+		return ks.TopUpTarget
+	}
+	return t.c.Target
+}
+
+func (t *balanceMonitorTopUpConfig) DailyCap() *assets.Wei {
+	return t.c.DailyCap
+}
+
+func (t *balanceMonitorTopUpConfig) keySpecific(addr gethcommon.Address) *toml.KeySpecificBalanceMonitor {
+	for i := range t.k {
+		if t.k[i].Key.Address() == addr {
+			return &t.k[i].BalanceMonitor
+		}
+	}
+	return nil
+}
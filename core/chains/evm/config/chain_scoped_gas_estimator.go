@@ -43,6 +43,10 @@ func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
 	return &feeHistoryConfig{c: g.c.FeeHistory}
 }
 
+func (g *gasEstimatorConfig) Composite() CompositeEstimator {
+	return &compositeEstimatorConfig{c: g.c.Composite}
+}
+
 func (g *gasEstimatorConfig) DAOracle() DAOracle {
 	return &daOracleConfig{c: g.c.DAOracle}
 }
@@ -111,6 +115,10 @@ func (g *gasEstimatorConfig) TipCapMin() *assets.Wei {
 	return g.c.TipCapMin
 }
 
+func (g *gasEstimatorConfig) BlobPriceMax() *assets.Wei {
+	return g.c.BlobPriceMax
+}
+
 func (g *gasEstimatorConfig) Mode() string {
 	return *g.c.Mode
 }
@@ -216,3 +224,26 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type compositeEstimatorConfig struct {
+	c toml.CompositeEstimator
+}
+
+func (c *compositeEstimatorConfig) Estimators() []string {
+	return *c.c.Estimators
+}
+
+func (c *compositeEstimatorConfig) Aggregation() toml.CompositeAggregation {
+	return *c.c.Aggregation
+}
+
+func (c *compositeEstimatorConfig) Weights() []uint32 {
+	if c.c.Weights == nil {
+		return nil
+	}
+	return *c.c.Weights
+}
+
+func (c *compositeEstimatorConfig) OutlierFactor() float64 {
+	return c.c.OutlierFactor.InexactFloat64()
+}
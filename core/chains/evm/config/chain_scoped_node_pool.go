@@ -51,3 +51,7 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+func (n *NodePoolConfig) HedgeDelay() time.Duration {
+	return n.C.HedgeDelay.Duration()
+}
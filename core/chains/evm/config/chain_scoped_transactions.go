@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -58,3 +59,39 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+func (t *transactionsConfig) Preemption() PreemptionConfig {
+	return &preemptionConfig{c: t.c.Preemption}
+}
+
+type preemptionConfig struct {
+	c toml.PreemptionConfig
+}
+
+func (p *preemptionConfig) Enabled() bool {
+	return *p.c.Enabled
+}
+
+func (p *preemptionConfig) MinPendingTime() time.Duration {
+	return p.c.MinPendingTime.Duration()
+}
+
+func (t *transactionsConfig) NonceGapHealing() NonceGapHealing {
+	return &nonceGapHealingConfig{c: t.c.NonceGapHealing}
+}
+
+type nonceGapHealingConfig struct {
+	c toml.NonceGapHealingConfig
+}
+
+func (n *nonceGapHealingConfig) Enabled() bool {
+	return *n.c.Enabled
+}
+
+func (n *nonceGapHealingConfig) Threshold() uint32 {
+	return *n.c.Threshold
+}
+
+func (n *nonceGapHealingConfig) PriceMax() *assets.Wei {
+	return n.c.PriceMax
+}
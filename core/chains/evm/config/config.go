@@ -9,6 +9,7 @@ import (
 
 	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
@@ -36,6 +37,7 @@ type EVM interface {
 	FlagsContractAddress() string
 	LinkContractAddress() string
 	LogBackfillBatchSize() uint32
+	LogBackfillConcurrency() uint32
 	LogKeepBlocksDepth() uint32
 	BackupLogPollerBlockDelay() uint64
 	LogPollInterval() time.Duration
@@ -78,10 +80,20 @@ type HeadTracker interface {
 	FinalityTagBypass() bool
 	MaxAllowedFinalityDepth() uint32
 	PersistenceEnabled() bool
+	ReorgRetention() time.Duration
 }
 
 type BalanceMonitor interface {
 	Enabled() bool
+	TopUp() BalanceMonitorTopUp
+}
+
+type BalanceMonitorTopUp interface {
+	Enabled() bool
+	TreasuryKey() *types.EIP55Address
+	ThresholdKey(gethcommon.Address) *assets.Wei
+	TargetKey(gethcommon.Address) *assets.Wei
+	DailyCap() *assets.Wei
 }
 
 type ClientErrors interface {
@@ -110,6 +122,17 @@ type Transactions interface {
 	MaxInFlight() uint32
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
+	Preemption() PreemptionConfig
+	NonceGapHealing() NonceGapHealing
+}
+
+// PreemptionConfig is shared with the chain agnostic broadcaster.
+type PreemptionConfig = txmgrtypes.PreemptionConfig
+
+type NonceGapHealing interface {
+	Enabled() bool
+	Threshold() uint32
+	PriceMax() *assets.Wei
 }
 
 type AutoPurgeConfig interface {
@@ -122,6 +145,7 @@ type AutoPurgeConfig interface {
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	Composite() CompositeEstimator
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -137,6 +161,7 @@ type GasEstimator interface {
 	PriceDefault() *assets.Wei
 	TipCapDefault() *assets.Wei
 	TipCapMin() *assets.Wei
+	BlobPriceMax() *assets.Wei
 	PriceMax() *assets.Wei
 	PriceMin() *assets.Wei
 	Mode() string
@@ -174,6 +199,13 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type CompositeEstimator interface {
+	Estimators() []string
+	Aggregation() toml.CompositeAggregation
+	Weights() []uint32
+	OutlierFactor() float64
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -192,6 +224,7 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	HedgeDelay() time.Duration
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
@@ -247,6 +247,17 @@ func TestChainScopedConfig_FeeHistory(t *testing.T) {
 	assert.Equal(t, 10*time.Second, u.CacheTimeout())
 }
 
+func TestChainScopedConfig_Composite(t *testing.T) {
+	t.Parallel()
+	cfg := testutils.NewTestChainScopedConfig(t, nil)
+
+	c := cfg.EVM().GasEstimator().Composite()
+	assert.Equal(t, []string{"BlockHistory", "FeeHistory", "SuggestedPrice"}, c.Estimators())
+	assert.Equal(t, toml.CompositeAggregationMedian, c.Aggregation())
+	assert.Nil(t, c.Weights())
+	assert.Equal(t, float64(3), c.OutlierFactor())
+}
+
 func TestChainScopedConfig_GasEstimator(t *testing.T) {
 	t.Parallel()
 	cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
@@ -344,6 +355,7 @@ func TestChainScopedConfig_HeadTracker(t *testing.T) {
 	assert.Equal(t, true, ht.FinalityTagBypass())
 	assert.Equal(t, uint32(10000), ht.MaxAllowedFinalityDepth())
 	assert.Equal(t, true, ht.PersistenceEnabled())
+	assert.Equal(t, 720*time.Hour, ht.ReorgRetention())
 }
 
 func TestNodePoolConfig(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/pelletier/go-toml/v2"
@@ -372,6 +373,7 @@ type Chain struct {
 	FlagsContractAddress         *types.EIP55Address
 	LinkContractAddress          *types.EIP55Address
 	LogBackfillBatchSize         *uint32
+	LogBackfillConcurrency       *uint32
 	LogPollInterval              *commonconfig.Duration
 	LogKeepBlocksDepth           *uint32
 	LogPrunePageSize             *uint32
@@ -412,6 +414,10 @@ func (c *Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FinalityDepth", Value: *c.FinalityDepth,
 			Msg: "must be greater than or equal to 1"})
 	}
+	if *c.LogBackfillConcurrency < 1 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "LogBackfillConcurrency", Value: *c.LogBackfillConcurrency,
+			Msg: "must be greater than or equal to 1"})
+	}
 	if *c.MinIncomingConfirmations < 1 {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "MinIncomingConfirmations", Value: *c.MinIncomingConfirmations,
 			Msg: "must be greater than or equal to 1"})
@@ -468,6 +474,29 @@ func (c *Chain) ValidateConfig() (err error) {
 		}
 	}
 
+	if c.Transactions.NonceGapHealing.Enabled != nil && *c.Transactions.NonceGapHealing.Enabled {
+		if c.Transactions.NonceGapHealing.Threshold == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Transactions.NonceGapHealing.Threshold", Msg: "needs to be set if nonce gap healing is enabled"})
+		} else if *c.Transactions.NonceGapHealing.Threshold == 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Transactions.NonceGapHealing.Threshold", Value: 0, Msg: "cannot be 0 if nonce gap healing is enabled"})
+		}
+	}
+
+	// key specific top-up thresholds and targets default to the chain's, so check the effective values of each key
+	for i, ks := range c.KeySpecific {
+		threshold, target := c.BalanceMonitor.TopUp.Threshold, c.BalanceMonitor.TopUp.Target
+		if v := ks.BalanceMonitor.TopUpThreshold; v != nil {
+			threshold = v
+		}
+		if v := ks.BalanceMonitor.TopUpTarget; v != nil {
+			target = v
+		}
+		if threshold != nil && target != nil && target.Cmp(threshold) < 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("KeySpecific.%d.BalanceMonitor.TopUpTarget", i), Value: target,
+				Msg: "must be greater than or equal to TopUpThreshold"})
+		}
+	}
+
 	return
 }
 
@@ -479,7 +508,9 @@ type Transactions struct {
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge       AutoPurgeConfig       `toml:",omitempty"`
+	Preemption      PreemptionConfig      `toml:",omitempty"`
+	NonceGapHealing NonceGapHealingConfig `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -502,6 +533,8 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.Preemption.setFrom(&f.Preemption)
+	t.NonceGapHealing.setFrom(&f.NonceGapHealing)
 }
 
 type AutoPurgeConfig struct {
@@ -526,6 +559,38 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+type PreemptionConfig struct {
+	Enabled        *bool
+	MinPendingTime *commonconfig.Duration
+}
+
+func (p *PreemptionConfig) setFrom(f *PreemptionConfig) {
+	if v := f.Enabled; v != nil {
+		p.Enabled = v
+	}
+	if v := f.MinPendingTime; v != nil {
+		p.MinPendingTime = v
+	}
+}
+
+type NonceGapHealingConfig struct {
+	Enabled   *bool
+	Threshold *uint32
+	PriceMax  *assets.Wei
+}
+
+func (n *NonceGapHealingConfig) setFrom(f *NonceGapHealingConfig) {
+	if v := f.Enabled; v != nil {
+		n.Enabled = v
+	}
+	if v := f.Threshold; v != nil {
+		n.Threshold = v
+	}
+	if v := f.PriceMax; v != nil {
+		n.PriceMax = v
+	}
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -565,12 +630,56 @@ func (m *Workflow) setFrom(f *Workflow) {
 
 type BalanceMonitor struct {
 	Enabled *bool
+	TopUp   BalanceMonitorTopUp `toml:",omitempty"`
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	m.TopUp.setFrom(&f.TopUp)
+}
+
+type BalanceMonitorTopUp struct {
+	Enabled     *bool
+	TreasuryKey *types.EIP55Address
+	Threshold   *assets.Wei
+	Target      *assets.Wei
+	DailyCap    *assets.Wei
+}
+
+func (t *BalanceMonitorTopUp) setFrom(f *BalanceMonitorTopUp) {
+	if v := f.Enabled; v != nil {
+		t.Enabled = v
+	}
+	if v := f.TreasuryKey; v != nil {
+		t.TreasuryKey = v
+	}
+	if v := f.Threshold; v != nil {
+		t.Threshold = v
+	}
+	if v := f.Target; v != nil {
+		t.Target = v
+	}
+	if v := f.DailyCap; v != nil {
+		t.DailyCap = v
+	}
+}
+
+func (t *BalanceMonitorTopUp) ValidateConfig() (err error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return
+	}
+	if t.TreasuryKey == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "TreasuryKey", Msg: "must be set if top-ups are enabled"})
+	}
+	if t.Threshold != nil && t.Target != nil && t.Target.Cmp(t.Threshold) < 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Target", Value: t.Target, Msg: "must be greater than or equal to Threshold"})
+	}
+	if t.DailyCap != nil && t.DailyCap.IsZero() {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "DailyCap", Value: t.DailyCap, Msg: "must be greater than zero if top-ups are enabled"})
+	}
+	return
 }
 
 type GasEstimator struct {
@@ -598,8 +707,11 @@ type GasEstimator struct {
 	TipCapDefault *assets.Wei
 	TipCapMin     *assets.Wei
 
+	BlobPriceMax *assets.Wei
+
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	Composite    CompositeEstimator    `toml:",omitempty"`
 	DAOracle     DAOracle              `toml:",omitempty"`
 }
 
@@ -637,6 +749,13 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "Composite" {
+		err = multierr.Append(err, e.Composite.validate())
+		if slices.Contains(*e.Composite.Estimators, "BlockHistory") && *e.BlockHistory.BlockHistorySize <= 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
+				Msg: "must be greater than or equal to 1 with BlockHistory in Composite.Estimators"})
+		}
+	}
 
 	return
 }
@@ -687,6 +806,9 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	if v := f.TipCapMin; v != nil {
 		e.TipCapMin = v
 	}
+	if v := f.BlobPriceMax; v != nil {
+		e.BlobPriceMax = v
+	}
 	if v := f.PriceMax; v != nil {
 		e.PriceMax = v
 	}
@@ -696,6 +818,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.Composite.setFrom(&f.Composite)
 	e.DAOracle.setFrom(&f.DAOracle)
 }
 
@@ -769,6 +892,90 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+type CompositeEstimator struct {
+	Estimators    *[]string
+	Aggregation   *CompositeAggregation
+	Weights       *[]uint32
+	OutlierFactor *decimal.Decimal
+}
+
+type CompositeAggregation string
+
+const (
+	CompositeAggregationMax      = CompositeAggregation("Max")
+	CompositeAggregationMedian   = CompositeAggregation("Median")
+	CompositeAggregationWeighted = CompositeAggregation("Weighted")
+)
+
+func (a CompositeAggregation) IsValid() bool {
+	switch a {
+	case CompositeAggregationMax, CompositeAggregationMedian, CompositeAggregationWeighted:
+		return true
+	}
+	return false
+}
+
+// compositeEstimatorModes are the estimator modes that can be combined by the Composite estimator.
+var compositeEstimatorModes = []string{"Arbitrum", "BlockHistory", "FeeHistory", "FixedPrice", "L2Suggested", "SuggestedPrice"}
+
+func (c *CompositeEstimator) validate() (err error) {
+	if len(*c.Estimators) < 2 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Estimators", Value: *c.Estimators,
+			Msg: "must contain at least 2 estimators with Composite Mode"})
+	}
+	seen := map[string]struct{}{}
+	for _, m := range *c.Estimators {
+		if !slices.Contains(compositeEstimatorModes, m) {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Estimators", Value: m,
+				Msg: fmt.Sprintf("must be one of: %s", strings.Join(compositeEstimatorModes, ", "))})
+		}
+		if _, ok := seen[m]; ok {
+			err = multierr.Append(err, commonconfig.NewErrDuplicate("Composite.Estimators", m))
+		}
+		seen[m] = struct{}{}
+	}
+	if !c.Aggregation.IsValid() {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Aggregation", Value: *c.Aggregation,
+			Msg: "must be one of: Max, Median, Weighted"})
+	} else if *c.Aggregation == CompositeAggregationWeighted {
+		if c.Weights == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "Composite.Weights", Msg: "must be set with Weighted Aggregation"})
+		} else if len(*c.Weights) != len(*c.Estimators) {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Weights", Value: *c.Weights,
+				Msg: "must contain one weight per estimator with Weighted Aggregation"})
+		} else {
+			var total uint64
+			for _, w := range *c.Weights {
+				total += uint64(w)
+			}
+			if total == 0 {
+				err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.Weights", Value: *c.Weights,
+					Msg: "must not all be zero"})
+			}
+		}
+	}
+	if c.OutlierFactor.IsNegative() || (!c.OutlierFactor.IsZero() && c.OutlierFactor.LessThanOrEqual(decimal.NewFromInt(1))) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Composite.OutlierFactor", Value: c.OutlierFactor,
+			Msg: "must be greater than 1, or 0 to disable the outlier guard"})
+	}
+	return
+}
+
+func (c *CompositeEstimator) setFrom(f *CompositeEstimator) {
+	if v := f.Estimators; v != nil {
+		c.Estimators = v
+	}
+	if v := f.Aggregation; v != nil {
+		c.Aggregation = v
+	}
+	if v := f.Weights; v != nil {
+		c.Weights = v
+	}
+	if v := f.OutlierFactor; v != nil {
+		c.OutlierFactor = v
+	}
+}
+
 type DAOracle struct {
 	OracleType             DAOracleType
 	OracleAddress          *types.EIP55Address
@@ -816,8 +1023,9 @@ func (ks KeySpecificConfig) ValidateConfig() (err error) {
 }
 
 type KeySpecific struct {
-	Key          *types.EIP55Address
-	GasEstimator KeySpecificGasEstimator `toml:",omitempty"`
+	Key            *types.EIP55Address
+	GasEstimator   KeySpecificGasEstimator   `toml:",omitempty"`
+	BalanceMonitor KeySpecificBalanceMonitor `toml:",omitempty"`
 }
 
 type KeySpecificGasEstimator struct {
@@ -830,6 +1038,20 @@ func (e *KeySpecificGasEstimator) setFrom(f *KeySpecificGasEstimator) {
 	}
 }
 
+type KeySpecificBalanceMonitor struct {
+	TopUpThreshold *assets.Wei
+	TopUpTarget    *assets.Wei
+}
+
+func (m *KeySpecificBalanceMonitor) setFrom(f *KeySpecificBalanceMonitor) {
+	if v := f.TopUpThreshold; v != nil {
+		m.TopUpThreshold = v
+	}
+	if v := f.TopUpTarget; v != nil {
+		m.TopUpTarget = v
+	}
+}
+
 type HeadTracker struct {
 	HistoryDepth            *uint32
 	MaxBufferSize           *uint32
@@ -837,6 +1059,7 @@ type HeadTracker struct {
 	MaxAllowedFinalityDepth *uint32
 	FinalityTagBypass       *bool
 	PersistenceEnabled      *bool
+	ReorgRetention          *commonconfig.Duration
 }
 
 func (t *HeadTracker) setFrom(f *HeadTracker) {
@@ -858,6 +1081,9 @@ func (t *HeadTracker) setFrom(f *HeadTracker) {
 	if v := f.PersistenceEnabled; v != nil {
 		t.PersistenceEnabled = v
 	}
+	if v := f.ReorgRetention; v != nil {
+		t.ReorgRetention = v
+	}
 }
 
 func (t *HeadTracker) ValidateConfig() (err error) {
@@ -948,6 +1174,7 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	HedgeDelay                 *commonconfig.Duration
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -985,6 +1212,10 @@ func (p *NodePool) setFrom(f *NodePool) {
 		p.NewHeadsPollInterval = v
 	}
 
+	if v := f.HedgeDelay; v != nil {
+		p.HedgeDelay = v
+	}
+
 	p.Errors.setFrom(&f.Errors)
 }
 
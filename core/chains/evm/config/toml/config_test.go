@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
 func TestEVMConfig_ValidateConfig(t *testing.T) {
@@ -29,3 +32,86 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestBalanceMonitorTopUp_ValidateConfig(t *testing.T) {
+	enabled := true
+	treasury := types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+
+	assert.NoError(t, config.Validate(&toml.BalanceMonitorTopUp{}))
+	assert.NoError(t, config.Validate(&toml.BalanceMonitorTopUp{Enabled: &enabled, TreasuryKey: &treasury,
+		Threshold: assets.NewWeiI(1), Target: assets.NewWeiI(2), DailyCap: assets.NewWeiI(10)}))
+
+	err := config.Validate(&toml.BalanceMonitorTopUp{Enabled: &enabled,
+		Threshold: assets.NewWeiI(2), Target: assets.NewWeiI(1), DailyCap: assets.NewWeiI(0)})
+	assert.ErrorContains(t, err, "TreasuryKey: missing")
+	assert.ErrorContains(t, err, "Target: invalid value")
+	assert.ErrorContains(t, err, "DailyCap: invalid value")
+}
+
+func TestGasEstimator_ValidateConfig_Composite(t *testing.T) {
+	newConfig := func() toml.GasEstimator {
+		c := toml.Defaults(nil).GasEstimator
+		mode := "Composite"
+		c.Mode = &mode
+		return c
+	}
+
+	c := newConfig()
+	assert.NoError(t, config.Validate(&c))
+
+	c = newConfig()
+	weighted := toml.CompositeAggregationWeighted
+	c.Composite.Aggregation = &weighted
+	c.Composite.Weights = &[]uint32{1, 0, 2}
+	assert.NoError(t, config.Validate(&c))
+
+	c = newConfig()
+	c.Composite.Estimators = &[]string{"BlockHistory", "BlockHistory", "Composite"}
+	c.Composite.Aggregation = &weighted
+	c.Composite.Weights = &[]uint32{1}
+	c.Composite.OutlierFactor = ptr(decimal.NewFromInt(1))
+	err := config.Validate(&c)
+	assert.ErrorContains(t, err, "Composite.Estimators: invalid value (Composite)")
+	assert.ErrorContains(t, err, "Composite.Estimators: invalid value (BlockHistory): duplicate")
+	assert.ErrorContains(t, err, "Composite.Weights: invalid value")
+	assert.ErrorContains(t, err, "Composite.OutlierFactor: invalid value")
+
+	c = newConfig()
+	c.Composite.Estimators = &[]string{"SuggestedPrice"}
+	c.Composite.Aggregation = &weighted
+	err = config.Validate(&c)
+	assert.ErrorContains(t, err, "Composite.Estimators: invalid value")
+	assert.ErrorContains(t, err, "Composite.Weights: missing")
+}
+
+func ptr[T any](t T) *T { return &t }
+
+func TestChain_ValidateConfig_KeySpecificTopUp(t *testing.T) {
+	key := types.MustEIP55Address("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	chain := toml.Defaults(nil)
+	chain.BalanceMonitor.TopUp.Threshold = assets.NewWeiI(10)
+	chain.BalanceMonitor.TopUp.Target = assets.NewWeiI(20)
+
+	chain.KeySpecific = toml.KeySpecificConfig{{Key: &key, BalanceMonitor: toml.KeySpecificBalanceMonitor{TopUpTarget: assets.NewWeiI(15)}}}
+	assert.NoError(t, chain.ValidateConfig())
+
+	// the key's target is below the chain's threshold
+	chain.KeySpecific = toml.KeySpecificConfig{{Key: &key, BalanceMonitor: toml.KeySpecificBalanceMonitor{TopUpTarget: assets.NewWeiI(5)}}}
+	assert.ErrorContains(t, chain.ValidateConfig(), "KeySpecific.0.BalanceMonitor.TopUpTarget: invalid value")
+
+	// the key's threshold is above the chain's target
+	chain.KeySpecific = toml.KeySpecificConfig{{Key: &key, BalanceMonitor: toml.KeySpecificBalanceMonitor{TopUpThreshold: assets.NewWeiI(30)}}}
+	assert.ErrorContains(t, chain.ValidateConfig(), "KeySpecific.0.BalanceMonitor.TopUpTarget: invalid value")
+}
+
+func TestChain_ValidateConfig_NonceGapHealing(t *testing.T) {
+	chain := toml.Defaults(nil)
+	chain.Transactions.NonceGapHealing.Threshold = ptr[uint32](0)
+	assert.NoError(t, chain.ValidateConfig())
+
+	chain.Transactions.NonceGapHealing.Enabled = ptr(true)
+	assert.ErrorContains(t, chain.ValidateConfig(), "Transactions.NonceGapHealing.Threshold: invalid value (0)")
+
+	chain.Transactions.NonceGapHealing.Threshold = ptr[uint32](5)
+	assert.NoError(t, chain.ValidateConfig())
+}
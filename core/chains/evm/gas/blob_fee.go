@@ -0,0 +1,57 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+)
+
+const (
+	// BlobFeeCapMultiplier is applied to the current blob base fee to get the maxFeePerBlobGas of new blob transactions.
+	// The blob base fee rises by at most 12.5% per block, so this keeps a transaction includable for several full blocks.
+	BlobFeeCapMultiplier = 2
+	// BlobTxPriceBumpPercent is the minimum increase of every fee cap the blob pool requires to replace a blob transaction,
+	// see: https://github.com/ethereum/go-ethereum/blob/v1.13.8/core/txpool/blobpool/config.go#L37
+	BlobTxPriceBumpPercent = 100
+)
+
+// GetBlobFee returns BlobFeeCapMultiplier times the current blob base fee, capped at BlobPriceMax.
+// It fails if the blob base fee alone exceeds BlobPriceMax, since such a transaction could not be included.
+func (e *evmFeeEstimator) GetBlobFee(ctx context.Context) (*assets.Wei, error) {
+	var blobBaseFee hexutil.Big
+	if err := e.ethClient.CallContext(ctx, &blobBaseFee, "eth_blobBaseFee"); err != nil {
+		return nil, fmt.Errorf("failed to fetch blob base fee: %w", err)
+	}
+	baseFee := assets.NewWei((*big.Int)(&blobBaseFee))
+	maxBlobFee := e.geCfg.BlobPriceMax()
+	if baseFee.Cmp(maxBlobFee) > 0 {
+		return nil, fmt.Errorf("blob base fee of %s exceeds BlobPriceMax of %s", baseFee, maxBlobFee)
+	}
+	return assets.WeiMin(baseFee.Mul(big.NewInt(BlobFeeCapMultiplier)), maxBlobFee), nil
+}
+
+// bumpBlobTxFee makes sure a bumped blob transaction fee is accepted as a replacement by the blob pool, which requires
+// every fee cap to be raised by BlobTxPriceBumpPercent rather than the usual BumpPercent.
+func (e *evmFeeEstimator) bumpBlobTxFee(ctx context.Context, original, bumped EvmFee, maxFeePrice *assets.Wei) (EvmFee, error) {
+	bumped.GasTipCap = assets.WeiMax(bumped.GasTipCap, original.GasTipCap.AddPercentage(BlobTxPriceBumpPercent))
+	bumped.GasFeeCap = assets.WeiMax(bumped.GasFeeCap, original.GasFeeCap.AddPercentage(BlobTxPriceBumpPercent))
+	if bumped.GasFeeCap.Cmp(maxFeePrice) > 0 {
+		return bumped, fmt.Errorf("bumped blob tx gas fee cap of %s would exceed configured max gas price of %s: %w", bumped.GasFeeCap, maxFeePrice, commonfee.ErrBumpFeeExceedsLimit)
+	}
+
+	bumped.BlobFeeCap = original.BlobFeeCap.AddPercentage(BlobTxPriceBumpPercent)
+	current, err := e.GetBlobFee(ctx)
+	if err != nil {
+		return bumped, err
+	}
+	bumped.BlobFeeCap = assets.WeiMax(bumped.BlobFeeCap, current)
+	if maxBlobFee := e.geCfg.BlobPriceMax(); bumped.BlobFeeCap.Cmp(maxBlobFee) > 0 {
+		return bumped, fmt.Errorf("bumped blob fee cap of %s would exceed BlobPriceMax of %s: %w", bumped.BlobFeeCap, maxBlobFee, commonfee.ErrBumpFeeExceedsLimit)
+	}
+	return bumped, nil
+}
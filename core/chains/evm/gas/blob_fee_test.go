@@ -0,0 +1,90 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+)
+
+func TestEvmFeeEstimator_BlobFees(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	maxPrice := assets.GWei(100)
+
+	newEstimator := func(t *testing.T, blobBaseFee int64) gas.EvmFeeEstimator {
+		est := mocks.NewEvmEstimator(t)
+		est.On("BumpDynamicFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.DynamicFee{GasFeeCap: assets.GWei(12), GasTipCap: assets.GWei(2)}, nil).Maybe()
+		geCfg := gas.NewMockGasConfig()
+		geCfg.LimitMultiplierF = 1
+		geCfg.BlobPriceMaxF = assets.GWei(10)
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_blobBaseFee").Run(func(args mock.Arguments) {
+			res := args.Get(1).(*hexutil.Big)
+			(*big.Int)(res).SetInt64(blobBaseFee)
+		}).Return(nil).Maybe()
+		return gas.NewEvmFeeEstimator(logger.Test(t), func(logger.Logger) gas.EvmEstimator { return est }, true, geCfg, ethClient)
+	}
+
+	t.Run("GetBlobFee leaves headroom above the blob base fee", func(t *testing.T) {
+		fee, err := newEstimator(t, 2e9).GetBlobFee(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(4), fee)
+	})
+
+	t.Run("GetBlobFee is capped at BlobPriceMax", func(t *testing.T) {
+		fee, err := newEstimator(t, 8e9).GetBlobFee(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(10), fee)
+	})
+
+	t.Run("GetBlobFee fails if the blob base fee exceeds BlobPriceMax", func(t *testing.T) {
+		_, err := newEstimator(t, 11e9).GetBlobFee(ctx)
+		require.ErrorContains(t, err, "exceeds BlobPriceMax")
+	})
+
+	original := gas.EvmFee{
+		DynamicFee: gas.DynamicFee{GasFeeCap: assets.GWei(10), GasTipCap: assets.GWei(1)},
+		BlobFeeCap: assets.GWei(2),
+	}
+
+	t.Run("BumpFee doubles every fee cap of blob txs", func(t *testing.T) {
+		bumped, _, err := newEstimator(t, 1e9).BumpFee(ctx, original, 100, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(20), bumped.GasFeeCap)
+		assert.Equal(t, assets.GWei(2), bumped.GasTipCap)
+		assert.Equal(t, assets.GWei(4), bumped.BlobFeeCap)
+	})
+
+	t.Run("BumpFee uses the current blob fee if it is higher", func(t *testing.T) {
+		bumped, _, err := newEstimator(t, 3e9).BumpFee(ctx, original, 100, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(6), bumped.BlobFeeCap)
+	})
+
+	t.Run("BumpFee fails if the blob fee cap would exceed BlobPriceMax", func(t *testing.T) {
+		fee := original
+		fee.BlobFeeCap = assets.GWei(6)
+		_, _, err := newEstimator(t, 1e9).BumpFee(ctx, fee, 100, maxPrice, nil)
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+	})
+
+	t.Run("BumpFee fails if the gas fee cap would exceed the max price", func(t *testing.T) {
+		_, _, err := newEstimator(t, 1e9).BumpFee(ctx, original, 100, assets.GWei(15), nil)
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+	})
+}
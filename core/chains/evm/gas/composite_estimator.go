@@ -0,0 +1,322 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var (
+	_ EvmEstimator = &CompositeEstimator{}
+
+	promCompositeOutliers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_updater_composite_outliers",
+		Help: "Number of estimates left out by the Composite estimator for being outliers",
+	},
+		[]string{"evmChainID", "estimator"},
+	)
+	promCompositeFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_updater_composite_failures",
+		Help: "Number of estimates that failed in the Composite estimator",
+	},
+		[]string{"evmChainID", "estimator"},
+	)
+)
+
+type compositeEstimatorConfig interface {
+	Aggregation() toml.CompositeAggregation
+	Weights() []uint32
+	OutlierFactor() float64
+}
+
+// CompositeEstimator is an Estimator which runs several estimators in parallel and combines their estimates according
+// to the configured Aggregation. Before they are combined, estimates more than OutlierFactor times above or below the
+// median estimate are left out, so that a single estimator returning an outlier does not skew the result.
+type CompositeEstimator struct {
+	services.StateMachine
+
+	lggr       logger.SugaredLogger
+	cfg        compositeEstimatorConfig
+	chainID    *big.Int
+	names      []string
+	estimators []EvmEstimator
+	l1Oracle   rollups.L1Oracle
+}
+
+// NewCompositeEstimator returns a new Estimator combining estimators, which are identified by names in logs and metrics.
+func NewCompositeEstimator(lggr logger.Logger, cfg compositeEstimatorConfig, chainID *big.Int, names []string, estimators []EvmEstimator, l1Oracle rollups.L1Oracle) EvmEstimator {
+	return &CompositeEstimator{
+		lggr:       logger.Sugared(logger.Named(lggr, "CompositeEstimator")),
+		cfg:        cfg,
+		chainID:    chainID,
+		names:      names,
+		estimators: estimators,
+		l1Oracle:   l1Oracle,
+	}
+}
+
+func (c *CompositeEstimator) Name() string {
+	return c.lggr.Name()
+}
+
+func (c *CompositeEstimator) L1Oracle() rollups.L1Oracle {
+	return c.l1Oracle
+}
+
+func (c *CompositeEstimator) Start(ctx context.Context) error {
+	return c.StartOnce("CompositeEstimator", func() error {
+		var ms services.MultiStart
+		for _, e := range c.estimators {
+			if err := ms.Start(ctx, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (c *CompositeEstimator) Close() error {
+	return c.StopOnce("CompositeEstimator", func() error {
+		return services.MultiCloser(c.estimators).Close()
+	})
+}
+
+func (c *CompositeEstimator) Ready() error {
+	for _, e := range c.estimators {
+		if err := e.Ready(); err != nil {
+			return err
+		}
+	}
+	return c.StateMachine.Ready()
+}
+
+func (c *CompositeEstimator) HealthReport() map[string]error {
+	report := map[string]error{c.Name(): c.Healthy()}
+	for _, e := range c.estimators {
+		services.CopyHealth(report, e.HealthReport())
+	}
+	return report
+}
+
+func (c *CompositeEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	for _, e := range c.estimators {
+		e.OnNewLongestChain(ctx, head)
+	}
+}
+
+func (c *CompositeEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...feetypes.Opt) (*assets.Wei, uint64, error) {
+	results := runEstimators(c, func(e EvmEstimator) (legacyEstimate, error) {
+		price, limit, err := e.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+		return legacyEstimate{price, limit}, err
+	})
+	return c.combineLegacy(results, "GetLegacyGas")
+}
+
+func (c *CompositeEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (*assets.Wei, uint64, error) {
+	results := runEstimators(c, func(e EvmEstimator) (legacyEstimate, error) {
+		price, limit, err := e.BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxGasPriceWei, attempts)
+		return legacyEstimate{price, limit}, err
+	})
+	return c.combineLegacy(results, "BumpLegacyGas")
+}
+
+func (c *CompositeEstimator) GetDynamicFee(ctx context.Context, maxGasPriceWei *assets.Wei) (DynamicFee, error) {
+	results := runEstimators(c, func(e EvmEstimator) (DynamicFee, error) {
+		return e.GetDynamicFee(ctx, maxGasPriceWei)
+	})
+	return c.combineDynamic(results, "GetDynamicFee")
+}
+
+func (c *CompositeEstimator) BumpDynamicFee(ctx context.Context, original DynamicFee, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (DynamicFee, error) {
+	results := runEstimators(c, func(e EvmEstimator) (DynamicFee, error) {
+		return e.BumpDynamicFee(ctx, original, maxGasPriceWei, attempts)
+	})
+	return c.combineDynamic(results, "BumpDynamicFee")
+}
+
+type legacyEstimate struct {
+	price *assets.Wei
+	limit uint64
+}
+
+type estimatorResult[T any] struct {
+	index int
+	value T
+	err   error
+}
+
+// runEstimators calls fn with every estimator in parallel and returns the results in the order of the estimators.
+func runEstimators[T any](c *CompositeEstimator, fn func(EvmEstimator) (T, error)) []estimatorResult[T] {
+	results := make([]estimatorResult[T], len(c.estimators))
+	var wg sync.WaitGroup
+	for i, e := range c.estimators {
+		wg.Add(1)
+		go func(i int, e EvmEstimator) {
+			defer wg.Done()
+			v, err := fn(e)
+			results[i] = estimatorResult[T]{index: i, value: v, err: err}
+		}(i, e)
+	}
+	wg.Wait()
+	return results
+}
+
+// succeeded returns the successful results, or an error joining all the errors if there are none. ErrConnectivity is
+// returned if any estimator detected a connectivity problem, as it would have been without the Composite estimator.
+func succeeded[T any](c *CompositeEstimator, results []estimatorResult[T], method string) ([]estimatorResult[T], error) {
+	var ok []estimatorResult[T]
+	var errs []error
+	for _, r := range results {
+		if r.err != nil {
+			if errors.Is(r.err, commonfee.ErrConnectivity) {
+				return nil, r.err
+			}
+			c.lggr.Warnw("Estimator failed", "estimator", c.names[r.index], "method", method, "err", r.err)
+			promCompositeFailures.WithLabelValues(c.chainID.String(), c.names[r.index]).Inc()
+			errs = append(errs, fmt.Errorf("%s: %w", c.names[r.index], r.err))
+			continue
+		}
+		ok = append(ok, r)
+	}
+	if len(ok) == 0 {
+		return nil, fmt.Errorf("all estimators failed: %w", errors.Join(errs...))
+	}
+	return ok, nil
+}
+
+func (c *CompositeEstimator) combineLegacy(results []estimatorResult[legacyEstimate], method string) (*assets.Wei, uint64, error) {
+	ok, err := succeeded(c, results, method)
+	if err != nil {
+		return nil, 0, err
+	}
+	prices := make([]*assets.Wei, len(ok))
+	indexes := make([]int, len(ok))
+	var limit uint64
+	for i, r := range ok {
+		prices[i], indexes[i] = r.value.price, r.index
+		limit = max(limit, r.value.limit)
+	}
+	kept := c.withoutOutliers(indexes, prices, method)
+	price := c.aggregate(kept, indexes, prices)
+	c.lggr.Debugw("Combined estimates", "method", method, "gasPrice", price, "estimates", c.describe(indexes, prices))
+	return price, limit, nil
+}
+
+func (c *CompositeEstimator) combineDynamic(results []estimatorResult[DynamicFee], method string) (DynamicFee, error) {
+	ok, err := succeeded(c, results, method)
+	if err != nil {
+		return DynamicFee{}, err
+	}
+	feeCaps := make([]*assets.Wei, len(ok))
+	tipCaps := make([]*assets.Wei, len(ok))
+	indexes := make([]int, len(ok))
+	for i, r := range ok {
+		feeCaps[i], tipCaps[i], indexes[i] = r.value.GasFeeCap, r.value.GasTipCap, r.index
+	}
+	// outliers are detected on the fee cap, and left out for both caps so that the tip cap never exceeds the fee cap
+	kept := c.withoutOutliers(indexes, feeCaps, method)
+	fee := DynamicFee{
+		GasFeeCap: c.aggregate(kept, indexes, feeCaps),
+		GasTipCap: c.aggregate(kept, indexes, tipCaps),
+	}
+	c.lggr.Debugw("Combined estimates", "method", method, "fee", fee, "feeCaps", c.describe(indexes, feeCaps), "tipCaps", c.describe(indexes, tipCaps))
+	return fee, nil
+}
+
+// withoutOutliers returns the positions of values that are within OutlierFactor of their median. At least three values
+// are needed to tell which one is the outlier, so fewer are all kept.
+func (c *CompositeEstimator) withoutOutliers(indexes []int, values []*assets.Wei, method string) []int {
+	all := make([]int, len(values))
+	for i := range values {
+		all[i] = i
+	}
+	factor := c.cfg.OutlierFactor()
+	if factor == 0 || len(values) < 3 {
+		return all
+	}
+	m := new(big.Float).SetInt(median(all, values).ToInt())
+	upper := new(big.Float).Mul(m, big.NewFloat(factor))
+	lower := new(big.Float).Quo(m, big.NewFloat(factor))
+
+	var kept []int
+	for i, v := range values {
+		f := new(big.Float).SetInt(v.ToInt())
+		if f.Cmp(upper) > 0 || f.Cmp(lower) < 0 {
+			c.lggr.Warnw("Leaving out outlier estimate", "estimator", c.names[indexes[i]], "method", method, "estimate", v, "median", (*assets.Wei)(median(all, values)), "outlierFactor", factor)
+			promCompositeOutliers.WithLabelValues(c.chainID.String(), c.names[indexes[i]]).Inc()
+			continue
+		}
+		kept = append(kept, i)
+	}
+	return kept
+}
+
+// aggregate combines the values at the positions in kept according to the configured Aggregation. indexes are the
+// indexes of the estimators that returned values.
+func (c *CompositeEstimator) aggregate(kept []int, indexes []int, values []*assets.Wei) *assets.Wei {
+	switch c.cfg.Aggregation() {
+	case toml.CompositeAggregationMax:
+		var highest *assets.Wei
+		for _, i := range kept {
+			if highest == nil || values[i].Cmp(highest) > 0 {
+				highest = values[i]
+			}
+		}
+		return highest
+	case toml.CompositeAggregationWeighted:
+		weights := c.cfg.Weights()
+		sum, total := new(big.Int), new(big.Int)
+		for _, i := range kept {
+			w := big.NewInt(int64(weights[indexes[i]]))
+			sum.Add(sum, new(big.Int).Mul(values[i].ToInt(), w))
+			total.Add(total, w)
+		}
+		if total.Sign() > 0 {
+			return assets.NewWei(sum.Div(sum, total))
+		}
+		// only estimators with no weight are left
+		return median(kept, values)
+	default:
+		return median(kept, values)
+	}
+}
+
+// median returns the median of the values at the positions in kept, or the mean of the two middle values for an even
+// number of positions.
+func median(kept []int, values []*assets.Wei) *assets.Wei {
+	sorted := make([]*assets.Wei, len(kept))
+	for i, k := range kept {
+		sorted[i] = values[k]
+	}
+	slices.SortFunc(sorted, func(a, b *assets.Wei) int { return a.Cmp(b) })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	sum := new(big.Int).Add(sorted[mid-1].ToInt(), sorted[mid].ToInt())
+	return assets.NewWei(sum.Div(sum, big.NewInt(2)))
+}
+
+func (c *CompositeEstimator) describe(indexes []int, values []*assets.Wei) map[string]string {
+	m := make(map[string]string, len(values))
+	for i, v := range values {
+		m[c.names[indexes[i]]] = v.String()
+	}
+	return m
+}
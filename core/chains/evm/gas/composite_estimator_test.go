@@ -0,0 +1,126 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+type compositeConfig struct {
+	aggregation   toml.CompositeAggregation
+	weights       []uint32
+	outlierFactor float64
+}
+
+func (c *compositeConfig) Aggregation() toml.CompositeAggregation { return c.aggregation }
+func (c *compositeConfig) Weights() []uint32                      { return c.weights }
+func (c *compositeConfig) OutlierFactor() float64                 { return c.outlierFactor }
+
+func newCompositeEstimator(t *testing.T, cfg *compositeConfig, prices ...int64) gas.EvmEstimator {
+	var names []string
+	var estimators []gas.EvmEstimator
+	for i, p := range prices {
+		e := mocks.NewEvmEstimator(t)
+		if p < 0 {
+			e.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, uint64(0), assert.AnError).Maybe()
+			e.On("GetDynamicFee", mock.Anything, mock.Anything).Return(gas.DynamicFee{}, assert.AnError).Maybe()
+		} else {
+			e.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(assets.NewWeiI(p), uint64(100_000+i), nil).Maybe()
+			e.On("GetDynamicFee", mock.Anything, mock.Anything).Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(p), GasTipCap: assets.NewWeiI(p / 10)}, nil).Maybe()
+		}
+		names = append(names, string(rune('a'+i)))
+		estimators = append(estimators, e)
+	}
+	return gas.NewCompositeEstimator(logger.Test(t), cfg, big.NewInt(0), names, estimators, nil)
+}
+
+func TestCompositeEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxPrice := assets.NewWeiI(1_000_000)
+
+	cases := []struct {
+		name     string
+		cfg      compositeConfig
+		prices   []int64
+		expected int64
+	}{
+		{"max", compositeConfig{aggregation: toml.CompositeAggregationMax}, []int64{10, 30, 20}, 30},
+		{"median", compositeConfig{aggregation: toml.CompositeAggregationMedian}, []int64{10, 30, 20}, 20},
+		{"median of an even number is the mean of the middle", compositeConfig{aggregation: toml.CompositeAggregationMedian}, []int64{10, 40, 20, 30}, 25},
+		{"median of two is their mean", compositeConfig{aggregation: toml.CompositeAggregationMedian, outlierFactor: 3}, []int64{10, 1000}, 505},
+		{"weighted", compositeConfig{aggregation: toml.CompositeAggregationWeighted, weights: []uint32{1, 3, 0}}, []int64{10, 30, 1000}, 25},
+		{"max without outliers", compositeConfig{aggregation: toml.CompositeAggregationMax, outlierFactor: 3}, []int64{10, 12, 1000}, 12},
+		{"low outliers are left out", compositeConfig{aggregation: toml.CompositeAggregationWeighted, weights: []uint32{1, 1, 1}, outlierFactor: 3}, []int64{1, 30, 30}, 30},
+		{"outlier guard disabled", compositeConfig{aggregation: toml.CompositeAggregationMax}, []int64{10, 12, 1000}, 1000},
+		{"failed estimators are left out", compositeConfig{aggregation: toml.CompositeAggregationWeighted, weights: []uint32{1, 5, 1}}, []int64{10, -1, 20}, 15},
+		{"weighted falls back to the median without weights", compositeConfig{aggregation: toml.CompositeAggregationWeighted, weights: []uint32{0, 1, 0}}, []int64{10, -1, 20}, 15},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ce := newCompositeEstimator(t, &tc.cfg, tc.prices...)
+
+			price, limit, err := ce.GetLegacyGas(tests.Context(t), nil, 100_000, maxPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(tc.expected), price)
+			assert.Equal(t, uint64(100_000+len(tc.prices)-1), limit)
+
+			fee, err := ce.GetDynamicFee(tests.Context(t), maxPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(tc.expected), fee.GasFeeCap)
+			assert.LessOrEqual(t, fee.GasTipCap.Cmp(fee.GasFeeCap), 0)
+		})
+	}
+
+	t.Run("fails if all estimators fail", func(t *testing.T) {
+		ce := newCompositeEstimator(t, &compositeConfig{aggregation: toml.CompositeAggregationMedian}, -1, -1)
+
+		_, _, err := ce.GetLegacyGas(tests.Context(t), nil, 100_000, maxPrice)
+		require.ErrorIs(t, err, assert.AnError)
+		_, err = ce.GetDynamicFee(tests.Context(t), maxPrice)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("bumps with every estimator", func(t *testing.T) {
+		e1, e2 := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		ce := gas.NewCompositeEstimator(logger.Test(t), &compositeConfig{aggregation: toml.CompositeAggregationMax}, big.NewInt(0),
+			[]string{"a", "b"}, []gas.EvmEstimator{e1, e2}, nil)
+
+		e1.On("BumpLegacyGas", mock.Anything, assets.NewWeiI(10), uint64(100_000), maxPrice, mock.Anything).Return(assets.NewWeiI(12), uint64(100_000), nil).Once()
+		e2.On("BumpLegacyGas", mock.Anything, assets.NewWeiI(10), uint64(100_000), maxPrice, mock.Anything).Return(assets.NewWeiI(15), uint64(100_000), nil).Once()
+		price, _, err := ce.BumpLegacyGas(tests.Context(t), assets.NewWeiI(10), 100_000, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(15), price)
+
+		original := gas.DynamicFee{GasFeeCap: assets.NewWeiI(10), GasTipCap: assets.NewWeiI(1)}
+		e1.On("BumpDynamicFee", mock.Anything, original, maxPrice, mock.Anything).Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(12), GasTipCap: assets.NewWeiI(3)}, nil).Once()
+		e2.On("BumpDynamicFee", mock.Anything, original, maxPrice, mock.Anything).Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(15), GasTipCap: assets.NewWeiI(2)}, nil).Once()
+		fee, err := ce.BumpDynamicFee(tests.Context(t), original, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(15), fee.GasFeeCap)
+		assert.Equal(t, assets.NewWeiI(3), fee.GasTipCap)
+	})
+
+	t.Run("returns connectivity errors", func(t *testing.T) {
+		e1, e2 := mocks.NewEvmEstimator(t), mocks.NewEvmEstimator(t)
+		ce := gas.NewCompositeEstimator(logger.Test(t), &compositeConfig{aggregation: toml.CompositeAggregationMax}, big.NewInt(0),
+			[]string{"a", "b"}, []gas.EvmEstimator{e1, e2}, nil)
+
+		e1.On("BumpLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, uint64(0), commonfee.ErrConnectivity).Once()
+		e2.On("BumpLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(assets.NewWeiI(15), uint64(100_000), nil).Once()
+		_, _, err := ce.BumpLegacyGas(tests.Context(t), assets.NewWeiI(10), 100_000, maxPrice, nil)
+		require.ErrorIs(t, err, commonfee.ErrConnectivity)
+	})
+}
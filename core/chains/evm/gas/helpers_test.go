@@ -130,6 +130,7 @@ type MockGasEstimatorConfig struct {
 	TipCapDefaultF      *assets.Wei
 	TipCapMinF          *assets.Wei
 	PriceMaxF           *assets.Wei
+	BlobPriceMaxF       *assets.Wei
 	PriceMinF           *assets.Wei
 	PriceDefaultF       *assets.Wei
 	FeeCapDefaultF      *assets.Wei
@@ -174,6 +175,10 @@ func (m *MockGasEstimatorConfig) TipCapMin() *assets.Wei {
 	return m.TipCapMinF
 }
 
+func (m *MockGasEstimatorConfig) BlobPriceMax() *assets.Wei {
+	return m.BlobPriceMaxF
+}
+
 func (m *MockGasEstimatorConfig) PriceMax() *assets.Wei {
 	return m.PriceMaxF
 }
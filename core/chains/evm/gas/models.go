@@ -36,6 +36,8 @@ type EvmFeeEstimator interface {
 	// L1Oracle returns the L1 gas price oracle only if the chain has one, e.g. OP stack L2s and Arbitrum.
 	L1Oracle() rollups.L1Oracle
 	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error)
+	// GetBlobFee returns the fee cap per unit of blob gas to use for a new EIP-4844 blob transaction
+	GetBlobFee(ctx context.Context) (blobFeeCap *assets.Wei, err error)
 	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
@@ -89,44 +91,78 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 			return nil, fmt.Errorf("failed to initialize L1 oracle: %w", err)
 		}
 	}
-	var newEstimator func(logger.Logger) EvmEstimator
-	switch s {
-	case "Arbitrum":
-		arbOracle, err := rollups.NewArbitrumL1GasOracle(lggr, ethClient)
-		if err != nil {
-			return nil, fmt.Errorf("failed to initialize Arbitrum L1 oracle: %w", err)
-		}
-		newEstimator = func(l logger.Logger) EvmEstimator {
-			return NewArbitrumEstimator(lggr, geCfg, ethClient, arbOracle)
+	newModeEstimator := func(mode string) (func(logger.Logger) EvmEstimator, error) {
+		switch mode {
+		case "Arbitrum":
+			arbOracle, err := rollups.NewArbitrumL1GasOracle(lggr, ethClient)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize Arbitrum L1 oracle: %w", err)
+			}
+			return func(l logger.Logger) EvmEstimator {
+				return NewArbitrumEstimator(lggr, geCfg, ethClient, arbOracle)
+			}, nil
+		case "BlockHistory":
+			return func(l logger.Logger) EvmEstimator {
+				return NewBlockHistoryEstimator(lggr, ethClient, chaintype, geCfg, bh, ethClient.ConfiguredChainID(), l1Oracle)
+			}, nil
+		case "FixedPrice":
+			return func(l logger.Logger) EvmEstimator {
+				return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
+			}, nil
+		case "L2Suggested", "SuggestedPrice":
+			return func(l logger.Logger) EvmEstimator {
+				return NewSuggestedPriceEstimator(lggr, ethClient, geCfg, l1Oracle)
+			}, nil
+		case "FeeHistory":
+			return func(l logger.Logger) EvmEstimator {
+				ccfg := FeeHistoryEstimatorConfig{
+					BumpPercent:      geCfg.BumpPercent(),
+					CacheTimeout:     geCfg.FeeHistory().CacheTimeout(),
+					EIP1559:          geCfg.EIP1559DynamicFees(),
+					BlockHistorySize: uint64(geCfg.BlockHistory().BlockHistorySize()),
+					RewardPercentile: float64(geCfg.BlockHistory().TransactionPercentile()),
+				}
+				return NewFeeHistoryEstimator(lggr, ethClient, ccfg, ethClient.ConfiguredChainID(), l1Oracle)
+			}, nil
 		}
-	case "BlockHistory":
-		newEstimator = func(l logger.Logger) EvmEstimator {
-			return NewBlockHistoryEstimator(lggr, ethClient, chaintype, geCfg, bh, ethClient.ConfiguredChainID(), l1Oracle)
+		return nil, nil
+	}
+
+	var newEstimator func(logger.Logger) EvmEstimator
+	if s == "Composite" {
+		cfg := geCfg.Composite()
+		names := cfg.Estimators()
+		newEstimators := make([]func(logger.Logger) EvmEstimator, len(names))
+		for i, mode := range names {
+			ne, err := newModeEstimator(mode)
+			if err != nil {
+				return nil, err
+			}
+			if ne == nil {
+				return nil, fmt.Errorf("GasEstimator: unrecognised mode '%s' in Composite.Estimators", mode)
+			}
+			newEstimators[i] = ne
 		}
-	case "FixedPrice":
+		lggr.Infow("Combining EVM gas estimators", "estimators", names, "aggregation", cfg.Aggregation(),
+			"weights", cfg.Weights(), "outlierFactor", cfg.OutlierFactor())
 		newEstimator = func(l logger.Logger) EvmEstimator {
-			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
+			estimators := make([]EvmEstimator, len(newEstimators))
+			for i, ne := range newEstimators {
+				estimators[i] = ne(l)
+			}
+			return NewCompositeEstimator(lggr, cfg, ethClient.ConfiguredChainID(), names, estimators, l1Oracle)
 		}
-	case "L2Suggested", "SuggestedPrice":
-		newEstimator = func(l logger.Logger) EvmEstimator {
-			return NewSuggestedPriceEstimator(lggr, ethClient, geCfg, l1Oracle)
+	} else {
+		var err error
+		newEstimator, err = newModeEstimator(s)
+		if err != nil {
+			return nil, err
 		}
-	case "FeeHistory":
-		newEstimator = func(l logger.Logger) EvmEstimator {
-			ccfg := FeeHistoryEstimatorConfig{
-				BumpPercent:      geCfg.BumpPercent(),
-				CacheTimeout:     geCfg.FeeHistory().CacheTimeout(),
-				EIP1559:          geCfg.EIP1559DynamicFees(),
-				BlockHistorySize: uint64(geCfg.BlockHistory().BlockHistorySize()),
-				RewardPercentile: float64(geCfg.BlockHistory().TransactionPercentile()),
+		if newEstimator == nil {
+			lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
+			newEstimator = func(l logger.Logger) EvmEstimator {
+				return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
 			}
-			return NewFeeHistoryEstimator(lggr, ethClient, ccfg, ethClient.ConfiguredChainID(), l1Oracle)
-		}
-
-	default:
-		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
-		newEstimator = func(l logger.Logger) EvmEstimator {
-			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
 		}
 	}
 	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
@@ -179,9 +215,14 @@ var _ feetypes.Fee = (*EvmFee)(nil)
 type EvmFee struct {
 	GasPrice *assets.Wei
 	DynamicFee
+	// BlobFeeCap is the maxFeePerBlobGas of EIP-4844 blob transactions, nil for all other types
+	BlobFeeCap *assets.Wei
 }
 
 func (fee EvmFee) String() string {
+	if fee.BlobFeeCap != nil {
+		return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s, BlobFeeCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap, fee.BlobFeeCap)
+	}
 	return fmt.Sprintf("{GasPrice: %s, GasFeeCap: %s, GasTipCap: %s}", fee.GasPrice, fee.GasFeeCap, fee.GasTipCap)
 }
 
@@ -342,8 +383,14 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 			return
 		}
 		chainSpecificFeeLimit, err = commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
+		if err != nil {
+			return
+		}
 		bumpedFee.GasFeeCap = bumpedDynamic.GasFeeCap
 		bumpedFee.GasTipCap = bumpedDynamic.GasTipCap
+		if originalFee.BlobFeeCap != nil {
+			bumpedFee, err = e.bumpBlobTxFee(ctx, originalFee, bumpedFee, maxFeePrice)
+		}
 		return
 	}
 
@@ -419,6 +466,7 @@ type GasEstimatorConfig interface {
 	TipCapMin() *assets.Wei
 	PriceMin() *assets.Wei
 	PriceMax() *assets.Wei
+	BlobPriceMax() *assets.Wei
 	Mode() string
 	EstimateLimit() bool
 }
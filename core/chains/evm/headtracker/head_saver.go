@@ -3,6 +3,7 @@ package headtracker
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -16,6 +17,7 @@ import (
 
 type headSaver struct {
 	orm      ORM
+	reorgORM ReorgORM
 	config   commontypes.Config
 	htConfig commontypes.HeadTrackerConfig
 	logger   logger.Logger
@@ -24,9 +26,12 @@ type headSaver struct {
 
 var _ headtracker.HeadSaver[*evmtypes.Head, common.Hash] = (*headSaver)(nil)
 
-func NewHeadSaver(lggr logger.Logger, orm ORM, config commontypes.Config, htConfig commontypes.HeadTrackerConfig) httypes.HeadSaver {
+// NewHeadSaver creates a HeadSaver that keeps the heads in orm. reorgORM is optional, and is trimmed of the reorgs older
+// than htConfig.ReorgRetention along with the heads.
+func NewHeadSaver(lggr logger.Logger, orm ORM, reorgORM ReorgORM, config commontypes.Config, htConfig commontypes.HeadTrackerConfig) httypes.HeadSaver {
 	return &headSaver{
 		orm:      orm,
+		reorgORM: reorgORM,
 		config:   config,
 		htConfig: htConfig,
 		logger:   logger.Named(lggr, "HeadSaver"),
@@ -86,7 +91,13 @@ func (hs *headSaver) MarkFinalized(ctx context.Context, finalized *evmtypes.Head
 		return fmt.Errorf("failed to find %s block in the canonical chain to mark it as finalized", finalized)
 	}
 
-	return hs.orm.TrimOldHeads(ctx, minBlockToKeep)
+	if err := hs.orm.TrimOldHeads(ctx, minBlockToKeep); err != nil {
+		return err
+	}
+	if retention := hs.htConfig.ReorgRetention(); hs.reorgORM != nil && retention > 0 {
+		return hs.reorgORM.TrimOldReorgs(ctx, time.Now().Add(-retention))
+	}
+	return nil
 }
 
 var NullSaver httypes.HeadSaver = &nullSaver{}
@@ -21,7 +21,8 @@ import (
 )
 
 type headTrackerConfig struct {
-	historyDepth uint32
+	historyDepth   uint32
+	reorgRetention time.Duration
 }
 
 func (h *headTrackerConfig) HistoryDepth() uint32 {
@@ -45,6 +46,9 @@ func (h *headTrackerConfig) MaxAllowedFinalityDepth() uint32 {
 func (h *headTrackerConfig) PersistenceEnabled() bool {
 	return true
 }
+func (h *headTrackerConfig) ReorgRetention() time.Duration {
+	return h.reorgRetention
+}
 
 type config struct {
 	finalityDepth                     uint32
@@ -68,6 +72,7 @@ func (c *config) FinalizedBlockOffset() uint32 {
 
 type saverOpts struct {
 	headTrackerConfig *headTrackerConfig
+	reorgORM          headtracker.ReorgORM
 }
 
 func configureSaver(t *testing.T, opts saverOpts) (httypes.HeadSaver, headtracker.ORM) {
@@ -78,7 +83,7 @@ func configureSaver(t *testing.T, opts saverOpts) (httypes.HeadSaver, headtracke
 	lggr := logger.Test(t)
 	htCfg := &config{finalityDepth: uint32(1)}
 	orm := headtracker.NewORM(*testutils.FixtureChainID, db)
-	saver := headtracker.NewHeadSaver(lggr, orm, htCfg, opts.headTrackerConfig)
+	saver := headtracker.NewHeadSaver(lggr, orm, opts.reorgORM, htCfg, opts.headTrackerConfig)
 	return saver, orm
 }
 
@@ -104,6 +109,41 @@ func TestHeadSaver_Save(t *testing.T) {
 	require.Equal(t, int64(1), latest.Number)
 }
 
+func TestHeadSaver_MarkFinalized_TrimsOldReorgs(t *testing.T) {
+	t.Parallel()
+
+	reorgORM := headtracker.NewReorgORM(*testutils.FixtureChainID, pgtest.NewSqlxDB(t))
+	saver, _ := configureSaver(t, saverOpts{
+		headTrackerConfig: &headTrackerConfig{historyDepth: 6, reorgRetention: time.Hour},
+		reorgORM:          reorgORM,
+	})
+	ctx := tests.Context(t)
+
+	newReorg := func(detectedAt time.Time) httypes.Reorg {
+		return httypes.Reorg{
+			Depth:                1,
+			CommonAncestorNumber: 1,
+			CommonAncestorHash:   utils.NewHash(),
+			OldHashes:            []common.Hash{utils.NewHash()},
+			NewHashes:            []common.Hash{utils.NewHash()},
+			DetectedAt:           detectedAt,
+		}
+	}
+	require.NoError(t, reorgORM.SaveReorg(ctx, newReorg(time.Now().Add(-2*time.Hour))))
+	recent := newReorg(time.Now())
+	require.NoError(t, reorgORM.SaveReorg(ctx, recent))
+
+	head := testutils.Head(1)
+	require.NoError(t, saver.Save(ctx, head))
+	require.NoError(t, saver.MarkFinalized(ctx, head))
+
+	reorgs, count, err := reorgORM.FindReorgs(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Len(t, reorgs, 1)
+	require.Equal(t, recent.CommonAncestorHash, reorgs[0].CommonAncestorHash)
+}
+
 func TestHeadSaver_Load(t *testing.T) {
 	t.Parallel()
 
@@ -21,6 +21,7 @@ func NewHeadTracker(
 	config commontypes.Config,
 	htConfig commontypes.HeadTrackerConfig,
 	headBroadcaster httypes.HeadBroadcaster,
+	reorgBroadcaster httypes.ReorgBroadcaster,
 	headSaver httypes.HeadSaver,
 	mailMon *mailbox.Monitor,
 ) httypes.HeadTracker {
@@ -30,6 +31,7 @@ func NewHeadTracker(
 		config,
 		htConfig,
 		headBroadcaster,
+		reorgBroadcaster,
 		headSaver,
 		mailMon,
 		func() *evmtypes.Head { return nil },
@@ -793,6 +793,80 @@ func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingDisabled(t *testing.T
 	}
 }
 
+func TestHeadTracker_DetectsReorgs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+
+	config := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+		c.FinalityDepth = ptr[uint32](50)
+		c.HeadTracker.MaxBufferSize = ptr[uint32](100)
+		c.HeadTracker.SamplingInterval = commonconfig.MustNewDuration(0)
+	})
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	orm := headtracker.NewORM(*testutils.FixtureChainID, db)
+	ht := createHeadTracker(t, ethClient, config.EVM(), config.EVM().HeadTracker(), orm)
+
+	chchHeaders := make(chan testutils.RawSub[*evmtypes.Head], 1)
+	mockEth := &testutils.MockEth{EthClient: ethClient}
+	chHead := make(chan *evmtypes.Head)
+	ethClient.On("SubscribeToHeads", mock.Anything).
+		Return(
+			func(ctx context.Context) (<-chan *evmtypes.Head, ethereum.Subscription, error) {
+				sub := mockEth.NewSub(t)
+				chchHeaders <- testutils.NewRawSub(chHead, sub.Err())
+				return chHead, sub, nil
+			},
+		)
+
+	blocks := NewBlocks(t, 10)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(blocks.Head(0), nil)
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(0)).Return(blocks.Head(0), nil)
+
+	// Reorg happened forking from block 2, replacing blocks 2 to 4
+	blocksForked := blocks.ForkAt(t, 2, 5)
+
+	reorgAwaiter := testutils.NewAwaiter()
+	checker := htmocks.NewReorgTrackable[common.Hash](t)
+	checker.On("OnReorg", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			reorg := args.Get(1).(httypes.Reorg)
+			assert.Equal(t, int64(3), reorg.Depth)
+			assert.Equal(t, int64(1), reorg.CommonAncestorNumber)
+			assert.Equal(t, blocks.Head(1).Hash, reorg.CommonAncestorHash)
+			assert.Equal(t, []common.Hash{blocks.Head(4).Hash, blocks.Head(3).Hash, blocks.Head(2).Hash}, reorg.OldHashes)
+			assert.Equal(t, []common.Hash{blocksForked.Head(5).Hash, blocksForked.Head(4).Hash, blocksForked.Head(3).Hash, blocksForked.Head(2).Hash}, reorg.NewHashes)
+			assert.Equal(t, int64(4), reorg.OldHeadNumber())
+			assert.Equal(t, int64(5), reorg.NewHeadNumber())
+			reorgAwaiter.ItHappened()
+		}).Return().Once()
+	ht.reorgBroadcaster.Subscribe(checker)
+
+	ht.Start(t)
+	headers := <-chchHeaders
+
+	for i := uint64(1); i <= 4; i++ {
+		headers.TrySend(blocks.Head(i))
+	}
+	// wait for the original chain to be backfilled, so that the fork replaces it
+	gomega.NewWithT(t).Eventually(func() bool {
+		for _, l := range ht.observer.FilterMessage("Finished backfill").All() {
+			if l.ContextMap()["blockNumber"] == int64(4) {
+				return true
+			}
+		}
+		return false
+	}, tests.WaitTimeout(t), tests.TestInterval).Should(gomega.BeTrue())
+
+	for i := uint64(2); i <= 5; i++ {
+		headers.TrySend(blocksForked.Head(i))
+	}
+
+	reorgAwaiter.AwaitOrFail(t, tests.WaitTimeout(t))
+	ht.Stop(t)
+}
+
 func TestHeadTracker_Backfill(t *testing.T) {
 	t.Parallel()
 	t.Run("Enabled Persistence", func(t *testing.T) {
@@ -1267,49 +1341,61 @@ func TestHeadTracker_LatestAndFinalizedBlock(t *testing.T) {
 func createHeadTracker(t testing.TB, ethClient *evmclimocks.Client, config commontypes.Config, htConfig commontypes.HeadTrackerConfig, orm headtracker.ORM) *headTrackerUniverse {
 	lggr, ob := logger.TestObserved(t, zap.DebugLevel)
 	hb := headtracker.NewHeadBroadcaster(lggr)
-	hs := headtracker.NewHeadSaver(lggr, orm, config, htConfig)
+	rb := newReorgBroadcaster(t, lggr)
+	hs := headtracker.NewHeadSaver(lggr, orm, nil, config, htConfig)
 	mailMon := mailboxtest.NewMonitor(t)
 	return &headTrackerUniverse{
-		mu:              new(sync.Mutex),
-		headTracker:     headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon),
-		headBroadcaster: hb,
-		headSaver:       hs,
-		mailMon:         mailMon,
-		observer:        ob,
-		orm:             orm,
-		ethClient:       ethClient,
+		mu:               new(sync.Mutex),
+		headTracker:      headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, rb, hs, mailMon),
+		headBroadcaster:  hb,
+		reorgBroadcaster: rb,
+		headSaver:        hs,
+		mailMon:          mailMon,
+		observer:         ob,
+		orm:              orm,
+		ethClient:        ethClient,
 	}
 }
 
 func createHeadTrackerWithChecker(t *testing.T, ethClient *evmclimocks.Client, config commontypes.Config, htConfig commontypes.HeadTrackerConfig, orm headtracker.ORM, checker httypes.HeadTrackable) *headTrackerUniverse {
 	lggr, ob := logger.TestObserved(t, zap.DebugLevel)
 	hb := headtracker.NewHeadBroadcaster(lggr)
-	hs := headtracker.NewHeadSaver(lggr, orm, config, htConfig)
+	rb := newReorgBroadcaster(t, lggr)
+	hs := headtracker.NewHeadSaver(lggr, orm, nil, config, htConfig)
 	hb.Subscribe(checker)
 	mailMon := mailboxtest.NewMonitor(t)
-	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, hs, mailMon)
+	ht := headtracker.NewHeadTracker(lggr, ethClient, config, htConfig, hb, rb, hs, mailMon)
 	return &headTrackerUniverse{
-		mu:              new(sync.Mutex),
-		headTracker:     ht,
-		headBroadcaster: hb,
-		headSaver:       hs,
-		mailMon:         mailMon,
-		observer:        ob,
-		orm:             orm,
-		ethClient:       ethClient,
+		mu:               new(sync.Mutex),
+		headTracker:      ht,
+		headBroadcaster:  hb,
+		reorgBroadcaster: rb,
+		headSaver:        hs,
+		mailMon:          mailMon,
+		observer:         ob,
+		orm:              orm,
+		ethClient:        ethClient,
 	}
 }
 
 type headTrackerUniverse struct {
-	mu              *sync.Mutex
-	stopped         bool
-	headTracker     httypes.HeadTracker
-	headBroadcaster httypes.HeadBroadcaster
-	headSaver       httypes.HeadSaver
-	mailMon         *mailbox.Monitor
-	observer        *observer.ObservedLogs
-	orm             headtracker.ORM
-	ethClient       *evmclimocks.Client
+	mu               *sync.Mutex
+	stopped          bool
+	headTracker      httypes.HeadTracker
+	headBroadcaster  httypes.HeadBroadcaster
+	reorgBroadcaster httypes.ReorgBroadcaster
+	headSaver        httypes.HeadSaver
+	mailMon          *mailbox.Monitor
+	observer         *observer.ObservedLogs
+	orm              headtracker.ORM
+	ethClient        *evmclimocks.Client
+}
+
+// newReorgBroadcaster returns a ReorgBroadcaster backed by a mocked saver, which accepts any reorg.
+func newReorgBroadcaster(t testing.TB, lggr logger.Logger) httypes.ReorgBroadcaster {
+	saver := htmocks.NewReorgSaver[common.Hash](t)
+	saver.On("SaveReorg", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return commonht.NewReorgBroadcaster[common.Hash](lggr, saver)
 }
 
 func (u *headTrackerUniverse) Backfill(ctx context.Context, head *evmtypes.Head) error {
@@ -1321,6 +1407,7 @@ func (u *headTrackerUniverse) Start(t *testing.T) {
 	defer u.mu.Unlock()
 	ctx := tests.Context(t)
 	require.NoError(t, u.headBroadcaster.Start(ctx))
+	require.NoError(t, u.reorgBroadcaster.Start(ctx))
 	require.NoError(t, u.headTracker.Start(ctx))
 	require.NoError(t, u.mailMon.Start(ctx))
 
@@ -1343,6 +1430,7 @@ func (u *headTrackerUniverse) Stop(t *testing.T) {
 	}
 	u.stopped = true
 	require.NoError(t, u.headBroadcaster.Close())
+	require.NoError(t, u.reorgBroadcaster.Close())
 	require.NoError(t, u.headTracker.Close())
 	require.NoError(t, u.mailMon.Close())
 }
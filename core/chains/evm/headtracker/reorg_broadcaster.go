@@ -0,0 +1,17 @@
+package headtracker
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink/v2/common/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+)
+
+// NewReorgBroadcaster creates a ReorgBroadcaster that saves every reorg with orm before relaying it.
+func NewReorgBroadcaster(
+	lggr logger.Logger,
+	orm ReorgORM,
+) httypes.ReorgBroadcaster {
+	return headtracker.NewReorgBroadcaster[common.Hash](lggr, orm)
+}
@@ -0,0 +1,97 @@
+package headtracker_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonht "github.com/smartcontractkit/chainlink/v2/common/headtracker"
+	htmocks "github.com/smartcontractkit/chainlink/v2/common/headtracker/mocks"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+)
+
+func TestReorgBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	reorg := httypes.Reorg{
+		Depth:                1,
+		CommonAncestorNumber: 41,
+		CommonAncestorHash:   testutils.NewHash(),
+		OldHashes:            []common.Hash{testutils.NewHash()},
+		NewHashes:            []common.Hash{testutils.NewHash()},
+		DetectedAt:           time.Now(),
+	}
+
+	var saved atomic.Bool
+	saver := htmocks.NewReorgSaver[common.Hash](t)
+	saver.On("SaveReorg", mock.Anything, reorg).Run(func(mock.Arguments) { saved.Store(true) }).Return(nil).Once()
+
+	rb := commonht.NewReorgBroadcaster[common.Hash](logger.Test(t), saver)
+	servicetest.Run(t, rb)
+
+	notified := make(chan httypes.Reorg, 2)
+	subscriber := htmocks.NewReorgTrackable[common.Hash](t)
+	subscriber.On("OnReorg", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		assert.True(t, saved.Load(), "subscriber notified before the reorg was saved")
+		notified <- args.Get(1).(httypes.Reorg)
+	}).Return().Once()
+	rb.Subscribe(subscriber)
+
+	unsubscribed := htmocks.NewReorgTrackable[common.Hash](t)
+	unsubscribe := rb.Subscribe(unsubscribed)
+	unsubscribe()
+
+	rb.BroadcastReorg(tests.Context(t), reorg)
+	assert.True(t, saved.Load(), "reorg not saved before BroadcastReorg returned")
+
+	select {
+	case got := <-notified:
+		assert.Equal(t, reorg, got)
+	case <-time.After(tests.WaitTimeout(t)):
+		t.Fatal("subscriber was not notified of the reorg")
+	}
+}
+
+func TestReorgBroadcaster_DoesNotDropReorgs(t *testing.T) {
+	t.Parallel()
+
+	const count = 250
+	saver := htmocks.NewReorgSaver[common.Hash](t)
+	saver.On("SaveReorg", mock.Anything, mock.Anything).Return(nil).Times(count)
+
+	rb := commonht.NewReorgBroadcaster[common.Hash](logger.Test(t), saver)
+	servicetest.Run(t, rb)
+
+	// the subscriber is blocked until every reorg has been broadcast
+	unblock := make(chan struct{})
+	notified := make(chan int64, count)
+	subscriber := htmocks.NewReorgTrackable[common.Hash](t)
+	subscriber.On("OnReorg", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		<-unblock
+		notified <- args.Get(1).(httypes.Reorg).CommonAncestorNumber
+	}).Return().Times(count)
+	rb.Subscribe(subscriber)
+
+	for i := int64(0); i < count; i++ {
+		rb.BroadcastReorg(tests.Context(t), httypes.Reorg{Depth: 1, CommonAncestorNumber: i, DetectedAt: time.Now()})
+	}
+	close(unblock)
+
+	for i := int64(0); i < count; i++ {
+		select {
+		case got := <-notified:
+			assert.Equal(t, i, got)
+		case <-time.After(tests.WaitTimeout(t)):
+			t.Fatalf("subscriber was notified of %d reorgs only", i)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package headtracker
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// ReorgRecord is a reorg detected by the HeadTracker, as stored in the database.
+type ReorgRecord struct {
+	ID                   int64
+	EVMChainID           ubig.Big
+	Depth                int64
+	CommonAncestorNumber int64
+	CommonAncestorHash   common.Hash
+	OldHashes            []common.Hash
+	NewHashes            []common.Hash
+	DetectedAt           time.Time
+}
+
+// OldHeadNumber is the block number of the head of the replaced chain.
+func (r ReorgRecord) OldHeadNumber() int64 {
+	return r.CommonAncestorNumber + int64(len(r.OldHashes))
+}
+
+// NewHeadNumber is the block number of the head of the chain that replaced it.
+func (r ReorgRecord) NewHeadNumber() int64 {
+	return r.CommonAncestorNumber + int64(len(r.NewHashes))
+}
+
+type ReorgORM interface {
+	// SaveReorg inserts a reorg detected on the chain of the ORM.
+	SaveReorg(ctx context.Context, reorg httypes.Reorg) error
+	// FindReorgs returns a page of the reorgs of the chain of the ORM, most recent first, and their total count.
+	FindReorgs(ctx context.Context, offset, limit int) (reorgs []ReorgRecord, count int, err error)
+	// TrimOldReorgs deletes the reorgs of the chain of the ORM detected before the given time.
+	TrimOldReorgs(ctx context.Context, before time.Time) error
+}
+
+var _ ReorgORM = &DbReorgORM{}
+
+type DbReorgORM struct {
+	chainID ubig.Big
+	ds      sqlutil.DataSource
+}
+
+// NewReorgORM creates a ReorgORM scoped to chainID.
+func NewReorgORM(chainID big.Int, ds sqlutil.DataSource) *DbReorgORM {
+	return &DbReorgORM{
+		chainID: ubig.Big(chainID),
+		ds:      ds,
+	}
+}
+
+func (orm *DbReorgORM) SaveReorg(ctx context.Context, reorg httypes.Reorg) error {
+	query := `INSERT INTO evm.reorgs (evm_chain_id, depth, common_ancestor_number, common_ancestor_hash, old_hashes, new_hashes, detected_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := orm.ds.ExecContext(ctx, query, orm.chainID, reorg.Depth, reorg.CommonAncestorNumber, reorg.CommonAncestorHash,
+		hashesToByteaArray(reorg.OldHashes), hashesToByteaArray(reorg.NewHashes), reorg.DetectedAt)
+	return pkgerrors.Wrap(err, "SaveReorg failed to insert reorg")
+}
+
+type dbReorg struct {
+	ID                   int64
+	EVMChainID           ubig.Big `db:"evm_chain_id"`
+	Depth                int64
+	CommonAncestorNumber int64
+	CommonAncestorHash   common.Hash
+	OldHashes            pq.ByteaArray
+	NewHashes            pq.ByteaArray
+	DetectedAt           time.Time
+}
+
+func (orm *DbReorgORM) FindReorgs(ctx context.Context, offset, limit int) (reorgs []ReorgRecord, count int, err error) {
+	err = sqlutil.TransactDataSource(ctx, orm.ds, nil, func(tx sqlutil.DataSource) error {
+		if err = tx.GetContext(ctx, &count, `SELECT count(*) FROM evm.reorgs WHERE evm_chain_id = $1`, orm.chainID); err != nil {
+			return pkgerrors.Wrap(err, "failed to count reorgs")
+		}
+		var rows []dbReorg
+		if err = tx.SelectContext(ctx, &rows, `SELECT * FROM evm.reorgs WHERE evm_chain_id = $1 ORDER BY detected_at DESC, id DESC LIMIT $2 OFFSET $3`,
+			orm.chainID, limit, offset); err != nil {
+			return pkgerrors.Wrap(err, "failed to load reorgs")
+		}
+		for _, r := range rows {
+			reorgs = append(reorgs, ReorgRecord{
+				ID:                   r.ID,
+				EVMChainID:           r.EVMChainID,
+				Depth:                r.Depth,
+				CommonAncestorNumber: r.CommonAncestorNumber,
+				CommonAncestorHash:   r.CommonAncestorHash,
+				OldHashes:            byteaArrayToHashes(r.OldHashes),
+				NewHashes:            byteaArrayToHashes(r.NewHashes),
+				DetectedAt:           r.DetectedAt,
+			})
+		}
+		return nil
+	})
+	return
+}
+
+func (orm *DbReorgORM) TrimOldReorgs(ctx context.Context, before time.Time) error {
+	_, err := orm.ds.ExecContext(ctx, `DELETE FROM evm.reorgs WHERE evm_chain_id = $1 AND detected_at < $2`, orm.chainID, before)
+	return pkgerrors.Wrap(err, "TrimOldReorgs failed to delete reorgs")
+}
+
+func hashesToByteaArray(hashes []common.Hash) pq.ByteaArray {
+	arr := make(pq.ByteaArray, len(hashes))
+	for i, h := range hashes {
+		arr[i] = h.Bytes()
+	}
+	return arr
+}
+
+func byteaArrayToHashes(arr pq.ByteaArray) []common.Hash {
+	hashes := make([]common.Hash, len(arr))
+	for i, b := range arr {
+		hashes[i] = common.BytesToHash(b)
+	}
+	return hashes
+}
@@ -0,0 +1,81 @@
+package headtracker_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestReorgORM(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	orm := headtracker.NewReorgORM(*testutils.FixtureChainID, db)
+	otherORM := headtracker.NewReorgORM(*big.NewInt(1337), db)
+	ctx := tests.Context(t)
+
+	detectedAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Millisecond)
+	older := httypes.Reorg{
+		Depth:                1,
+		CommonAncestorNumber: 10,
+		CommonAncestorHash:   testutils.NewHash(),
+		OldHashes:            []common.Hash{testutils.NewHash()},
+		NewHashes:            []common.Hash{testutils.NewHash(), testutils.NewHash()},
+		DetectedAt:           detectedAt,
+	}
+	newer := httypes.Reorg{
+		Depth:                2,
+		CommonAncestorNumber: 20,
+		CommonAncestorHash:   testutils.NewHash(),
+		OldHashes:            []common.Hash{testutils.NewHash(), testutils.NewHash()},
+		NewHashes:            []common.Hash{testutils.NewHash(), testutils.NewHash()},
+		DetectedAt:           detectedAt.Add(time.Second),
+	}
+	require.NoError(t, orm.SaveReorg(ctx, older))
+	require.NoError(t, orm.SaveReorg(ctx, newer))
+	require.NoError(t, otherORM.SaveReorg(ctx, newer))
+
+	reorgs, count, err := orm.FindReorgs(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, reorgs, 2)
+
+	// most recent first
+	assert.Equal(t, newer.CommonAncestorHash, reorgs[0].CommonAncestorHash)
+	assert.Equal(t, *testutils.FixtureChainID, *reorgs[1].EVMChainID.ToInt())
+	assert.Equal(t, older.Depth, reorgs[1].Depth)
+	assert.Equal(t, older.CommonAncestorNumber, reorgs[1].CommonAncestorNumber)
+	assert.Equal(t, older.CommonAncestorHash, reorgs[1].CommonAncestorHash)
+	assert.Equal(t, older.OldHashes, reorgs[1].OldHashes)
+	assert.Equal(t, older.NewHashes, reorgs[1].NewHashes)
+	assert.Equal(t, int64(11), reorgs[1].OldHeadNumber())
+	assert.Equal(t, int64(12), reorgs[1].NewHeadNumber())
+	assert.True(t, older.DetectedAt.Equal(reorgs[1].DetectedAt))
+
+	reorgs, count, err = orm.FindReorgs(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, older.CommonAncestorHash, reorgs[0].CommonAncestorHash)
+
+	// only the older reorg of the chain of the ORM is trimmed
+	require.NoError(t, orm.TrimOldReorgs(ctx, newer.DetectedAt))
+	reorgs, count, err = orm.FindReorgs(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, newer.CommonAncestorHash, reorgs[0].CommonAncestorHash)
+	_, count, err = otherORM.FindReorgs(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
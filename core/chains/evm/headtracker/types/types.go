@@ -26,4 +26,8 @@ type (
 	HeadListener    = headtracker.HeadListener[*evmtypes.Head, common.Hash]
 	HeadBroadcaster = headtracker.HeadBroadcaster[*evmtypes.Head, common.Hash]
 	Client          = htrktypes.Client[*evmtypes.Head, ethereum.Subscription, *big.Int, common.Hash]
+
+	Reorg            = headtracker.Reorg[common.Hash]
+	ReorgTrackable   = headtracker.ReorgTrackable[common.Hash]
+	ReorgBroadcaster = headtracker.ReorgBroadcaster[common.Hash]
 )
@@ -0,0 +1,142 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+type backfillChunk struct {
+	from, to int64
+}
+
+// checkpointedBackfill backfills the finalized block range [start, end] in chunks of backfillBatchSize blocks,
+// fetching up to backfillConcurrency chunks in parallel. Progress is checkpointed in the db every time the range
+// backfilled without gaps grows, so that a replay interrupted by a restart resumes from the checkpoint instead of
+// starting over. The checkpoint is removed once the whole range has been backfilled, and kept if there is an error.
+func (lp *logPoller) checkpointedBackfill(ctx context.Context, start, end int64) error {
+	now := time.Now()
+	progress := BackfillProgress{
+		EvmChainId:     ubig.New(lp.ec.ConfiguredChainID()),
+		FromBlock:      start,
+		ToBlock:        end,
+		CompletedBlock: start - 1,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	prev, err := lp.orm.SelectBackfillProgress(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if prev != nil && prev.CompletedBlock+1 == start && prev.FromBlock <= start {
+		// resuming an interrupted replay
+		progress.FromBlock, progress.CreatedAt = prev.FromBlock, prev.CreatedAt
+		lp.lggr.Infow("Resuming interrupted backfill", "fromBlock", prev.FromBlock, "completedBlock", prev.CompletedBlock, "toBlock", end)
+	}
+	if err = lp.orm.UpsertBackfillProgress(ctx, progress); err != nil {
+		return err
+	}
+
+	var chunks []backfillChunk
+	for from := start; from <= end; from += lp.backfillBatchSize {
+		chunks = append(chunks, backfillChunk{from: from, to: mathutil.Min(from+lp.backfillBatchSize-1, end)})
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu       sync.Mutex
+		done     = make([]bool, len(chunks))
+		next     int // index of the first chunk that is not done
+		firstErr error
+		wg       sync.WaitGroup
+		work     = make(chan int)
+	)
+	workers := mathutil.Min(lp.backfillConcurrency, int64(len(chunks)))
+	for w := int64(0); w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				c := chunks[i]
+				if err := lp.backfill(ctx, c.from, c.to); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					cancel()
+					return
+				}
+
+				mu.Lock()
+				done[i] = true
+				advanced := false
+				for next < len(chunks) && done[next] {
+					progress.CompletedBlock = chunks[next].to
+					next++
+					advanced = true
+				}
+				if advanced {
+					progress.UpdatedAt = time.Now()
+					if err := lp.orm.UpsertBackfillProgress(ctx, progress); err != nil {
+						// not fatal, an interrupted replay resumes from an earlier checkpoint
+						lp.lggr.Warnw("Unable to save backfill checkpoint", "err", err, "completedBlock", progress.CompletedBlock)
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for i := range chunks {
+		select {
+		case work <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	lp.lggr.Infow("Finished backfill", "fromBlock", progress.FromBlock, "toBlock", end, "duration", time.Since(progress.CreatedAt))
+	return lp.orm.DeleteBackfillProgress(ctx)
+}
+
+// resumeBackfill resumes a replay that was interrupted while backfilling, if any.
+func (lp *logPoller) resumeBackfill(ctx context.Context) {
+	progress, err := lp.orm.SelectBackfillProgress(ctx)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			lp.lggr.Errorw("Unable to load backfill checkpoint", "err", err)
+		}
+		return
+	}
+	if progress.RemainingBlocks() <= 0 {
+		return
+	}
+	lp.lggr.Infow("Found interrupted replay", "fromBlock", progress.FromBlock, "completedBlock", progress.CompletedBlock, "toBlock", progress.ToBlock)
+	lp.ReplayAsync(progress.CompletedBlock + 1)
+}
+
+// BackfillProgress returns the checkpoint of the replay in progress, or nil if there is none.
+func (lp *logPoller) BackfillProgress(ctx context.Context) (*BackfillProgress, error) {
+	progress, err := lp.orm.SelectBackfillProgress(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return progress, err
+}
@@ -0,0 +1,96 @@
+package logpoller
+
+import (
+	"database/sql"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestBackfillProgress_ETA(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	p := BackfillProgress{FromBlock: 1, ToBlock: 100, CompletedBlock: 0, CreatedAt: start, UpdatedAt: start}
+	assert.Equal(t, int64(100), p.RemainingBlocks())
+	_, ok := p.ETA()
+	assert.False(t, ok)
+
+	p.CompletedBlock = 25
+	p.UpdatedAt = start.Add(time.Minute)
+	assert.Equal(t, int64(75), p.RemainingBlocks())
+	eta, ok := p.ETA()
+	require.True(t, ok)
+	assert.Equal(t, 3*time.Minute, eta)
+}
+
+func Test_checkpointedBackfill(t *testing.T) {
+	lggr := logger.Test(t)
+	chainID := testutils.FixtureChainID
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr)
+	ctx := testutils.Context(t)
+
+	lpOpts := Opts{
+		PollPeriod:               time.Hour,
+		BackfillBatchSize:        2,
+		BackfillConcurrency:      3,
+		RpcBatchSize:             2,
+		KeepFinalizedBlocksDepth: 50,
+		FinalityDepth:            3,
+	}
+
+	ec := evmclimocks.NewClient(t)
+	ec.On("ConfiguredChainID").Return(chainID).Maybe()
+	lp := NewLogPoller(orm, ec, lggr, nil, lpOpts)
+
+	t.Run("backfills the whole range and removes the checkpoint", func(t *testing.T) {
+		var calls atomic.Int32
+		ec.On("FilterLogs", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+			calls.Add(1)
+		}).Return([]types.Log{}, nil).Times(5)
+
+		require.NoError(t, lp.checkpointedBackfill(ctx, 1, 10))
+		assert.Equal(t, int32(5), calls.Load())
+
+		_, err := orm.SelectBackfillProgress(ctx)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("keeps the checkpoint on error and resumes from it", func(t *testing.T) {
+		ec.On("FilterLogs", mock.Anything, mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+			return q.FromBlock.Int64() == 5
+		})).Return(nil, assert.AnError).Once()
+		ec.On("FilterLogs", mock.Anything, mock.Anything).Return([]types.Log{}, nil).Maybe()
+
+		require.ErrorIs(t, lp.checkpointedBackfill(ctx, 1, 10), assert.AnError)
+
+		progress, err := orm.SelectBackfillProgress(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), progress.FromBlock)
+		assert.Equal(t, int64(10), progress.ToBlock)
+		assert.Less(t, progress.CompletedBlock, int64(5))
+
+		bp, err := lp.BackfillProgress(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, progress.CompletedBlock, bp.CompletedBlock)
+
+		require.NoError(t, lp.checkpointedBackfill(ctx, progress.CompletedBlock+1, 10))
+
+		bp, err = lp.BackfillProgress(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, bp)
+	})
+}
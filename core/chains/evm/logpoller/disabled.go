@@ -38,6 +38,10 @@ func (disabled) Replay(ctx context.Context, fromBlock int64) error { return ErrD
 
 func (disabled) ReplayAsync(fromBlock int64) {}
 
+func (disabled) BackfillProgress(ctx context.Context) (*BackfillProgress, error) {
+	return nil, ErrDisabled
+}
+
 func (disabled) RegisterFilter(ctx context.Context, filter Filter) error { return ErrDisabled }
 
 func (disabled) UnregisterFilter(ctx context.Context, name string) error { return ErrDisabled }
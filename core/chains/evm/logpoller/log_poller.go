@@ -40,6 +40,7 @@ type LogPoller interface {
 	Healthy() error
 	Replay(ctx context.Context, fromBlock int64) error
 	ReplayAsync(fromBlock int64)
+	BackfillProgress(ctx context.Context) (*BackfillProgress, error)
 	RegisterFilter(ctx context.Context, filter Filter) error
 	UnregisterFilter(ctx context.Context, name string) error
 	HasFilter(name string) bool
@@ -113,6 +114,7 @@ type logPoller struct {
 	finalityDepth            int64         // finality depth is taken to mean that block (head - finality) is finalized. If `useFinalityTag` is set to true, this value is ignored, because finalityDepth is fetched from chain
 	keepFinalizedBlocksDepth int64         // the number of blocks behind the last finalized block we keep in database
 	backfillBatchSize        int64         // batch size to use when backfilling finalized logs
+	backfillConcurrency      int64         // number of batches to backfill in parallel during a replay
 	rpcBatchSize             int64         // batch size to use for fallback RPC calls made in GetBlocks
 	logPrunePageSize         int64
 	clientErrors             config.ClientErrors
@@ -143,6 +145,7 @@ type Opts struct {
 	UseFinalityTag           bool
 	FinalityDepth            int64
 	BackfillBatchSize        int64
+	BackfillConcurrency      int64
 	RpcBatchSize             int64
 	KeepFinalizedBlocksDepth int64
 	BackupPollerBlockDelay   int64
@@ -161,6 +164,10 @@ type Opts struct {
 // How fast that can be done depends largely on network speed and DB, but even for the fastest
 // support chain, polygon, which has 2s block times, we need RPCs roughly with <= 500ms latency
 func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracker, opts Opts) *logPoller {
+	backfillConcurrency := opts.BackfillConcurrency
+	if backfillConcurrency < 1 {
+		backfillConcurrency = 1
+	}
 	return &logPoller{
 		stopCh:                   make(chan struct{}),
 		ec:                       ec,
@@ -174,6 +181,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		finalityDepth:            opts.FinalityDepth,
 		useFinalityTag:           opts.UseFinalityTag,
 		backfillBatchSize:        opts.BackfillBatchSize,
+		backfillConcurrency:      backfillConcurrency,
 		rpcBatchSize:             opts.RpcBatchSize,
 		keepFinalizedBlocksDepth: opts.KeepFinalizedBlocksDepth,
 		logPrunePageSize:         opts.LogPrunePageSize,
@@ -436,7 +444,7 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) (err error) {
 		return err
 	}
 	if fromBlock <= savedFinalizedBlockNumber {
-		err = lp.backfill(ctx, fromBlock, savedFinalizedBlockNumber)
+		err = lp.checkpointedBackfill(ctx, fromBlock, savedFinalizedBlockNumber)
 		if err != nil {
 			return err
 		}
@@ -624,6 +632,8 @@ func (lp *logPoller) run() {
 					continue
 				}
 				filtersLoaded = true
+				// Replays need the filters, so an interrupted one can only resume now
+				lp.resumeBackfill(ctx)
 			}
 
 			// Always start from the latest block in the db.
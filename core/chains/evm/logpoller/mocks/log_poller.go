@@ -31,6 +31,64 @@ func (_m *LogPoller) EXPECT() *LogPoller_Expecter {
 	return &LogPoller_Expecter{mock: &_m.Mock}
 }
 
+// BackfillProgress provides a mock function with given fields: ctx
+func (_m *LogPoller) BackfillProgress(ctx context.Context) (*logpoller.BackfillProgress, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackfillProgress")
+	}
+
+	var r0 *logpoller.BackfillProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*logpoller.BackfillProgress, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *logpoller.BackfillProgress); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.BackfillProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_BackfillProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackfillProgress'
+type LogPoller_BackfillProgress_Call struct {
+	*mock.Call
+}
+
+// BackfillProgress is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LogPoller_Expecter) BackfillProgress(ctx interface{}) *LogPoller_BackfillProgress_Call {
+	return &LogPoller_BackfillProgress_Call{Call: _e.mock.On("BackfillProgress", ctx)}
+}
+
+func (_c *LogPoller_BackfillProgress_Call) Run(run func(ctx context.Context)) *LogPoller_BackfillProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LogPoller_BackfillProgress_Call) Return(_a0 *logpoller.BackfillProgress, _a1 error) *LogPoller_BackfillProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_BackfillProgress_Call) RunAndReturn(run func(context.Context) (*logpoller.BackfillProgress, error)) *LogPoller_BackfillProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *LogPoller) Close() error {
 	ret := _m.Called()
@@ -22,6 +22,33 @@ type LogPollerBlock struct {
 	CreatedAt            time.Time
 }
 
+// BackfillProgress is the checkpoint of a replay of finalized blocks. All blocks from FromBlock up to and including
+// CompletedBlock have been backfilled, so an interrupted replay can resume from CompletedBlock + 1.
+type BackfillProgress struct {
+	EvmChainId     *big.Big
+	FromBlock      int64
+	ToBlock        int64
+	CompletedBlock int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// RemainingBlocks returns the number of blocks left to backfill.
+func (p BackfillProgress) RemainingBlocks() int64 {
+	return p.ToBlock - p.CompletedBlock
+}
+
+// ETA estimates the time left to backfill the remaining blocks from the average rate since the replay started, or
+// returns false if nothing has been backfilled yet.
+func (p BackfillProgress) ETA() (time.Duration, bool) {
+	done := p.CompletedBlock - p.FromBlock + 1
+	elapsed := p.UpdatedAt.Sub(p.CreatedAt)
+	if done <= 0 || elapsed <= 0 {
+		return 0, false
+	}
+	return time.Duration(float64(elapsed) / float64(done) * float64(p.RemainingBlocks())), true
+}
+
 // Log represents an EVM log.
 type Log struct {
 	EvmChainId     *big.Big
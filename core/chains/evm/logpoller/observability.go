@@ -136,6 +136,24 @@ func (o *ObservedORM) DeleteLogsAndBlocksAfter(ctx context.Context, start int64)
 	})
 }
 
+func (o *ObservedORM) UpsertBackfillProgress(ctx context.Context, progress BackfillProgress) error {
+	return withObservedExec(o, "UpsertBackfillProgress", create, func() error {
+		return o.ORM.UpsertBackfillProgress(ctx, progress)
+	})
+}
+
+func (o *ObservedORM) SelectBackfillProgress(ctx context.Context) (*BackfillProgress, error) {
+	return withObservedQuery(o, "SelectBackfillProgress", func() (*BackfillProgress, error) {
+		return o.ORM.SelectBackfillProgress(ctx)
+	})
+}
+
+func (o *ObservedORM) DeleteBackfillProgress(ctx context.Context) error {
+	return withObservedExec(o, "DeleteBackfillProgress", del, func() error {
+		return o.ORM.DeleteBackfillProgress(ctx)
+	})
+}
+
 func (o *ObservedORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error) {
 	return withObservedExecAndRowsAffected(o, "DeleteExpiredLogs", del, func() (int64, error) {
 		return o.ORM.DeleteExpiredLogs(ctx, limit)
@@ -38,6 +38,9 @@ type ORM interface {
 	InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error
 	DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
+	UpsertBackfillProgress(ctx context.Context, progress BackfillProgress) error
+	SelectBackfillProgress(ctx context.Context) (*BackfillProgress, error)
+	DeleteBackfillProgress(ctx context.Context) error
 	SelectUnmatchedLogIDs(ctx context.Context, limit int64) (ids []uint64, err error)
 	DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error)
 	SelectExcessLogIDs(ctx context.Context, limit int64) (rowIDs []uint64, err error)
@@ -375,6 +378,35 @@ func (o *DSORM) DeleteBlocksBefore(ctx context.Context, end int64, limit int64)
 	return q.ExecPagedQuery(ctx, limit, end)
 }
 
+// UpsertBackfillProgress saves the checkpoint of the replay in progress, replacing any previous one.
+func (o *DSORM) UpsertBackfillProgress(ctx context.Context, progress BackfillProgress) error {
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO evm.log_poller_backfills
+			(evm_chain_id, from_block, to_block, completed_block, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (evm_chain_id) DO UPDATE SET
+			from_block = EXCLUDED.from_block,
+			to_block = EXCLUDED.to_block,
+			completed_block = EXCLUDED.completed_block,
+			created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at`,
+		ubig.New(o.chainID), progress.FromBlock, progress.ToBlock, progress.CompletedBlock, progress.CreatedAt, progress.UpdatedAt)
+	return err
+}
+
+// SelectBackfillProgress returns the checkpoint of the replay in progress, or sql.ErrNoRows if there is none.
+func (o *DSORM) SelectBackfillProgress(ctx context.Context) (*BackfillProgress, error) {
+	var p BackfillProgress
+	if err := o.ds.GetContext(ctx, &p, `SELECT * FROM evm.log_poller_backfills WHERE evm_chain_id = $1`, ubig.New(o.chainID)); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (o *DSORM) DeleteBackfillProgress(ctx context.Context) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM evm.log_poller_backfills WHERE evm_chain_id = $1`, ubig.New(o.chainID))
+	return err
+}
+
 func (o *DSORM) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
 	// These deletes are bounded by reorg depth, so they are
 	// fast and should not slow down the log readers.
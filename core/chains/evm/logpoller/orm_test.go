@@ -1403,6 +1403,45 @@ func TestORM_DeleteBlocksBefore(t *testing.T) {
 	require.Equal(t, err, sql.ErrNoRows)
 }
 
+func TestORM_BackfillProgress(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o1, o2 := th.ORM, th.ORM2
+	ctx := testutils.Context(t)
+
+	_, err := o1.SelectBackfillProgress(ctx)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	progress := logpoller.BackfillProgress{
+		EvmChainId:     ubig.New(th.ChainID),
+		FromBlock:      10,
+		ToBlock:        100,
+		CompletedBlock: 9,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	require.NoError(t, o1.UpsertBackfillProgress(ctx, progress))
+	progress.CompletedBlock = 50
+	progress.UpdatedAt = now.Add(time.Minute)
+	require.NoError(t, o1.UpsertBackfillProgress(ctx, progress))
+
+	got, err := o1.SelectBackfillProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), got.FromBlock)
+	assert.Equal(t, int64(100), got.ToBlock)
+	assert.Equal(t, int64(50), got.CompletedBlock)
+	assert.True(t, now.Equal(got.CreatedAt))
+	assert.True(t, now.Add(time.Minute).Equal(got.UpdatedAt))
+
+	// checkpoints are per chain
+	_, err = o2.SelectBackfillProgress(ctx)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, o1.DeleteBackfillProgress(ctx))
+	_, err = o1.SelectBackfillProgress(ctx)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func TestLogPoller_Logs(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
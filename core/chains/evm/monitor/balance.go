@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -43,6 +44,9 @@ type (
 		ethBalances    map[gethCommon.Address]*assets.Eth
 		ethBalancesMtx sync.RWMutex
 		sleeperTask    *utils.SleeperTask
+		topUpper       *TopUpper
+		// latestHead is the number of the latest head seen, or 0 before the first head
+		latestHead atomic.Int64
 	}
 
 	NullBalanceMonitor struct{}
@@ -69,6 +73,11 @@ func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lgg
 	return bm
 }
 
+// SetTopUpper enables top-ups of the keys whose balance is below their threshold. It must be called before Start.
+func (bm *balanceMonitor) SetTopUpper(tu *TopUpper) {
+	bm.topUpper = tu
+}
+
 func (bm *balanceMonitor) start(ctx context.Context) error {
 	// Always query latest balance on start
 	(&worker{bm}).WorkCtx(ctx)
@@ -81,7 +90,10 @@ func (bm *balanceMonitor) close() error {
 }
 
 // OnNewLongestChain checks the balance for each key
-func (bm *balanceMonitor) OnNewLongestChain(_ context.Context, _ *evmtypes.Head) {
+func (bm *balanceMonitor) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	if head != nil {
+		bm.latestHead.Store(head.Number)
+	}
 	bm.eng.Debugw("BalanceMonitor: signalling balance worker")
 	ok := bm.sleeperTask.WakeUpIfStarted()
 	if !ok {
@@ -167,6 +179,16 @@ func (w *worker) WorkCtx(ctx context.Context) {
 		}(address)
 	}
 	wg.Wait()
+
+	if w.bm.topUpper != nil {
+		balances := make(map[gethCommon.Address]*assets.Eth, len(enabledAddresses))
+		for _, address := range enabledAddresses {
+			if bal := w.bm.GetEthBalance(address); bal != nil {
+				balances[address] = bal
+			}
+		}
+		w.bm.topUpper.TopUp(ctx, w.bm.latestHead.Load(), balances)
+	}
 }
 
 // Approximately ETH block time
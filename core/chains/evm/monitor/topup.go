@@ -0,0 +1,194 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+var promTopUps = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "balance_monitor_top_ups",
+		Help: "The number of top-ups of sending keys from the treasury key, by outcome",
+	},
+	[]string{"account", "evmChainID", "outcome"},
+)
+
+const (
+	topUpSent                 = "sent"
+	topUpFailed               = "failed"
+	topUpDailyCapReached      = "daily_cap_reached"
+	topUpInsufficientTreasury = "insufficient_treasury"
+
+	// topUpCapWindow is the window that the daily cap is counted over
+	topUpCapWindow = 24 * time.Hour
+	// topUpMetaField is the TxMeta field that marks top-up transactions
+	topUpMetaField = "TopUpKey"
+)
+
+var (
+	// topUpInFlightStates are the states of top-ups that have not been mined yet
+	topUpInFlightStates = []txmgrtypes.TxState{txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress, txmgrcommon.TxUnconfirmed}
+	// topUpSpentStates are the states of top-ups that count towards the daily cap
+	topUpSpentStates = append([]txmgrtypes.TxState{txmgrcommon.TxConfirmed, txmgrcommon.TxConfirmedMissingReceipt, txmgrcommon.TxFinalized}, topUpInFlightStates...)
+)
+
+// TopUpper tops up sending keys whose balance falls below their threshold with a transfer from the treasury key,
+// enqueued through the TxManager. At most one top-up per key is in flight, and the total value transferred is capped
+// over a rolling 24 hour window. Both are derived from the top-up transactions in the TxStore, which are marked with
+// the TopUpKey meta field, so that they hold across restarts. The cap counts the value transferred only, not the fees
+// paid by the treasury key. Each top-up is also recorded by the TopUpORM.
+type TopUpper struct {
+	lggr        logger.SugaredLogger
+	cfg         config.BalanceMonitorTopUp
+	txm         txmgr.TxManager
+	orm         TopUpORM
+	auditLogger audit.AuditLogger
+	chainID     *big.Int
+	gasLimit    uint64
+}
+
+// NewTopUpper returns a TopUpper sending transfers with gasLimit on chainID. auditLogger may be nil.
+func NewTopUpper(cfg config.BalanceMonitorTopUp, txm txmgr.TxManager, orm TopUpORM, auditLogger audit.AuditLogger, chainID *big.Int, gasLimit uint64, lggr logger.Logger) *TopUpper {
+	if auditLogger == nil {
+		auditLogger = audit.NoopLogger
+	}
+	return &TopUpper{
+		lggr:        logger.Sugared(logger.Named(lggr, "TopUpper")),
+		cfg:         cfg,
+		txm:         txm,
+		orm:         orm,
+		auditLogger: auditLogger,
+		chainID:     chainID,
+		gasLimit:    gasLimit,
+	}
+}
+
+// TopUp enqueues a transfer from the treasury key to each key of balances that is below its threshold. head is the
+// number of the latest head, which makes the top-ups idempotent per head, or 0 if no head has been seen yet.
+func (t *TopUpper) TopUp(ctx context.Context, head int64, balances map[gethCommon.Address]*assets.Eth) {
+	if !t.cfg.Enabled() || t.cfg.TreasuryKey() == nil {
+		return
+	}
+	treasury := t.cfg.TreasuryKey().Address()
+	treasuryBal, ok := balances[treasury]
+	if !ok {
+		t.lggr.Warnw("Skipping top-ups: treasury key balance is unknown, is the key enabled for this chain?", "treasury", treasury)
+		return
+	}
+	txes, err := t.txm.FindTxesWithMetaFieldByStates(ctx, topUpMetaField, topUpSpentStates, t.chainID)
+	if err != nil {
+		t.lggr.Errorw("Skipping top-ups: failed to load previous top-ups", "err", err)
+		return
+	}
+	spent, inFlight := t.previousTopUps(txes, time.Now())
+	// the balance does not include the top-ups that have not been mined yet
+	available := new(big.Int).Set(treasuryBal.ToInt())
+	for _, value := range inFlight {
+		available.Sub(available, value)
+	}
+
+	for address, bal := range balances {
+		if address == treasury {
+			continue
+		}
+		threshold := t.cfg.ThresholdKey(address)
+		if threshold == nil || threshold.IsZero() || bal.ToInt().Cmp(threshold.ToInt()) >= 0 {
+			continue
+		}
+		value := new(big.Int).Sub(t.cfg.TargetKey(address).ToInt(), bal.ToInt())
+		if value.Sign() <= 0 {
+			continue
+		}
+		if _, ok := inFlight[address]; ok {
+			continue
+		}
+		if available.Cmp(value) < 0 {
+			t.lggr.Errorw("Skipping top-up: treasury key balance is too low", "address", address, "value", (*assets.Eth)(value), "treasury", treasury, "treasuryBalance", (*assets.Eth)(available))
+			promTopUps.WithLabelValues(address.Hex(), t.chainID.String(), topUpInsufficientTreasury).Inc()
+			continue
+		}
+		if dailyCap := t.cfg.DailyCap().ToInt(); new(big.Int).Add(spent, value).Cmp(dailyCap) > 0 {
+			t.lggr.Errorw(fmt.Sprintf("Skipping top-up: daily cap reached: %s transferred in the last 24h", (*assets.Eth)(spent)), "address", address, "value", (*assets.Eth)(value), "dailyCap", t.cfg.DailyCap())
+			promTopUps.WithLabelValues(address.Hex(), t.chainID.String(), topUpDailyCapReached).Inc()
+			continue
+		}
+		if err := t.send(ctx, head, treasury, address, bal, value); err != nil {
+			t.lggr.Errorw("Failed to enqueue top-up", "address", address, "value", (*assets.Eth)(value), "err", err)
+			promTopUps.WithLabelValues(address.Hex(), t.chainID.String(), topUpFailed).Inc()
+			continue
+		}
+		available.Sub(available, value)
+		spent.Add(spent, value)
+		promTopUps.WithLabelValues(address.Hex(), t.chainID.String(), topUpSent).Inc()
+	}
+}
+
+// previousTopUps returns the value of txes created within the daily cap window, and the value of the txes that have
+// not been mined yet by key. Failed top-ups are not included in txes, so they do not count towards the cap.
+func (t *TopUpper) previousTopUps(txes []*txmgr.Tx, now time.Time) (spent *big.Int, inFlight map[gethCommon.Address]*big.Int) {
+	spent = new(big.Int)
+	inFlight = make(map[gethCommon.Address]*big.Int)
+	for _, tx := range txes {
+		if now.Sub(tx.CreatedAt) < topUpCapWindow {
+			spent.Add(spent, &tx.Value)
+		}
+		switch tx.State {
+		case txmgrcommon.TxUnstarted, txmgrcommon.TxInProgress, txmgrcommon.TxUnconfirmed:
+			inFlight[tx.ToAddress] = &tx.Value
+		}
+	}
+	return
+}
+
+func (t *TopUpper) send(ctx context.Context, head int64, treasury, address gethCommon.Address, bal *assets.Eth, value *big.Int) error {
+	// a key is topped up at most once per head, so that a head that is processed twice does not send a second top-up
+	var idempotencyKey *string
+	if head > 0 {
+		key := fmt.Sprintf("balancemonitor-topup-%s-%s-%d", t.chainID, address.Hex(), head)
+		idempotencyKey = &key
+	}
+	etx, err := t.txm.CreateTransaction(ctx, txmgr.TxRequest{
+		IdempotencyKey: idempotencyKey,
+		FromAddress:    treasury,
+		ToAddress:      address,
+		EncodedPayload: []byte{},
+		Value:          *value,
+		FeeLimit:       t.gasLimit,
+		Meta:           &txmgr.TxMeta{TopUpKey: &address},
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		Priority:       txmgrtypes.TxPriorityAdmin,
+	})
+	if err != nil {
+		return err
+	}
+
+	t.lggr.Infow("Enqueued top-up", "address", address, "balance", bal, "value", (*assets.Eth)(value), "treasury", treasury, "txID", etx.ID)
+	// the transaction is already enqueued, so a failure to record it must not fail the top-up
+	if err = t.orm.InsertTopUp(ctx, t.chainID, etx.ID, treasury, address, bal.ToInt(), value); err != nil {
+		t.lggr.Errorw("Failed to record top-up", "address", address, "value", (*assets.Eth)(value), "txID", etx.ID, "err", err)
+	}
+	t.auditLogger.Audit(audit.EthTopUpCreated, map[string]interface{}{
+		"evmChainID": t.chainID.String(),
+		"from":       treasury,
+		"to":         address,
+		"balance":    bal,
+		"value":      (*assets.Eth)(value),
+		"ethTX":      etx,
+	})
+	return nil
+}
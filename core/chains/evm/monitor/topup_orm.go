@@ -0,0 +1,34 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// TopUpORM persists the audit records of top-ups, which outlive the transactions once they are reaped.
+type TopUpORM interface {
+	// InsertTopUp records a top-up enqueued as the transaction with ID txID.
+	InsertTopUp(ctx context.Context, chainID *big.Int, txID int64, from, to gethCommon.Address, balance, value *big.Int) error
+}
+
+type topUpORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ TopUpORM = (*topUpORM)(nil)
+
+func NewTopUpORM(ds sqlutil.DataSource) TopUpORM {
+	return &topUpORM{ds: ds}
+}
+
+func (o *topUpORM) InsertTopUp(ctx context.Context, chainID *big.Int, txID int64, from, to gethCommon.Address, balance, value *big.Int) error {
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO evm.balance_monitor_top_ups (evm_chain_id, eth_tx_id, from_address, to_address, balance, value, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, NOW())`, ubig.New(chainID), txID, from, to, ubig.New(balance), ubig.New(value))
+	return err
+}
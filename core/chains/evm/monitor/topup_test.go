@@ -0,0 +1,234 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+type topUpConfig struct {
+	treasury  common.Address
+	threshold *assets.Wei
+	target    *assets.Wei
+	dailyCap  *assets.Wei
+	keys      map[common.Address][2]*assets.Wei
+}
+
+func (c *topUpConfig) Enabled() bool { return true }
+func (c *topUpConfig) TreasuryKey() *types.EIP55Address {
+	a := types.EIP55AddressFromAddress(c.treasury)
+	return &a
+}
+func (c *topUpConfig) ThresholdKey(addr common.Address) *assets.Wei {
+	if k, ok := c.keys[addr]; ok {
+		return k[0]
+	}
+	return c.threshold
+}
+func (c *topUpConfig) TargetKey(addr common.Address) *assets.Wei {
+	if k, ok := c.keys[addr]; ok {
+		return k[1]
+	}
+	return c.target
+}
+func (c *topUpConfig) DailyCap() *assets.Wei { return c.dailyCap }
+
+type topUpORM struct {
+	mu     sync.Mutex
+	topUps []common.Address
+}
+
+func (o *topUpORM) InsertTopUp(_ context.Context, _ *big.Int, _ int64, _, to common.Address, _, _ *big.Int) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.topUps = append(o.topUps, to)
+	return nil
+}
+
+func expectTopUp(txm *txmmocks.MockEvmTxManager, from, to common.Address, value int64) *mock.Call {
+	return txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(r txmgr.TxRequest) bool {
+		return r.FromAddress == from && r.ToAddress == to && r.Value.Cmp(big.NewInt(value)) == 0 &&
+			r.Meta != nil && r.Meta.TopUpKey != nil && *r.Meta.TopUpKey == to
+	})).Once().Return(txmgr.Tx{ID: 1}, nil)
+}
+
+// expectPreviousTopUps returns txes as the top-ups found in the TxStore.
+func expectPreviousTopUps(txm *txmmocks.MockEvmTxManager, txes ...*txmgr.Tx) *mock.Call {
+	return txm.On("FindTxesWithMetaFieldByStates", mock.Anything, "TopUpKey", mock.Anything, mock.Anything).Once().Return(txes, nil)
+}
+
+func topUpTx(to common.Address, value int64, state txmgrtypes.TxState, createdAt time.Time) *txmgr.Tx {
+	return &txmgr.Tx{ToAddress: to, Value: *big.NewInt(value), State: state, CreatedAt: createdAt}
+}
+
+func TestTopUpper_TopUp(t *testing.T) {
+	t.Parallel()
+
+	treasury := testutils.NewAddress()
+	k0 := testutils.NewAddress()
+	k1 := testutils.NewAddress()
+	chainID := big.NewInt(0)
+	const head = 42
+
+	newConfig := func() *topUpConfig {
+		return &topUpConfig{
+			treasury:  treasury,
+			threshold: assets.NewWeiI(100),
+			target:    assets.NewWeiI(500),
+			dailyCap:  assets.NewWeiI(1_000),
+			keys:      map[common.Address][2]*assets.Wei{},
+		}
+	}
+
+	t.Run("tops up keys below their threshold up to their target and records the top-ups", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.keys[k1] = [2]*assets.Wei{assets.NewWeiI(300), assets.NewWeiI(400)}
+		txm := txmmocks.NewMockEvmTxManager(t)
+		orm := &topUpORM{}
+		tu := monitor.NewTopUpper(cfg, txm, orm, nil, chainID, 21_000, logger.Test(t))
+
+		expectPreviousTopUps(txm)
+		expectTopUp(txm, treasury, k0, 450)
+		expectTopUp(txm, treasury, k1, 150)
+		tu.TopUp(tests.Context(t), head, map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10_000),
+			k0:       assets.NewEth(50),
+			k1:       assets.NewEth(250),
+		})
+		assert.ElementsMatch(t, []common.Address{k0, k1}, orm.topUps)
+	})
+
+	t.Run("skips keys above their threshold, the treasury and keys with a zero threshold", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.keys[k1] = [2]*assets.Wei{assets.NewWeiI(0), assets.NewWeiI(400)}
+		txm := txmmocks.NewMockEvmTxManager(t)
+		tu := monitor.NewTopUpper(cfg, txm, &topUpORM{}, nil, chainID, 21_000, logger.Test(t))
+
+		expectPreviousTopUps(txm)
+		tu.TopUp(tests.Context(t), head, map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10),
+			k0:       assets.NewEth(100),
+			k1:       assets.NewEth(0),
+		})
+	})
+
+	t.Run("does not top up while the last top-up is in flight", func(t *testing.T) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		tu := monitor.NewTopUpper(newConfig(), txm, &topUpORM{}, nil, chainID, 21_000, logger.Test(t))
+		balances := map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10_000),
+			k0:       assets.NewEth(50),
+		}
+
+		expectPreviousTopUps(txm, topUpTx(k0, 450, txmgrcommon.TxUnconfirmed, time.Now()))
+		tu.TopUp(tests.Context(t), head, balances)
+
+		expectPreviousTopUps(txm, topUpTx(k0, 450, txmgrcommon.TxConfirmed, time.Now()))
+		expectTopUp(txm, treasury, k0, 450)
+		tu.TopUp(tests.Context(t), head, balances)
+	})
+
+	t.Run("respects the daily cap", func(t *testing.T) {
+		cfg := newConfig()
+		cfg.dailyCap = assets.NewWeiI(850)
+		txm := txmmocks.NewMockEvmTxManager(t)
+		tu := monitor.NewTopUpper(cfg, txm, &topUpORM{}, nil, chainID, 21_000, logger.Test(t))
+
+		// 450 + 401 exceeds the cap
+		expectPreviousTopUps(txm, topUpTx(k0, 450, txmgrcommon.TxConfirmed, time.Now().Add(-time.Hour)))
+		tu.TopUp(tests.Context(t), head, map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10_000),
+			k1:       assets.NewEth(100 - 1),
+		})
+
+		// top-ups older than a day do not count
+		expectPreviousTopUps(txm, topUpTx(k0, 450, txmgrcommon.TxConfirmed, time.Now().Add(-25*time.Hour)))
+		expectTopUp(txm, treasury, k1, 401)
+		tu.TopUp(tests.Context(t), head, map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10_000),
+			k1:       assets.NewEth(100 - 1),
+		})
+	})
+
+	t.Run("skips top-ups the treasury cannot afford", func(t *testing.T) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		tu := monitor.NewTopUpper(newConfig(), txm, &topUpORM{}, nil, chainID, 21_000, logger.Test(t))
+
+		expectPreviousTopUps(txm)
+		tu.TopUp(tests.Context(t), head, map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(449),
+			k0:       assets.NewEth(50),
+		})
+
+		// in flight top-ups are not part of the treasury balance yet
+		expectPreviousTopUps(txm, topUpTx(k1, 200, txmgrcommon.TxUnstarted, time.Now()))
+		tu.TopUp(tests.Context(t), head, map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(600),
+			k0:       assets.NewEth(50),
+		})
+	})
+
+	t.Run("skips top-ups when the treasury is not a sending key", func(t *testing.T) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		tu := monitor.NewTopUpper(newConfig(), txm, &topUpORM{}, nil, chainID, 21_000, logger.Test(t))
+
+		tu.TopUp(tests.Context(t), head, map[common.Address]*assets.Eth{
+			k0: assets.NewEth(50),
+		})
+	})
+
+	t.Run("top-ups are idempotent per key and head", func(t *testing.T) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		tu := monitor.NewTopUpper(newConfig(), txm, &topUpORM{}, nil, chainID, 21_000, logger.Test(t))
+		balances := map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10_000),
+			k0:       assets.NewEth(50),
+		}
+
+		expectPreviousTopUps(txm)
+		expectTopUp(txm, treasury, k0, 450).Run(func(args mock.Arguments) {
+			assert.Equal(t, fmt.Sprintf("balancemonitor-topup-0-%s-42", k0.Hex()), *args.Get(1).(txmgr.TxRequest).IdempotencyKey)
+		})
+		tu.TopUp(tests.Context(t), head, balances)
+
+		// without a head there is nothing stable to derive the key from
+		expectPreviousTopUps(txm)
+		txm.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(r txmgr.TxRequest) bool {
+			return r.IdempotencyKey == nil
+		})).Once().Return(txmgr.Tx{ID: 2}, nil)
+		tu.TopUp(tests.Context(t), 0, balances)
+	})
+
+	t.Run("does not record failed top-ups", func(t *testing.T) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		orm := &topUpORM{}
+		tu := monitor.NewTopUpper(newConfig(), txm, orm, nil, chainID, 21_000, logger.Test(t))
+
+		expectPreviousTopUps(txm)
+		txm.On("CreateTransaction", mock.Anything, mock.Anything).Once().Return(txmgr.Tx{}, assert.AnError)
+		tu.TopUp(tests.Context(t), head, map[common.Address]*assets.Eth{
+			treasury: assets.NewEth(10_000),
+			k0:       assets.NewEth(50),
+		})
+		assert.Empty(t, orm.topUps)
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -35,6 +36,7 @@ type evmTxAttemptBuilder struct {
 type evmTxAttemptBuilderFeeConfig interface {
 	EIP1559DynamicFees() bool
 	PriceMaxKey(common.Address) *assets.Wei
+	BlobPriceMax() *assets.Wei
 	LimitDefault() uint64
 }
 
@@ -44,9 +46,12 @@ func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeCon
 
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
 // used for when a brand new transaction is being created in the txm
+// Transactions carrying blobs are always sent as EIP-4844 blob transactions
 func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	txType := 0x0
-	if c.feeConfig.EIP1559DynamicFees() {
+	if len(etx.Blobs) > 0 {
+		txType = 0x3
+	} else if c.feeConfig.EIP1559DynamicFees() {
 		txType = 0x2
 	}
 	return c.NewTxAttemptWithType(ctx, etx, lggr, txType, opts...)
@@ -60,6 +65,12 @@ func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx,
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
+	if txType == 0x3 {
+		fee.BlobFeeCap, err = c.EvmFeeEstimator.GetBlobFee(ctx)
+		if err != nil {
+			return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get blob fee") // estimator errors are retryable
+		}
+	}
 
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, fee, feeLimit, txType, lggr)
 	return attempt, fee, feeLimit, retryable, err
@@ -132,6 +143,17 @@ func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fe
 			GasTipCap: fee.GasTipCap,
 		}, gasLimit)
 		return attempt, true, err
+	case 0x3: // blob, EIP4844
+		if !fee.ValidDynamic() || fee.BlobFeeCap == nil {
+			err = pkgerrors.Errorf("Attempt %v is a type 3 transaction but estimator did not return dynamic and blob fees. Blob transactions require EIP1559DynamicFees to be enabled", attempt.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		attempt, err = c.newBlobAttempt(ctx, etx, gas.DynamicFee{
+			GasFeeCap: fee.GasFeeCap,
+			GasTipCap: fee.GasTipCap,
+		}, fee.BlobFeeCap, gasLimit)
+		return attempt, true, err
 	default:
 		err = pkgerrors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
@@ -197,6 +219,46 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+func (c *evmTxAttemptBuilder) newBlobAttempt(ctx context.Context, etx Tx, fee gas.DynamicFee, blobFeeCap *assets.Wei, gasLimit uint64) (attempt TxAttempt, err error) {
+	if err = validateDynamicFeeGas(c.feeConfig, fee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+	if max := c.feeConfig.BlobPriceMax(); blobFeeCap.Cmp(max) > 0 {
+		return attempt, pkgerrors.Errorf("cannot create tx attempt: specified blob fee cap of %s would exceed max configured blob gas price of %s", blobFeeCap.String(), max.String())
+	}
+	// The sidecar is not part of the signed payload, but it is kept in SignedRawTx so that the attempt can be
+	// broadcast and rebroadcast as is
+	sidecar, err := newBlobTxSidecar(etx.Blobs)
+	if err != nil {
+		return attempt, pkgerrors.Wrap(err, "error building blob sidecar")
+	}
+
+	b := newBlobTransaction(
+		uint64(*etx.Sequence),
+		etx.ToAddress,
+		&etx.Value,
+		gasLimit,
+		&c.chainID,
+		fee.GasTipCap,
+		fee.GasFeeCap,
+		blobFeeCap,
+		etx.EncodedPayload,
+		sidecar,
+	)
+	tx := types.NewTx(&b)
+	attempt, err = c.newSignedAttempt(ctx, etx, tx)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFee: gas.DynamicFee{GasFeeCap: fee.GasFeeCap, GasTipCap: fee.GasTipCap},
+		BlobFeeCap: blobFeeCap,
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = 3
+	return attempt, nil
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
@@ -248,6 +310,22 @@ func newDynamicFeeTransaction(nonce uint64, to common.Address, value *big.Int, g
 	}
 }
 
+func newBlobTransaction(nonce uint64, to common.Address, value *big.Int, gasLimit uint64, chainID *big.Int, gasTipCap, gasFeeCap, blobFeeCap *assets.Wei, data []byte, sidecar *types.BlobTxSidecar) types.BlobTx {
+	return types.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		Nonce:      nonce,
+		GasTipCap:  uint256.MustFromBig(gasTipCap.ToInt()),
+		GasFeeCap:  uint256.MustFromBig(gasFeeCap.ToInt()),
+		Gas:        gasLimit,
+		To:         to,
+		Value:      uint256.MustFromBig(value),
+		Data:       data,
+		BlobFeeCap: uint256.MustFromBig(blobFeeCap.ToInt()),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	}
+}
+
 func (c *evmTxAttemptBuilder) newLegacyAttempt(ctx context.Context, etx Tx, gasPrice *assets.Wei, gasLimit uint64) (attempt TxAttempt, err error) {
 	if err = validateLegacyGas(c.feeConfig, gasPrice, etx); err != nil {
 		return attempt, pkgerrors.Wrap(err, "error validating gas")
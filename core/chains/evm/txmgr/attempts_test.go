@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -36,14 +37,16 @@ type feeConfig struct {
 	tipCapMin          *assets.Wei
 	priceMin           *assets.Wei
 	priceMax           *assets.Wei
+	blobPriceMax       *assets.Wei
 	limitDefault       uint64
 }
 
 func newFeeConfig() *feeConfig {
 	return &feeConfig{
-		tipCapMin: assets.NewWeiI(0),
-		priceMin:  assets.NewWeiI(0),
-		priceMax:  assets.NewWeiI(0),
+		tipCapMin:    assets.NewWeiI(0),
+		priceMin:     assets.NewWeiI(0),
+		priceMax:     assets.NewWeiI(0),
+		blobPriceMax: assets.NewWeiI(0),
 	}
 }
 
@@ -51,6 +54,7 @@ func (g *feeConfig) EIP1559DynamicFees() bool                        { return g.
 func (g *feeConfig) TipCapMin() *assets.Wei                          { return g.tipCapMin }
 func (g *feeConfig) PriceMin() *assets.Wei                           { return g.priceMin }
 func (g *feeConfig) PriceMaxKey(addr gethcommon.Address) *assets.Wei { return g.priceMax }
+func (g *feeConfig) BlobPriceMax() *assets.Wei                       { return g.blobPriceMax }
 func (g *feeConfig) LimitDefault() uint64                            { return g.limitDefault }
 
 func TestTxm_SignTx(t *testing.T) {
@@ -191,6 +195,86 @@ func TestTxm_NewDynamicFeeTx(t *testing.T) {
 	})
 }
 
+func TestTxm_NewBlobTx(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(
+		func(_ context.Context, _ gethcommon.Address, tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+			return tx, nil
+		}).Maybe()
+	lggr := logger.Test(t)
+
+	feeCfg := newFeeConfig()
+	feeCfg.priceMax = assets.GWei(100)
+	feeCfg.blobPriceMax = assets.GWei(10)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), feeCfg, kst, nil)
+
+	blobs, err := txmgr.EncodeBlobs([]byte("report"))
+	require.NoError(t, err)
+	var n evmtypes.Nonce
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: blobs}
+	dynamicFee := gas.DynamicFee{GasTipCap: assets.GWei(1), GasFeeCap: assets.GWei(20)}
+
+	t.Run("creates attempt carrying the blob sidecar", func(t *testing.T) {
+		a, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, gas.EvmFee{DynamicFee: dynamicFee, BlobFeeCap: assets.GWei(2)}, 100, 0x3, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+		assert.Equal(t, assets.GWei(2).String(), a.TxFee.BlobFeeCap.String())
+		assert.Equal(t, uint64(100), a.ChainSpecificFeeLimit)
+
+		tx, err := txmgr.GetGethSignedTx(a.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, a.Hash, tx.Hash())
+		assert.Equal(t, assets.GWei(2).ToInt(), tx.BlobGasFeeCap())
+		require.NotNil(t, tx.BlobTxSidecar())
+		assert.Equal(t, tx.BlobTxSidecar().BlobHashes(), tx.BlobHashes())
+		assert.Len(t, tx.BlobHashes(), 1)
+	})
+
+	t.Run("fails if blob fee cap exceeds BlobPriceMax", func(t *testing.T) {
+		_, _, err := cks.NewCustomTxAttempt(tests.Context(t), etx, gas.EvmFee{DynamicFee: dynamicFee, BlobFeeCap: assets.GWei(11)}, 100, 0x3, lggr)
+		require.ErrorContains(t, err, "would exceed max configured blob gas price")
+	})
+
+	t.Run("fails without blob fee", func(t *testing.T) {
+		_, retryable, err := cks.NewCustomTxAttempt(tests.Context(t), etx, gas.EvmFee{DynamicFee: dynamicFee}, 100, 0x3, lggr)
+		require.Error(t, err)
+		assert.False(t, retryable)
+	})
+}
+
+func TestEncodeBlobs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("packs 31 bytes into each field element", func(t *testing.T) {
+		data := make([]byte, 40)
+		for i := range data {
+			data[i] = byte(i + 1)
+		}
+		blobs, err := txmgr.EncodeBlobs(data)
+		require.NoError(t, err)
+		require.Len(t, blobs, 1)
+		assert.Len(t, blobs[0], 131072)
+		assert.Equal(t, byte(0), blobs[0][0])
+		assert.Equal(t, data[:31], blobs[0][1:32])
+		assert.Equal(t, byte(0), blobs[0][32])
+		assert.Equal(t, data[31:], blobs[0][33:42])
+	})
+
+	t.Run("uses as few blobs as possible", func(t *testing.T) {
+		blobs, err := txmgr.EncodeBlobs(make([]byte, txmgr.MaxBlobDataSize+1))
+		require.NoError(t, err)
+		assert.Len(t, blobs, 2)
+	})
+
+	t.Run("fails for empty or too much data", func(t *testing.T) {
+		_, err := txmgr.EncodeBlobs(nil)
+		require.Error(t, err)
+		_, err = txmgr.EncodeBlobs(make([]byte, txmgr.MaxBlobsPerTx*txmgr.MaxBlobDataSize+1))
+		require.Error(t, err)
+	})
+}
+
 func TestTxm_NewLegacyAttempt(t *testing.T) {
 	addr := NewEvmAddress()
 	kst := ksmocks.NewEth(t)
@@ -0,0 +1,71 @@
+package txmgr
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// MaxBlobsPerTx is the most blobs a single EIP-4844 transaction can carry, bound by the blob gas limit of a block
+	MaxBlobsPerTx = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+	// blobFieldElements is the number of 32 byte field elements in a blob
+	blobFieldElements = len(kzg4844.Blob{}) / 32
+	// blobBytesPerFieldElement is the number of data bytes stored per field element. The first byte is always zero to
+	// keep the element below the BLS12-381 modulus.
+	blobBytesPerFieldElement = 31
+	// MaxBlobDataSize is the number of data bytes EncodeBlobs fits in a single blob
+	MaxBlobDataSize = blobFieldElements * blobBytesPerFieldElement
+)
+
+// EncodeBlobs splits data into as few blobs as possible to be sent with TxRequest.Blobs, by storing 31 bytes of data in
+// each field element. Consumers of the blobs need to decode them the same way.
+func EncodeBlobs(data []byte) ([][]byte, error) {
+	n := (len(data) + MaxBlobDataSize - 1) / MaxBlobDataSize
+	if n == 0 {
+		return nil, fmt.Errorf("blob data must not be empty")
+	}
+	if n > MaxBlobsPerTx {
+		return nil, fmt.Errorf("%d bytes of blob data need %d blobs, which exceeds the maximum of %d blobs per transaction", len(data), n, MaxBlobsPerTx)
+	}
+	blobs := make([][]byte, n)
+	for i := range blobs {
+		blob := make([]byte, len(kzg4844.Blob{}))
+		for fe := 0; fe < blobFieldElements && len(data) > 0; fe++ {
+			data = data[copy(blob[fe*32+1:(fe+1)*32], data):]
+		}
+		blobs[i] = blob
+	}
+	return blobs, nil
+}
+
+// newBlobTxSidecar computes the KZG commitments and proofs of blobs
+func newBlobTxSidecar(blobs [][]byte) (*types.BlobTxSidecar, error) {
+	if len(blobs) > MaxBlobsPerTx {
+		return nil, fmt.Errorf("transaction has %d blobs, which exceeds the maximum of %d", len(blobs), MaxBlobsPerTx)
+	}
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       make([]kzg4844.Blob, len(blobs)),
+		Commitments: make([]kzg4844.Commitment, len(blobs)),
+		Proofs:      make([]kzg4844.Proof, len(blobs)),
+	}
+	for i, b := range blobs {
+		if len(b) != len(kzg4844.Blob{}) {
+			return nil, fmt.Errorf("blob %d has a size of %d bytes, expected %d", i, len(b), len(kzg4844.Blob{}))
+		}
+		copy(sidecar.Blobs[i][:], b)
+		commitment, err := kzg4844.BlobToCommitment(sidecar.Blobs[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute commitment of blob %d: %w", i, err)
+		}
+		proof, err := kzg4844.ComputeBlobProof(sidecar.Blobs[i], commitment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute proof of blob %d: %w", i, err)
+		}
+		sidecar.Commitments[i] = commitment
+		sidecar.Proofs[i] = proof
+	}
+	return sidecar, nil
+}
@@ -22,6 +22,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/guregu/null.v4"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -733,6 +734,87 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Preemption(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.Preemption.Enabled = ptr(true)
+		c.EVM[0].Transactions.Preemption.MinPendingTime = commonconfig.MustNewDuration(time.Minute)
+	})
+	ctx := tests.Context(t)
+	txStore := cltest.NewTestTxStore(t, db)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	// A low priority tx which has been pending for longer than MinPendingTime
+	lowTx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress, time.Now().Add(-time.Hour), txmgrtypes.TxPriorityLow)
+	previousGasPrice := lowTx.TxAttempts[0].TxFee.GasPrice
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Maybe()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	highTx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      testutils.NewAddress(),
+		EncodedPayload: []byte{42, 42, 0},
+		FeeLimit:       1231,
+		Priority:       txmgrtypes.TxPriorityHigh,
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	}, testutils.FixtureChainID)
+
+	ethClient.On("NonceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(uint64(0), nil).Once()
+	// The high priority tx replaces the pending low priority tx
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0 && tx.GasPrice().Cmp(previousGasPrice.ToInt()) > 0
+	}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+	retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	etx, err := txStore.FindTxWithAttempts(ctx, highTx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+	require.NotNil(t, etx.Sequence)
+	assert.Equal(t, evmtypes.Nonce(0), *etx.Sequence)
+
+	// The low priority tx keeps its nonce and attempts, since it may still be mined instead of the high priority tx
+	etx, err = txStore.FindTxWithAttempts(ctx, lowTx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+	require.NotNil(t, etx.Sequence)
+	assert.Equal(t, evmtypes.Nonce(0), *etx.Sequence)
+	require.NotNil(t, etx.PreemptedByTxID)
+	assert.Equal(t, highTx.ID, *etx.PreemptedByTxID)
+	require.Len(t, etx.TxAttempts, 1)
+
+	// Once the high priority tx is confirmed, the low priority tx is sent again with the next nonce
+	_, err = db.ExecContext(ctx, `UPDATE evm.txes SET state = 'confirmed' WHERE id = $1`, highTx.ID)
+	require.NoError(t, err)
+	requeued, err := txStore.ResolvePreemptedTxs(ctx, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{lowTx.ID}, requeued)
+
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 1
+	}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+	retryable, err = eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	etx, err = txStore.FindTxWithAttempts(ctx, lowTx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+	require.NotNil(t, etx.Sequence)
+	assert.Equal(t, evmtypes.Nonce(1), *etx.Sequence)
+	require.Len(t, etx.TxAttempts, 1)
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := big.Int(assets.NewEthValue(142))
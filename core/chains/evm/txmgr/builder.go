@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 )
 
 type latestAndFinalizedBlockHeadTracker interface {
@@ -38,6 +39,7 @@ func NewTxm(
 	keyStore keystore.Eth,
 	estimator gas.EvmFeeEstimator,
 	headTracker latestAndFinalizedBlockHeadTracker,
+	auditLogger audit.AuditLogger,
 ) (txm TxManager,
 	err error,
 ) {
@@ -60,6 +62,16 @@ func NewTxm(
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
 	stuckTxDetector := NewStuckTxDetector(lggr, client.ConfiguredChainID(), chainConfig.ChainType(), fCfg.PriceMax(), txConfig.AutoPurge(), estimator, txStore, client)
 	evmConfirmer := NewEvmConfirmer(txStore, txmClient, txmCfg, feeCfg, txConfig, dbConfig, keyStore, txAttemptBuilder, lggr, stuckTxDetector, headTracker)
+	if auditLogger != nil {
+		evmConfirmer.SetSequenceGapHealedCallback(func(heal SequenceGapHeal) {
+			auditLogger.Audit(audit.EthNonceGapHealed, map[string]interface{}{
+				"fromAddress": heal.FromAddress,
+				"nonce":       heal.Sequence,
+				"txHash":      heal.TxHash,
+				"evmChainID":  chainID.String(),
+			})
+		})
+	}
 	evmFinalizer := NewEvmFinalizer(lggr, client.ConfiguredChainID(), chainConfig.RPCDefaultBatchSize(), txStore, client, headTracker)
 	var evmResender *Resender
 	if txConfig.ResendAfterThreshold() > 0 {
@@ -114,7 +126,7 @@ func NewEvmConfirmer(
 	client TxmClient,
 	chainConfig txmgrtypes.ConfirmerChainConfig,
 	feeConfig txmgrtypes.ConfirmerFeeConfig,
-	txConfig txmgrtypes.ConfirmerTransactionsConfig,
+	txConfig config.Transactions,
 	dbConfig txmgrtypes.ConfirmerDatabaseConfig,
 	keystore KeyStore,
 	txAttemptBuilder TxAttemptBuilder,
@@ -122,7 +134,7 @@ func NewEvmConfirmer(
 	stuckTxDetector StuckTxDetector,
 	headTracker latestAndFinalizedBlockHeadTracker,
 ) *Confirmer {
-	return txmgr.NewConfirmer(txStore, client, chainConfig, feeConfig, txConfig, dbConfig, keystore, txAttemptBuilder, lggr, func(r *evmtypes.Receipt) bool { return r == nil }, stuckTxDetector, headTracker)
+	return txmgr.NewConfirmer(txStore, client, chainConfig, feeConfig, txConfig, dbConfig, keystore, txAttemptBuilder, lggr, func(r *evmtypes.Receipt) bool { return r == nil }, stuckTxDetector, headTracker, NewEvmNonceGapHealingConfig(txConfig.NonceGapHealing()))
 }
 
 // NewEvmTracker instantiates a new EVM tracker for abandoned transactions
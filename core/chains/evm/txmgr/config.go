@@ -7,7 +7,9 @@ import (
 
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 )
 
 // ChainConfig encompasses config used by txmgr package
@@ -29,6 +31,7 @@ type FeeConfig interface {
 	PriceDefault() *assets.Wei
 	TipCapMin() *assets.Wei
 	PriceMax() *assets.Wei
+	BlobPriceMax() *assets.Wei
 	PriceMin() *assets.Wei
 	PriceMaxKey(gethcommon.Address) *assets.Wei
 }
@@ -75,3 +78,24 @@ func NewEvmTxmFeeConfig(c FeeConfig) *evmTxmFeeConfig {
 func (c evmTxmFeeConfig) MaxFeePrice() string { return c.PriceMax().String() }
 
 func (c evmTxmFeeConfig) FeePriceDefault() string { return c.PriceDefault().String() }
+
+var _ txmgrtypes.SequenceGapHealingConfig[gas.EvmFee] = (*evmNonceGapHealingConfig)(nil)
+
+type evmNonceGapHealingConfig struct {
+	config.NonceGapHealing
+}
+
+func NewEvmNonceGapHealingConfig(c config.NonceGapHealing) *evmNonceGapHealingConfig {
+	return &evmNonceGapHealingConfig{c}
+}
+
+// FeeWithinCap checks the gas price of legacy and the fee cap of dynamic fees against PriceMax
+func (c evmNonceGapHealingConfig) FeeWithinCap(fee gas.EvmFee) bool {
+	if fee.GasPrice != nil && fee.GasPrice.Cmp(c.PriceMax()) > 0 {
+		return false
+	}
+	if fee.GasFeeCap != nil && fee.GasFeeCap.Cmp(c.PriceMax()) > 0 {
+		return false
+	}
+	return true
+}
@@ -3000,6 +3000,118 @@ func TestEthConfirmer_ForceRebroadcast(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_HealSequenceGaps(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	gconfig, config := newTestChainScopedConfig(t)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+
+	isSelfSend := func(nonce uint64) func(tx *types.Transaction) bool {
+		return func(tx *types.Transaction) bool {
+			return tx.Nonce() == nonce &&
+				*tx.To() == fromAddress &&
+				tx.Value().Cmp(big.NewInt(0)) == 0 &&
+				len(tx.Data()) == 0 &&
+				tx.Gas() == config.EVM().GasEstimator().LimitDefault()
+		}
+	}
+
+	t.Run("returns an error if sending fails", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec := newEthConfirmer(t, txStore, ethClient, gconfig, config, ethKeyStore, nil)
+
+		ethClient.On("SequenceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(evmtypes.Nonce(1), nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(isSelfSend(2)), fromAddress).Return(commonclient.Fatal, errors.New("invalid sender")).Once()
+
+		heals, err := ec.HealSequenceGaps(tests.Context(t), fromAddress)
+		require.Error(t, err)
+		assert.Empty(t, heals)
+	})
+
+	t.Run("returns an error if the transaction is underpriced", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec := newEthConfirmer(t, txStore, ethClient, gconfig, config, ethKeyStore, nil)
+
+		ethClient.On("SequenceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(evmtypes.Nonce(1), nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(isSelfSend(2)), fromAddress).Return(commonclient.Underpriced, errors.New("transaction underpriced")).Once()
+
+		heals, err := ec.HealSequenceGaps(tests.Context(t), fromAddress)
+		require.ErrorIs(t, err, txmgrcommon.ErrGapHealUnderpriced)
+		assert.Empty(t, heals)
+	})
+
+	t.Run("refuses addresses which are not enabled", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec := newEthConfirmer(t, txStore, ethClient, gconfig, config, ethKeyStore, nil)
+
+		_, err := ec.HealSequenceGaps(tests.Context(t), testutils.NewAddress())
+		require.ErrorContains(t, err, "is not enabled")
+	})
+
+	t.Run("fills every gap above the mined nonce with a stored transaction to self", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec := newEthConfirmer(t, txStore, ethClient, gconfig, config, ethKeyStore, nil)
+
+		// Nonce 0 has been mined in the meantime
+		ethClient.On("SequenceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(evmtypes.Nonce(0), nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(isSelfSend(0)), fromAddress).Return(commonclient.TransactionAlreadyKnown, nil).Once()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(isSelfSend(2)), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		heals, err := ec.HealSequenceGaps(tests.Context(t), fromAddress)
+		require.NoError(t, err)
+		require.Len(t, heals, 1)
+		assert.Equal(t, fromAddress, heals[0].FromAddress)
+		assert.Equal(t, evmtypes.Nonce(2), heals[0].Sequence)
+
+		etx, err := txStore.FindTxWithSequence(tests.Context(t), fromAddress, evmtypes.Nonce(2))
+		require.NoError(t, err)
+		require.NotNil(t, etx)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		require.Len(t, etx.TxAttempts, 1)
+		assert.Equal(t, heals[0].TxHash, etx.TxAttempts[0].Hash)
+		assert.Equal(t, txmgrtypes.TxAttemptBroadcast, etx.TxAttempts[0].State)
+
+		// The stored transaction closes the gap
+		ethClient.On("SequenceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(evmtypes.Nonce(1), nil).Once()
+		heals, err = ec.HealSequenceGaps(tests.Context(t), fromAddress)
+		require.NoError(t, err)
+		assert.Empty(t, heals)
+	})
+
+	t.Run("automatic healing waits for the gap to persist for Threshold blocks", func(t *testing.T) {
+		gconfig := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].Transactions.NonceGapHealing.Enabled = ptr(true)
+			c.EVM[0].Transactions.NonceGapHealing.Threshold = ptr[uint32](2)
+		})
+		config := evmtest.NewChainScopedConfig(t, gconfig)
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ec := newEthConfirmer(t, txStore, ethClient, gconfig, config, ethKeyStore, nil)
+		var healed []txmgr.SequenceGapHeal
+		ec.SetSequenceGapHealedCallback(func(heal txmgr.SequenceGapHeal) {
+			healed = append(healed, heal)
+		})
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 5, fromAddress)
+
+		ethClient.On("SequenceAt", mock.Anything, fromAddress, (*big.Int)(nil)).Return(evmtypes.Nonce(1), nil)
+		require.NoError(t, ec.HealSequenceGapsWhereNecessary(tests.Context(t), 10))
+		require.NoError(t, ec.HealSequenceGapsWhereNecessary(tests.Context(t), 11))
+		assert.Empty(t, healed)
+
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(isSelfSend(4)), fromAddress).Return(commonclient.Successful, nil).Once()
+		require.NoError(t, ec.HealSequenceGapsWhereNecessary(tests.Context(t), 12))
+		require.Len(t, healed, 1)
+		assert.Equal(t, evmtypes.Nonce(4), healed[0].Sequence)
+	})
+}
+
 func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 	t.Parallel()
 
@@ -199,6 +199,9 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	Priority          txmgrtypes.TxPriority
+	Blobs             pq.ByteaArray
+	PreemptedByTxID   *int64
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -221,6 +224,9 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.Priority = tx.Priority
+	db.Blobs = tx.Blobs
+	db.PreemptedByTxID = tx.PreemptedByTxID
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -256,6 +262,9 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Priority = db.Priority
+	tx.Blobs = db.Blobs
+	tx.PreemptedByTxID = db.PreemptedByTxID
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -288,6 +297,7 @@ type DbEthTxAttempt struct {
 	TxType                  int
 	GasTipCap               *assets.Wei
 	GasFeeCap               *assets.Wei
+	BlobFeeCap              *assets.Wei
 	IsPurgeAttempt          bool
 }
 
@@ -303,6 +313,7 @@ func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
 	db.TxType = attempt.TxType
 	db.GasTipCap = attempt.TxFee.GasTipCap
 	db.GasFeeCap = attempt.TxFee.GasFeeCap
+	db.BlobFeeCap = attempt.TxFee.BlobFeeCap
 	db.IsPurgeAttempt = attempt.IsPurgeAttempt
 
 	// handle state naming difference between generic + EVM
@@ -333,6 +344,7 @@ func (db DbEthTxAttempt) ToTxAttempt(attempt *TxAttempt) {
 	attempt.TxFee = gas.EvmFee{
 		GasPrice:   db.GasPrice,
 		DynamicFee: gas.DynamicFee{GasTipCap: db.GasTipCap, GasFeeCap: db.GasFeeCap},
+		BlobFeeCap: db.BlobFeeCap,
 	}
 	attempt.IsPurgeAttempt = db.IsPurgeAttempt
 }
@@ -358,8 +370,8 @@ func NewTxStore(
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt)
+INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, blob_fee_cap, is_purge_attempt)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :blob_fee_cap, :is_purge_attempt)
 RETURNING *;
 `
 
@@ -530,8 +542,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, priority, blobs) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :priority, :blobs
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -558,6 +570,45 @@ func (o *evmTxStore) InsertTxAttempt(ctx context.Context, attempt *TxAttempt) er
 	return pkgerrors.Wrap(err, "InsertTxAttempt failed")
 }
 
+// InsertUnconfirmedTxWithAttempt inserts an unconfirmed transaction which has already been sent, together with its
+// broadcast attempt, so that it is confirmed, bumped and rebroadcast like any other transaction. Nothing is inserted if
+// another transaction of the same address already uses its nonce.
+func (o *evmTxStore) InsertUnconfirmedTxWithAttempt(ctx context.Context, etx *Tx, attempt *TxAttempt) (inserted bool, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if etx.State != txmgr.TxUnconfirmed {
+		return false, pkgerrors.Errorf("can only insert unconfirmed transactions, transaction is %s", etx.State)
+	}
+	if etx.Sequence == nil {
+		return false, errors.New("unconfirmed transaction must have a nonce")
+	}
+	if attempt.State != txmgrtypes.TxAttemptBroadcast {
+		return false, pkgerrors.Errorf("attempt must be in broadcast state, got: %s", attempt.State)
+	}
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		var exists bool
+		if err = orm.q.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM evm.txes WHERE evm_chain_id = $1 AND from_address = $2 AND nonce = $3)`,
+			etx.ChainID.String(), etx.FromAddress, etx.Sequence.Int64()); err != nil {
+			return pkgerrors.Wrap(err, "InsertUnconfirmedTxWithAttempt failed to load evm.txes")
+		}
+		if exists {
+			return nil
+		}
+		if err = orm.InsertTx(ctx, etx); err != nil {
+			return err
+		}
+		attempt.TxID = etx.ID
+		if err = orm.InsertTxAttempt(ctx, attempt); err != nil {
+			return err
+		}
+		etx.TxAttempts = []TxAttempt{*attempt}
+		inserted = true
+		return nil
+	})
+	return inserted, err
+}
+
 // InsertReceipt only used in tests. Use SaveFetchedReceipts instead
 func (o *evmTxStore) InsertReceipt(ctx context.Context, receipt *evmtypes.Receipt) (int64, error) {
 	// convert to database representation
@@ -770,7 +821,7 @@ func (o *evmTxStore) FindTxAttemptsRequiringResend(ctx context.Context, olderTha
 	err = o.q.SelectContext(ctx, &dbAttempts, `
 SELECT DISTINCT ON (evm.txes.nonce) evm.tx_attempts.*
 FROM evm.tx_attempts
-JOIN evm.txes ON evm.txes.id = evm.tx_attempts.eth_tx_id AND evm.txes.state IN ('unconfirmed', 'confirmed_missing_receipt')
+JOIN evm.txes ON evm.txes.id = evm.tx_attempts.eth_tx_id AND evm.txes.state IN ('unconfirmed', 'confirmed_missing_receipt') AND evm.txes.preempted_by_tx_id IS NULL
 WHERE evm.tx_attempts.state <> 'in_progress' AND evm.txes.broadcast_at <= $1 AND evm_chain_id = $2 AND from_address = $3
 ORDER BY evm.txes.nonce ASC, evm.tx_attempts.gas_price DESC, evm.tx_attempts.gas_tip_cap DESC
 LIMIT $4
@@ -878,7 +929,7 @@ func (o *evmTxStore) FindTxsByStateAndFromAddresses(ctx context.Context, address
 	}
 	err = o.Transact(ctx, true, func(orm *evmTxStore) error {
 		var dbEtxs []DbEthTx
-		err = orm.q.SelectContext(ctx, &dbEtxs, `SELECT * FROM evm.txes WHERE state = $1 AND from_address = ANY($2) AND evm_chain_id = $3`, state, enabledAddrsBytea, chainID.String())
+		err = orm.q.SelectContext(ctx, &dbEtxs, `SELECT * FROM evm.txes WHERE state = $1 AND from_address = ANY($2) AND evm_chain_id = $3 AND preempted_by_tx_id IS NULL`, state, enabledAddrsBytea, chainID.String())
 		if err != nil {
 			return fmt.Errorf("FindTxsByStateAndFromAddresses failed to load evm.txes: %w", err)
 		}
@@ -1017,6 +1068,7 @@ FROM (
 ) AS max_table
 WHERE state = 'unconfirmed'
 	AND evm_chain_id = $1
+	AND preempted_by_tx_id IS NULL
 	AND nonce < max_table.max_nonce
 	AND evm.txes.from_address = max_table.from_address
 	`, chainID.String())
@@ -1137,7 +1189,7 @@ func (o *evmTxStore) FindTxWithSequence(ctx context.Context, fromAddress common.
 	err = o.Transact(ctx, true, func(orm *evmTxStore) error {
 		var dbEtx DbEthTx
 		err = orm.q.GetContext(ctx, &dbEtx, `
-SELECT * FROM evm.txes WHERE from_address = $1 AND nonce = $2 AND state IN ('confirmed', 'confirmed_missing_receipt', 'unconfirmed')
+SELECT * FROM evm.txes WHERE from_address = $1 AND nonce = $2 AND state IN ('confirmed', 'confirmed_missing_receipt', 'unconfirmed') AND preempted_by_tx_id IS NULL
 `, fromAddress, nonce.Int64())
 		if err != nil {
 			return pkgerrors.Wrap(err, "FindEthTxWithNonce failed to load evm.txes")
@@ -1430,8 +1482,8 @@ func (o *evmTxStore) FindTxsRequiringGasBump(ctx context.Context, address common
 		stmt := `
 SELECT evm.txes.* FROM evm.txes
 LEFT JOIN evm.tx_attempts ON evm.txes.id = evm.tx_attempts.eth_tx_id AND (broadcast_before_block_num > $4 OR broadcast_before_block_num IS NULL OR evm.tx_attempts.state != 'broadcast')
-WHERE evm.txes.state = 'unconfirmed' AND evm.tx_attempts.id IS NULL AND evm.txes.from_address = $1 AND evm.txes.evm_chain_id = $2
-	AND (($3 = 0) OR (evm.txes.id IN (SELECT id FROM evm.txes WHERE state = 'unconfirmed' AND from_address = $1 ORDER BY nonce ASC LIMIT $3)))
+WHERE evm.txes.state = 'unconfirmed' AND evm.tx_attempts.id IS NULL AND evm.txes.from_address = $1 AND evm.txes.evm_chain_id = $2 AND evm.txes.preempted_by_tx_id IS NULL
+	AND (($3 = 0) OR (evm.txes.id IN (SELECT id FROM evm.txes WHERE state = 'unconfirmed' AND from_address = $1 AND preempted_by_tx_id IS NULL ORDER BY nonce ASC LIMIT $3)))
 ORDER BY nonce ASC
 `
 		var dbEtxs []DbEthTx
@@ -1458,7 +1510,7 @@ func (o *evmTxStore) FindTxsRequiringResubmissionDueToInsufficientFunds(ctx cont
 		err = orm.q.SelectContext(ctx, &dbEtxs, `
 SELECT DISTINCT evm.txes.* FROM evm.txes
 INNER JOIN evm.tx_attempts ON evm.txes.id = evm.tx_attempts.eth_tx_id AND evm.tx_attempts.state = 'insufficient_eth'
-WHERE evm.txes.from_address = $1 AND evm.txes.state = 'unconfirmed' AND evm.txes.evm_chain_id = $2
+WHERE evm.txes.from_address = $1 AND evm.txes.state = 'unconfirmed' AND evm.txes.evm_chain_id = $2 AND evm.txes.preempted_by_tx_id IS NULL
 ORDER BY nonce ASC
 `, address, chainID.String())
 		if err != nil {
@@ -1599,7 +1651,7 @@ func (o *evmTxStore) FindNextUnstartedTransactionFromAddress(ctx context.Context
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	var dbEtx DbEthTx
-	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := o.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY priority DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
 	etx := new(Tx)
 	dbEtx.ToTx(etx)
 	if err != nil {
@@ -1740,6 +1792,121 @@ func (o *evmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *Tx,
 	})
 }
 
+// FindSequenceGaps returns the nonces from minNonce up to the highest pending nonce of fromAddress which are not used
+// by any pending or confirmed transaction, e.g. because the transaction using it was purged or marked as fatally errored.
+func (o *evmTxStore) FindSequenceGaps(ctx context.Context, fromAddress common.Address, chainID *big.Int, minNonce evmtypes.Nonce) (gaps []evmtypes.Nonce, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.q.SelectContext(ctx, &gaps, `
+SELECT n FROM generate_series($3::bigint, (
+	SELECT MAX(nonce) FROM evm.txes WHERE from_address = $1 AND evm_chain_id = $2 AND state IN ('in_progress', 'unconfirmed', 'confirmed_missing_receipt')
+)) AS n
+WHERE NOT EXISTS (
+	SELECT 1 FROM evm.txes WHERE from_address = $1 AND evm_chain_id = $2 AND nonce = n
+	AND state IN ('in_progress', 'unconfirmed', 'confirmed_missing_receipt', 'confirmed', 'finalized')
+)
+ORDER BY n ASC`, fromAddress, chainID.String(), minNonce.Int64())
+	return gaps, pkgerrors.Wrap(err, "FindSequenceGaps failed")
+}
+
+// FindTxToPreempt returns the unconfirmed transaction with the lowest nonce which has a lower priority than the one
+// given and was first broadcast before broadcastBefore, with its attempts loaded. Transactions which are preempted, or
+// which took over the nonce of a preempted transaction, are not preempted again.
+func (o *evmTxStore) FindTxToPreempt(ctx context.Context, fromAddress common.Address, chainID *big.Int, priority txmgrtypes.TxPriority, broadcastBefore time.Time) (etx *Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.Transact(ctx, true, func(orm *evmTxStore) error {
+		var dbEtx DbEthTx
+		err = orm.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE from_address = $1 AND evm_chain_id = $2 AND state = 'unconfirmed' AND priority < $3 AND initial_broadcast_at < $4
+	AND preempted_by_tx_id IS NULL AND NOT EXISTS (SELECT 1 FROM evm.txes p WHERE p.preempted_by_tx_id = evm.txes.id)
+ORDER BY nonce ASC LIMIT 1`,
+			fromAddress, chainID.String(), priority, broadcastBefore)
+		if err != nil {
+			return pkgerrors.Wrap(err, "FindTxToPreempt failed to load evm.txes")
+		}
+		etx = new(Tx)
+		dbEtx.ToTx(etx)
+		return pkgerrors.Wrap(orm.loadTxAttemptsAtomic(ctx, etx), "FindTxToPreempt failed to load evm.tx_attempts")
+	})
+	return
+}
+
+// PreemptTx marks the preempted unconfirmed transaction as preempted by etx, and moves etx to in_progress with the
+// nonce of the preempted transaction. The attempts of the preempted transaction are kept, since any of them may still
+// be mined, but they are no longer bumped or resent. ResolvePreemptedTxs requeues whichever of the two loses the nonce.
+func (o *evmTxStore) PreemptTx(ctx context.Context, preempted *Tx, etx *Tx, attempt *TxAttempt) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if preempted.State != txmgr.TxUnconfirmed {
+		return pkgerrors.Errorf("can only preempt unconfirmed transactions, transaction is currently %s", preempted.State)
+	}
+	if etx.Sequence == nil || preempted.Sequence == nil || *etx.Sequence != *preempted.Sequence {
+		return errors.New("preempting transaction must take over the nonce of the preempted transaction")
+	}
+	err := o.Transact(ctx, false, func(orm *evmTxStore) error {
+		res, err := orm.q.ExecContext(ctx, `UPDATE evm.txes SET preempted_by_tx_id = $2 WHERE id = $1 AND state = 'unconfirmed' AND preempted_by_tx_id IS NULL`, preempted.ID, etx.ID)
+		if err != nil {
+			return pkgerrors.Wrap(err, "PreemptTx failed to update evm.txes")
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return pkgerrors.Wrap(err, "PreemptTx failed to get RowsAffected")
+		}
+		if rows == 0 {
+			return pkgerrors.Errorf("PreemptTx failed: transaction %d is no longer unconfirmed", preempted.ID)
+		}
+		return orm.UpdateTxUnstartedToInProgress(ctx, etx, attempt)
+	})
+	if err != nil {
+		return err
+	}
+	preempted.PreemptedByTxID = &etx.ID
+	return nil
+}
+
+// ResolvePreemptedTxs settles preempted transactions once it is known which transaction holds their nonce:
+//   - if the transaction that took over the nonce is confirmed, the preempted transaction can no longer be mined, so
+//     it is moved back to unstarted to be sent again with a new nonce;
+//   - if the preempted transaction was mined after all, the transaction that took over its nonce is moved back to
+//     unstarted instead;
+//   - if the transaction that took over the nonce failed before it was sent, the preempted transaction is no longer
+//     preempted, and is bumped as usual.
+//
+// The attempts of a transaction moved back to unstarted are deleted, since they can no longer be mined.
+func (o *evmTxStore) ResolvePreemptedTxs(ctx context.Context, chainID *big.Int) (requeued []int64, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		var lost []int64
+		if err = orm.q.SelectContext(ctx, &lost, `
+SELECT p.id FROM evm.txes p JOIN evm.txes r ON r.id = p.preempted_by_tx_id
+WHERE p.evm_chain_id = $1 AND p.state = 'unconfirmed' AND r.state IN ('confirmed', 'finalized')
+UNION ALL
+SELECT r.id FROM evm.txes p JOIN evm.txes r ON r.id = p.preempted_by_tx_id
+WHERE p.evm_chain_id = $1 AND p.state IN ('confirmed', 'finalized') AND r.state IN ('unconfirmed', 'confirmed_missing_receipt')`, chainID.String()); err != nil {
+			return pkgerrors.Wrap(err, "ResolvePreemptedTxs failed to load evm.txes")
+		}
+		if len(lost) > 0 {
+			if _, err = orm.q.ExecContext(ctx, `DELETE FROM evm.tx_attempts WHERE eth_tx_id = ANY($1)`, pq.Array(lost)); err != nil {
+				return pkgerrors.Wrap(err, "ResolvePreemptedTxs failed to delete evm.tx_attempts")
+			}
+			if _, err = orm.q.ExecContext(ctx, `UPDATE evm.txes SET state = 'unstarted', nonce = NULL, error = NULL, broadcast_at = NULL, initial_broadcast_at = NULL, preempted_by_tx_id = NULL
+WHERE id = ANY($1)`, pq.Array(lost)); err != nil {
+				return pkgerrors.Wrap(err, "ResolvePreemptedTxs failed to requeue evm.txes")
+			}
+		}
+		_, err = orm.q.ExecContext(ctx, `UPDATE evm.txes p SET preempted_by_tx_id = NULL FROM evm.txes r
+WHERE r.id = p.preempted_by_tx_id AND p.evm_chain_id = $1 AND r.state IN ('unstarted', 'fatal_error')`, chainID.String())
+		requeued = lost
+		return pkgerrors.Wrap(err, "ResolvePreemptedTxs failed to update evm.txes")
+	})
+	return
+}
+
 // GetTxInProgress returns either 0 or 1 transaction that was left in
 // an unfinished state because something went screwy the last time. Most likely
 // the node crashed in the middle of the ProcessUnstartedEthTxs loop.
@@ -1787,7 +1954,7 @@ func (o *evmTxStore) countTransactionsWithState(ctx context.Context, fromAddress
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
-	err = o.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.txes WHERE from_address = $1 AND state = $2 AND evm_chain_id = $3`,
+	err = o.q.GetContext(ctx, &count, `SELECT count(*) FROM evm.txes WHERE from_address = $1 AND state = $2 AND evm_chain_id = $3 AND preempted_by_tx_id IS NULL`,
 		fromAddress, state, chainID.String())
 	return count, pkgerrors.Wrap(err, "failed to countTransactionsWithState")
 }
@@ -1853,12 +2020,12 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, priority, blobs)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14,$15
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, txRequest.Priority, pq.ByteaArray(txRequest.Blobs))
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -1991,7 +2158,7 @@ func (o *evmTxStore) Abandon(ctx context.Context, chainID *big.Int, addr common.
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
-	_, err := o.q.ExecContext(ctx, `UPDATE evm.txes SET state='fatal_error', nonce = NULL, error = 'abandoned' WHERE state IN ('unconfirmed', 'in_progress', 'unstarted') AND evm_chain_id = $1 AND from_address = $2`, chainID.String(), addr)
+	_, err := o.q.ExecContext(ctx, `UPDATE evm.txes SET state='fatal_error', nonce = NULL, error = 'abandoned', preempted_by_tx_id = NULL WHERE state IN ('unconfirmed', 'in_progress', 'unstarted') AND evm_chain_id = $1 AND from_address = $2`, chainID.String(), addr)
 	return err
 }
 
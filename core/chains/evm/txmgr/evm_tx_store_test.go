@@ -760,6 +760,45 @@ func TestORM_FindTxWithSequence(t *testing.T) {
 	})
 }
 
+func TestORM_FindSequenceGaps(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	chainID := testutils.FixtureChainID
+
+	t.Run("returns nothing if there are no pending transactions", func(t *testing.T) {
+		gaps, err := txStore.FindSequenceGaps(tests.Context(t), fromAddress, chainID, evmtypes.Nonce(0))
+		require.NoError(t, err)
+		assert.Empty(t, gaps)
+	})
+
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 1, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 6, fromAddress)
+
+	t.Run("returns the sequences not used by any transaction up to the highest pending sequence", func(t *testing.T) {
+		gaps, err := txStore.FindSequenceGaps(tests.Context(t), fromAddress, chainID, evmtypes.Nonce(0))
+		require.NoError(t, err)
+		assert.Equal(t, []evmtypes.Nonce{0, 2, 4, 5}, gaps)
+	})
+
+	t.Run("ignores sequences below the minimum", func(t *testing.T) {
+		gaps, err := txStore.FindSequenceGaps(tests.Context(t), fromAddress, chainID, evmtypes.Nonce(3))
+		require.NoError(t, err)
+		assert.Equal(t, []evmtypes.Nonce{4, 5}, gaps)
+	})
+
+	t.Run("is scoped to the address", func(t *testing.T) {
+		_, otherAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		gaps, err := txStore.FindSequenceGaps(tests.Context(t), otherAddress, chainID, evmtypes.Nonce(0))
+		require.NoError(t, err)
+		assert.Empty(t, gaps)
+	})
+}
+
 func TestORM_UpdateTxForRebroadcast(t *testing.T) {
 	t.Parallel()
 
@@ -1269,6 +1308,114 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, resultEtx)
 	})
+
+	t.Run("finds unstarted tx with the highest priority first", func(t *testing.T) {
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, func(r *txmgr.TxRequest) {
+			r.Priority = txmgrtypes.TxPriorityLow
+		})
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, func(r *txmgr.TxRequest) {
+			r.Priority = txmgrtypes.TxPriorityHigh
+		})
+		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(tests.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Equal(t, etx.ID, resultEtx.ID)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, resultEtx.Priority)
+	})
+}
+
+func TestORM_PreemptTx(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	chainID := testutils.FixtureChainID
+	pendingSince := time.Now().Add(-time.Hour)
+
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 0, fromAddress, pendingSince, txmgrtypes.TxPriorityHigh)
+	lowPriorityTx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 1, fromAddress, pendingSince, txmgrtypes.TxPriorityLow)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress, txmgrtypes.TxPriorityLow)
+
+	t.Run("finds the lowest nonce with a lower priority", func(t *testing.T) {
+		etx, err := txStore.FindTxToPreempt(ctx, fromAddress, chainID, txmgrtypes.TxPriorityHigh, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, lowPriorityTx.ID, etx.ID)
+		assert.Len(t, etx.TxAttempts, 1)
+
+		_, err = txStore.FindTxToPreempt(ctx, fromAddress, chainID, txmgrtypes.TxPriorityLow, time.Now().Add(-time.Minute))
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		_, err = txStore.FindTxToPreempt(ctx, fromAddress, chainID, txmgrtypes.TxPriorityHigh, pendingSince.Add(-time.Minute))
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	var replacement txmgr.Tx
+	t.Run("takes over the nonce of the preempted tx", func(t *testing.T) {
+		preempted, err := txStore.FindTxToPreempt(ctx, fromAddress, chainID, txmgrtypes.TxPriorityHigh, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		replacement = mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, chainID, func(r *txmgr.TxRequest) {
+			r.Priority = txmgrtypes.TxPriorityHigh
+		})
+		replacement.Sequence = preempted.Sequence
+		attempt := cltest.NewLegacyEthTxAttempt(t, replacement.ID)
+
+		require.NoError(t, txStore.PreemptTx(ctx, preempted, &replacement, &attempt))
+		require.NotNil(t, preempted.PreemptedByTxID)
+		assert.Equal(t, replacement.ID, *preempted.PreemptedByTxID)
+
+		etx, err := txStore.FindTxWithAttempts(ctx, replacement.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxInProgress, etx.State)
+		assert.Equal(t, evmtypes.Nonce(1), *etx.Sequence)
+		assert.Len(t, etx.TxAttempts, 1)
+
+		// The preempted tx keeps its nonce and attempts until it is known which of the two is mined
+		lowPriorityTx, err = txStore.FindTxWithAttempts(ctx, lowPriorityTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, lowPriorityTx.State)
+		assert.Equal(t, evmtypes.Nonce(1), *lowPriorityTx.Sequence)
+		assert.Len(t, lowPriorityTx.TxAttempts, 1)
+
+		next, err := txStore.FindTxToPreempt(ctx, fromAddress, chainID, txmgrtypes.TxPriorityAdmin, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.NotEqual(t, lowPriorityTx.ID, next.ID)
+		requeued, err := txStore.ResolvePreemptedTxs(ctx, chainID)
+		require.NoError(t, err)
+		assert.Empty(t, requeued)
+	})
+
+	t.Run("requeues the preempted tx once the replacement is confirmed", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, `UPDATE evm.txes SET state = 'confirmed' WHERE id = $1`, replacement.ID)
+		require.NoError(t, err)
+
+		requeued, err := txStore.ResolvePreemptedTxs(ctx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{lowPriorityTx.ID}, requeued)
+
+		lowPriorityTx, err = txStore.FindTxWithAttempts(ctx, lowPriorityTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, lowPriorityTx.State)
+		assert.Nil(t, lowPriorityTx.Sequence)
+		assert.Nil(t, lowPriorityTx.InitialBroadcastAt)
+		assert.Nil(t, lowPriorityTx.PreemptedByTxID)
+		assert.Empty(t, lowPriorityTx.TxAttempts)
+	})
+
+	t.Run("fails if the preempted tx is no longer unconfirmed", func(t *testing.T) {
+		preempted := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 3, fromAddress, pendingSince, txmgrtypes.TxPriorityLow)
+		_, err := db.ExecContext(ctx, `UPDATE evm.txes SET state = 'confirmed' WHERE id = $1`, preempted.ID)
+		require.NoError(t, err)
+
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, chainID)
+		etx.Sequence = preempted.Sequence
+		attempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+
+		require.ErrorContains(t, txStore.PreemptTx(ctx, &preempted, &etx, &attempt), "no longer unconfirmed")
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+	})
 }
 
 func TestORM_UpdateTxFatalError(t *testing.T) {
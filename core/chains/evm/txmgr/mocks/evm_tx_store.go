@@ -738,6 +738,67 @@ func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(
 	return _c
 }
 
+// FindSequenceGaps provides a mock function with given fields: ctx, fromAddress, chainID, minSequence
+func (_m *EvmTxStore) FindSequenceGaps(ctx context.Context, fromAddress common.Address, chainID *big.Int, minSequence evmtypes.Nonce) ([]evmtypes.Nonce, error) {
+	ret := _m.Called(ctx, fromAddress, chainID, minSequence)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSequenceGaps")
+	}
+
+	var r0 []evmtypes.Nonce
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) ([]evmtypes.Nonce, error)); ok {
+		return rf(ctx, fromAddress, chainID, minSequence)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) []evmtypes.Nonce); ok {
+		r0 = rf(ctx, fromAddress, chainID, minSequence)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]evmtypes.Nonce)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, evmtypes.Nonce) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, minSequence)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindSequenceGaps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSequenceGaps'
+type EvmTxStore_FindSequenceGaps_Call struct {
+	*mock.Call
+}
+
+// FindSequenceGaps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+//   - minSequence evmtypes.Nonce
+func (_e *EvmTxStore_Expecter) FindSequenceGaps(ctx interface{}, fromAddress interface{}, chainID interface{}, minSequence interface{}) *EvmTxStore_FindSequenceGaps_Call {
+	return &EvmTxStore_FindSequenceGaps_Call{Call: _e.mock.On("FindSequenceGaps", ctx, fromAddress, chainID, minSequence)}
+}
+
+func (_c *EvmTxStore_FindSequenceGaps_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, minSequence evmtypes.Nonce)) *EvmTxStore_FindSequenceGaps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].(evmtypes.Nonce))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindSequenceGaps_Call) Return(gaps []evmtypes.Nonce, err error) *EvmTxStore_FindSequenceGaps_Call {
+	_c.Call.Return(gaps, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindSequenceGaps_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, evmtypes.Nonce) ([]evmtypes.Nonce, error)) *EvmTxStore_FindSequenceGaps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTransactionsConfirmedInBlockRange provides a mock function with given fields: ctx, highBlockNumber, lowBlockNumber, chainID
 func (_m *EvmTxStore) FindTransactionsConfirmedInBlockRange(ctx context.Context, highBlockNumber int64, lowBlockNumber int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, highBlockNumber, lowBlockNumber, chainID)
@@ -1156,6 +1217,68 @@ func (_c *EvmTxStore_FindTxByHash_Call) RunAndReturn(run func(context.Context, c
 	return _c
 }
 
+// FindTxToPreempt provides a mock function with given fields: ctx, fromAddress, chainID, priority, broadcastBefore
+func (_m *EvmTxStore) FindTxToPreempt(ctx context.Context, fromAddress common.Address, chainID *big.Int, priority types.TxPriority, broadcastBefore time.Time) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, fromAddress, chainID, priority, broadcastBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxToPreempt")
+	}
+
+	var r0 *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, types.TxPriority, time.Time) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, fromAddress, chainID, priority, broadcastBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, *big.Int, types.TxPriority, time.Time) *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, fromAddress, chainID, priority, broadcastBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, *big.Int, types.TxPriority, time.Time) error); ok {
+		r1 = rf(ctx, fromAddress, chainID, priority, broadcastBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindTxToPreempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxToPreempt'
+type EvmTxStore_FindTxToPreempt_Call struct {
+	*mock.Call
+}
+
+// FindTxToPreempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - chainID *big.Int
+//   - priority types.TxPriority
+//   - broadcastBefore time.Time
+func (_e *EvmTxStore_Expecter) FindTxToPreempt(ctx interface{}, fromAddress interface{}, chainID interface{}, priority interface{}, broadcastBefore interface{}) *EvmTxStore_FindTxToPreempt_Call {
+	return &EvmTxStore_FindTxToPreempt_Call{Call: _e.mock.On("FindTxToPreempt", ctx, fromAddress, chainID, priority, broadcastBefore)}
+}
+
+func (_c *EvmTxStore_FindTxToPreempt_Call) Run(run func(ctx context.Context, fromAddress common.Address, chainID *big.Int, priority types.TxPriority, broadcastBefore time.Time)) *EvmTxStore_FindTxToPreempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(*big.Int), args[3].(types.TxPriority), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxToPreempt_Call) Return(etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindTxToPreempt_Call {
+	_c.Call.Return(etx, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxToPreempt_Call) RunAndReturn(run func(context.Context, common.Address, *big.Int, types.TxPriority, time.Time) (*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindTxToPreempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTxWithAttempts provides a mock function with given fields: ctx, etxID
 func (_m *EvmTxStore) FindTxWithAttempts(ctx context.Context, etxID int64) (types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, etxID)
@@ -2121,6 +2244,64 @@ func (_c *EvmTxStore_HasInProgressTransaction_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// InsertUnconfirmedTxWithAttempt provides a mock function with given fields: ctx, etx, attempt
+func (_m *EvmTxStore) InsertUnconfirmedTxWithAttempt(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) (bool, error) {
+	ret := _m.Called(ctx, etx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertUnconfirmedTxWithAttempt")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) (bool, error)); ok {
+		return rf(ctx, etx, attempt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) bool); ok {
+		r0 = rf(ctx, etx, attempt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r1 = rf(ctx, etx, attempt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_InsertUnconfirmedTxWithAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertUnconfirmedTxWithAttempt'
+type EvmTxStore_InsertUnconfirmedTxWithAttempt_Call struct {
+	*mock.Call
+}
+
+// InsertUnconfirmedTxWithAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etx *types.Tx[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+//   - attempt *types.TxAttempt[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+func (_e *EvmTxStore_Expecter) InsertUnconfirmedTxWithAttempt(ctx interface{}, etx interface{}, attempt interface{}) *EvmTxStore_InsertUnconfirmedTxWithAttempt_Call {
+	return &EvmTxStore_InsertUnconfirmedTxWithAttempt_Call{Call: _e.mock.On("InsertUnconfirmedTxWithAttempt", ctx, etx, attempt)}
+}
+
+func (_c *EvmTxStore_InsertUnconfirmedTxWithAttempt_Call) Run(run func(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])) *EvmTxStore_InsertUnconfirmedTxWithAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]), args[2].(*types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_InsertUnconfirmedTxWithAttempt_Call) Return(inserted bool, err error) *EvmTxStore_InsertUnconfirmedTxWithAttempt_Call {
+	_c.Call.Return(inserted, err)
+	return _c
+}
+
+func (_c *EvmTxStore_InsertUnconfirmedTxWithAttempt_Call) RunAndReturn(run func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) (bool, error)) *EvmTxStore_InsertUnconfirmedTxWithAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadTxAttempts provides a mock function with given fields: ctx, etx
 func (_m *EvmTxStore) LoadTxAttempts(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, etx)
@@ -2264,6 +2445,55 @@ func (_c *EvmTxStore_MarkOldTxesMissingReceiptAsErrored_Call) RunAndReturn(run f
 	return _c
 }
 
+// PreemptTx provides a mock function with given fields: ctx, preempted, etx, attempt
+func (_m *EvmTxStore) PreemptTx(ctx context.Context, preempted *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
+	ret := _m.Called(ctx, preempted, etx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreemptTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r0 = rf(ctx, preempted, etx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_PreemptTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreemptTx'
+type EvmTxStore_PreemptTx_Call struct {
+	*mock.Call
+}
+
+// PreemptTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - preempted *types.Tx[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+//   - etx *types.Tx[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+//   - attempt *types.TxAttempt[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+func (_e *EvmTxStore_Expecter) PreemptTx(ctx interface{}, preempted interface{}, etx interface{}, attempt interface{}) *EvmTxStore_PreemptTx_Call {
+	return &EvmTxStore_PreemptTx_Call{Call: _e.mock.On("PreemptTx", ctx, preempted, etx, attempt)}
+}
+
+func (_c *EvmTxStore_PreemptTx_Call) Run(run func(ctx context.Context, preempted *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])) *EvmTxStore_PreemptTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]), args[2].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]), args[3].(*types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_PreemptTx_Call) Return(_a0 error) *EvmTxStore_PreemptTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_PreemptTx_Call) RunAndReturn(run func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error) *EvmTxStore_PreemptTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PreloadTxes provides a mock function with given fields: ctx, attempts
 func (_m *EvmTxStore) PreloadTxes(ctx context.Context, attempts []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, attempts)
@@ -2419,6 +2649,65 @@ func (_c *EvmTxStore_ReapTxHistory_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ResolvePreemptedTxs provides a mock function with given fields: ctx, chainID
+func (_m *EvmTxStore) ResolvePreemptedTxs(ctx context.Context, chainID *big.Int) ([]int64, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolvePreemptedTxs")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]int64, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []int64); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_ResolvePreemptedTxs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolvePreemptedTxs'
+type EvmTxStore_ResolvePreemptedTxs_Call struct {
+	*mock.Call
+}
+
+// ResolvePreemptedTxs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) ResolvePreemptedTxs(ctx interface{}, chainID interface{}) *EvmTxStore_ResolvePreemptedTxs_Call {
+	return &EvmTxStore_ResolvePreemptedTxs_Call{Call: _e.mock.On("ResolvePreemptedTxs", ctx, chainID)}
+}
+
+func (_c *EvmTxStore_ResolvePreemptedTxs_Call) Run(run func(ctx context.Context, chainID *big.Int)) *EvmTxStore_ResolvePreemptedTxs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_ResolvePreemptedTxs_Call) Return(requeued []int64, err error) *EvmTxStore_ResolvePreemptedTxs_Call {
+	_c.Call.Return(requeued, err)
+	return _c
+}
+
+func (_c *EvmTxStore_ResolvePreemptedTxs_Call) RunAndReturn(run func(context.Context, *big.Int) ([]int64, error)) *EvmTxStore_ResolvePreemptedTxs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveConfirmedMissingReceiptAttempt provides a mock function with given fields: ctx, timeout, attempt, broadcastAt
 func (_m *EvmTxStore) SaveConfirmedMissingReceiptAttempt(ctx context.Context, timeout time.Duration, attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], broadcastAt time.Time) error {
 	ret := _m.Called(ctx, timeout, attempt, broadcastAt)
@@ -180,6 +180,6 @@ func (s *nonceTracker) GenerateNextSequence(address common.Address, nonceUsed ev
 	}
 
 	// If currentNonce is ahead of even the incremented nonceUsed, maintain the unchanged currentNonce in the map
-	// This scenario should never occur but logging this discrepancy for visibility
-	s.lggr.Warnf("Local nonce map value %d for address %s is ahead of the nonce transmitted %d. Maintaining the existing value in the map without incrementing.", currentNonce, address.String(), nonceUsed)
+	// This happens when a transaction takes over the nonce of a preempted lower priority transaction, otherwise it should never occur
+	s.lggr.Debugf("Local nonce map value %d for address %s is ahead of the nonce transmitted %d. Maintaining the existing value in the map without incrementing.", currentNonce, address.String(), nonceUsed)
 }
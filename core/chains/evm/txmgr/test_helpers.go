@@ -56,6 +56,9 @@ type TestEvmConfig struct {
 	MinAttempts          uint32
 	DetectionApiUrl      *url.URL
 	RpcDefaultBatchSize  uint32
+
+	PreemptionEnabled        bool
+	PreemptionMinPendingTime time.Duration
 }
 
 func (e *TestEvmConfig) Transactions() evmconfig.Transactions {
@@ -100,6 +103,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Composite() evmconfig.CompositeEstimator {
+	return &TestCompositeEstimatorConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 42 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 42 }
@@ -109,6 +116,7 @@ func (g *TestGasEstimatorConfig) FeeCapDefault() *assets.Wei { return assets.New
 func (g *TestGasEstimatorConfig) PriceDefault() *assets.Wei  { return assets.NewWeiI(42) }
 func (g *TestGasEstimatorConfig) TipCapDefault() *assets.Wei { return assets.NewWeiI(42) }
 func (g *TestGasEstimatorConfig) TipCapMin() *assets.Wei     { return assets.NewWeiI(42) }
+func (g *TestGasEstimatorConfig) BlobPriceMax() *assets.Wei  { return assets.NewWeiI(42) }
 func (g *TestGasEstimatorConfig) LimitMax() uint64           { return 0 }
 func (g *TestGasEstimatorConfig) LimitMultiplier() float32   { return 0 }
 func (g *TestGasEstimatorConfig) BumpTxDepth() uint32        { return 42 }
@@ -154,6 +162,19 @@ type TestFeeHistoryConfig struct {
 
 func (b *TestFeeHistoryConfig) CacheTimeout() time.Duration { return 0 * time.Second }
 
+type TestCompositeEstimatorConfig struct {
+	evmconfig.CompositeEstimator
+}
+
+func (c *TestCompositeEstimatorConfig) Estimators() []string {
+	return []string{"FixedPrice", "SuggestedPrice"}
+}
+func (c *TestCompositeEstimatorConfig) Aggregation() toml.CompositeAggregation {
+	return toml.CompositeAggregationMedian
+}
+func (c *TestCompositeEstimatorConfig) Weights() []uint32      { return nil }
+func (c *TestCompositeEstimatorConfig) OutlierFactor() float64 { return 3 }
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -167,6 +188,12 @@ func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (t *transactionsConfig) Preemption() evmconfig.PreemptionConfig {
+	return &preemptionConfig{enabled: t.e.PreemptionEnabled, minPendingTime: t.e.PreemptionMinPendingTime}
+}
+func (t *transactionsConfig) NonceGapHealing() evmconfig.NonceGapHealing {
+	return &nonceGapHealingConfig{}
+}
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -174,6 +201,20 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type preemptionConfig struct {
+	enabled        bool
+	minPendingTime time.Duration
+}
+
+func (p *preemptionConfig) Enabled() bool                 { return p.enabled }
+func (p *preemptionConfig) MinPendingTime() time.Duration { return p.minPendingTime }
+
+type nonceGapHealingConfig struct {
+	evmconfig.NonceGapHealing
+}
+
+func (n *nonceGapHealingConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig          *TestEvmConfig
 	finalityDepth      uint32
@@ -64,6 +64,8 @@ type Receipt struct {
 	BlockNumber       *big.Int        `json:"blockNumber,omitempty"`
 	TransactionIndex  uint            `json:"transactionIndex"`
 	RevertReason      []byte          `json:"revertReason,omitempty"` // Only provided by Hedera
+	BlobGasUsed       uint64          `json:"blobGasUsed,omitempty"`  // Only set for EIP-4844 blob transactions
+	BlobGasPrice      *big.Int        `json:"blobGasPrice,omitempty"` // Only set for EIP-4844 blob transactions
 }
 
 // FromGethReceipt converts a gethTypes.Receipt to a Receipt
@@ -88,6 +90,8 @@ func FromGethReceipt(gr *gethTypes.Receipt) *Receipt {
 		gr.BlockNumber,
 		gr.TransactionIndex,
 		nil,
+		gr.BlobGasUsed,
+		gr.BlobGasPrice,
 	}
 }
 
@@ -122,6 +126,8 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 		BlockNumber       *hexutil.Big    `json:"blockNumber,omitempty"`
 		TransactionIndex  hexutil.Uint    `json:"transactionIndex"`
 		RevertReason      hexutil.Bytes   `json:"revertReason,omitempty"` // Only provided by Hedera
+		BlobGasUsed       hexutil.Uint64  `json:"blobGasUsed,omitempty"`
+		BlobGasPrice      *hexutil.Big    `json:"blobGasPrice,omitempty"`
 	}
 	var enc Receipt
 	enc.PostState = r.PostState
@@ -136,6 +142,8 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 	enc.BlockNumber = (*hexutil.Big)(r.BlockNumber)
 	enc.TransactionIndex = hexutil.Uint(r.TransactionIndex)
 	enc.RevertReason = r.RevertReason
+	enc.BlobGasUsed = hexutil.Uint64(r.BlobGasUsed)
+	enc.BlobGasPrice = (*hexutil.Big)(r.BlobGasPrice)
 	return json.Marshal(&enc)
 }
 
@@ -154,6 +162,8 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 		BlockNumber       *hexutil.Big     `json:"blockNumber,omitempty"`
 		TransactionIndex  *hexutil.Uint    `json:"transactionIndex"`
 		RevertReason      *hexutil.Bytes   `json:"revertReason,omitempty"` // Only provided by Hedera
+		BlobGasUsed       *hexutil.Uint64  `json:"blobGasUsed,omitempty"`
+		BlobGasPrice      *hexutil.Big     `json:"blobGasPrice,omitempty"`
 	}
 	var dec Receipt
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -193,6 +203,12 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 	if dec.RevertReason != nil {
 		r.RevertReason = *dec.RevertReason
 	}
+	if dec.BlobGasUsed != nil {
+		r.BlobGasUsed = uint64(*dec.BlobGasUsed)
+	}
+	if dec.BlobGasPrice != nil {
+		r.BlobGasPrice = (*big.Int)(dec.BlobGasPrice)
+	}
 	return nil
 }
 
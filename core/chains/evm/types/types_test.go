@@ -56,6 +56,8 @@ var (
 		BlockHash:         common.HexToHash("0x11111111111111"),
 		BlockNumber:       big.NewInt(555),
 		TransactionIndex:  777,
+		BlobGasUsed:       131072,
+		BlobGasPrice:      big.NewInt(3),
 		Logs: []*gethTypes.Log{
 			testGethLog1,
 			testGethLog2,
@@ -79,6 +81,8 @@ func TestFromGethReceipt(t *testing.T) {
 	assert.Equal(t, testGethReceipt.BlockHash, receipt.BlockHash)
 	assert.Equal(t, testGethReceipt.BlockNumber, receipt.BlockNumber)
 	assert.Equal(t, testGethReceipt.TransactionIndex, receipt.TransactionIndex)
+	assert.Equal(t, testGethReceipt.BlobGasUsed, receipt.BlobGasUsed)
+	assert.Equal(t, testGethReceipt.BlobGasPrice, receipt.BlobGasPrice)
 	assert.Len(t, receipt.Logs, len(testGethReceipt.Logs))
 
 	for i, log := range receipt.Logs {
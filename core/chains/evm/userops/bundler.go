@@ -0,0 +1,53 @@
+package userops
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Bundler is the subset of the ERC-4337 bundler RPC API needed to send user operations and track them.
+type Bundler interface {
+	SendUserOperation(ctx context.Context, op UserOperation, entryPoint common.Address) (common.Hash, error)
+	EstimateUserOperationGas(ctx context.Context, op UserOperation, entryPoint common.Address) (GasEstimate, error)
+	// GetUserOperationReceipt returns nil if the user operation has not been included yet.
+	GetUserOperationReceipt(ctx context.Context, userOpHash common.Hash) (*Receipt, error)
+	Close()
+}
+
+type bundlerClient struct {
+	rpc *rpc.Client
+}
+
+var _ Bundler = (*bundlerClient)(nil)
+
+// NewBundlerClient returns a Bundler that talks to the bundler RPC endpoint at url. Any service implementing the
+// bundler RPC API can be used, including a local stand-in.
+func NewBundlerClient(ctx context.Context, url string) (Bundler, error) {
+	c, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial bundler: %w", err)
+	}
+	return &bundlerClient{rpc: c}, nil
+}
+
+func (b *bundlerClient) SendUserOperation(ctx context.Context, op UserOperation, entryPoint common.Address) (hash common.Hash, err error) {
+	err = b.rpc.CallContext(ctx, &hash, "eth_sendUserOperation", op, entryPoint)
+	return
+}
+
+func (b *bundlerClient) EstimateUserOperationGas(ctx context.Context, op UserOperation, entryPoint common.Address) (estimate GasEstimate, err error) {
+	err = b.rpc.CallContext(ctx, &estimate, "eth_estimateUserOperationGas", op, entryPoint)
+	return
+}
+
+func (b *bundlerClient) GetUserOperationReceipt(ctx context.Context, userOpHash common.Hash) (receipt *Receipt, err error) {
+	err = b.rpc.CallContext(ctx, &receipt, "eth_getUserOperationReceipt", userOpHash)
+	return
+}
+
+func (b *bundlerClient) Close() {
+	b.rpc.Close()
+}
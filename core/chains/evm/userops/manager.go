@@ -0,0 +1,400 @@
+package userops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+const (
+	accountABI    = `[{"inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"name":"execute","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	entryPointABI = `[{"inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"name":"getNonce","outputs":[{"name":"nonce","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+)
+
+const (
+	// DefaultPollInterval is how often pending user operations are checked for receipts by default.
+	DefaultPollInterval = 5 * time.Second
+	// DefaultSubmitTimeout is how long a user operation may be pending by default before it is resubmitted with a
+	// bumped fee.
+	DefaultSubmitTimeout = 2 * time.Minute
+)
+
+var (
+	accountContract    = mustABI(accountABI)
+	entryPointContract = mustABI(entryPointABI)
+
+	// dummySignature is a well-formed ECDSA signature used while estimating gas, before the user operation is final.
+	// Accounts must not revert on it, so that the bundler can simulate validation.
+	dummySignature = hexutil.MustDecode("0xfffffffffffffffffffffffffffffff0000000000000000000000000000000007aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1c")
+)
+
+func mustABI(s string) abi.ABI {
+	a, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Client is the subset of the EVM client needed to read account nonces from the EntryPoint.
+type Client interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// Signer signs user operation hashes with a session key of the smart account.
+type Signer interface {
+	SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error)
+}
+
+type Config struct {
+	// EntryPoint is the address of the v0.6 EntryPoint contract that the bundler submits to.
+	EntryPoint common.Address
+	// Account is the smart account that user operations are sent from.
+	Account common.Address
+	// NonceKey selects the nonce lane of Account used by this manager, so that several managers can share an account
+	// without racing each other for nonces.
+	NonceKey *big.Int
+	// MaxFeePrice caps the fee per gas of user operations.
+	MaxFeePrice *assets.Wei
+	// PollInterval is how often pending user operations are checked for receipts.
+	PollInterval time.Duration
+	// SubmitTimeout is how long a user operation may be pending before it is resubmitted with a bumped fee. If the fee
+	// cannot be bumped below MaxFeePrice, the user operation is abandoned and its nonce is reused. Zero disables
+	// resubmission.
+	SubmitTimeout time.Duration
+}
+
+// Manager sends user operations to an ERC-4337 bundler on behalf of a smart account, and tracks them until they are
+// included or have failed.
+type Manager struct {
+	services.Service
+	eng *services.Engine
+
+	cfg       Config
+	chainID   *big.Int
+	orm       ORM
+	bundler   Bundler
+	client    Client
+	estimator gas.EvmFeeEstimator
+	signer    Signer
+
+	sendMu sync.Mutex
+}
+
+func NewManager(lggr logger.Logger, cfg Config, chainID *big.Int, orm ORM, bundler Bundler, client Client, estimator gas.EvmFeeEstimator, signer Signer) *Manager {
+	m := &Manager{
+		cfg:       cfg,
+		chainID:   chainID,
+		orm:       orm,
+		bundler:   bundler,
+		client:    client,
+		estimator: estimator,
+		signer:    signer,
+	}
+	m.Service, m.eng = services.Config{
+		Name:  "UserOpManager",
+		Start: m.start,
+		Close: m.close,
+	}.NewServiceEngine(logger.With(lggr, "account", cfg.Account, "entryPoint", cfg.EntryPoint))
+	return m
+}
+
+func (m *Manager) start(context.Context) error {
+	m.eng.GoTick(services.NewTicker(m.cfg.PollInterval), m.checkPending)
+	return nil
+}
+
+func (m *Manager) close() error {
+	m.bundler.Close()
+	return nil
+}
+
+// Account returns the smart account that user operations are sent from.
+func (m *Manager) Account() common.Address {
+	return m.cfg.Account
+}
+
+// SendUserOperation has the smart account call toAddress with payload. The user operation is signed by sessionKey,
+// which the account must accept as a signer.
+func (m *Manager) SendUserOperation(ctx context.Context, sessionKey, toAddress common.Address, payload []byte, gasLimit uint64) (UserOp, error) {
+	callData, err := accountContract.Pack("execute", toAddress, new(big.Int), payload)
+	if err != nil {
+		return UserOp{}, fmt.Errorf("failed to encode account call: %w", err)
+	}
+
+	m.sendMu.Lock()
+	defer m.sendMu.Unlock()
+
+	nonce, err := m.nextNonce(ctx)
+	if err != nil {
+		return UserOp{}, err
+	}
+	fee, _, err := m.estimator.GetFee(ctx, callData, gasLimit, m.cfg.MaxFeePrice, &m.cfg.Account, &m.cfg.EntryPoint)
+	if err != nil {
+		return UserOp{}, fmt.Errorf("failed to estimate fee: %w", err)
+	}
+	op := UserOperation{
+		Sender:    m.cfg.Account,
+		Nonce:     (*hexutil.Big)(nonce),
+		CallData:  callData,
+		Signature: dummySignature,
+	}
+	if fee.ValidDynamic() {
+		op.MaxFeePerGas = (*hexutil.Big)(fee.GasFeeCap.ToInt())
+		op.MaxPriorityFeePerGas = (*hexutil.Big)(fee.GasTipCap.ToInt())
+	} else {
+		op.MaxFeePerGas = (*hexutil.Big)(fee.GasPrice.ToInt())
+		op.MaxPriorityFeePerGas = (*hexutil.Big)(fee.GasPrice.ToInt())
+	}
+
+	estimate, err := m.bundler.EstimateUserOperationGas(ctx, op, m.cfg.EntryPoint)
+	if err != nil {
+		return UserOp{}, fmt.Errorf("failed to estimate user operation gas: %w", err)
+	}
+	op.PreVerificationGas = estimate.PreVerificationGas
+	op.VerificationGasLimit = estimate.VerificationGasLimit
+	op.CallGasLimit = estimate.CallGasLimit
+
+	userOp, err := m.submit(ctx, op, sessionKey, toAddress)
+	if err != nil {
+		return UserOp{}, err
+	}
+	m.eng.Debugw("Sent user operation", "userOpHash", userOp.UserOpHash, "nonce", nonce, "to", toAddress)
+	return userOp, nil
+}
+
+// submit signs op with sessionKey, saves it and hands it to the bundler.
+func (m *Manager) submit(ctx context.Context, op UserOperation, sessionKey, toAddress common.Address) (UserOp, error) {
+	hash, err := op.Hash(m.cfg.EntryPoint, m.chainID)
+	if err != nil {
+		return UserOp{}, fmt.Errorf("failed to hash user operation: %w", err)
+	}
+	op.Signature, err = m.signer.SignMessage(ctx, sessionKey, hash.Bytes())
+	if err != nil {
+		return UserOp{}, fmt.Errorf("failed to sign user operation: %w", err)
+	}
+
+	encoded, err := json.Marshal(op)
+	if err != nil {
+		return UserOp{}, err
+	}
+	userOp := UserOp{
+		EVMChainID: *ubig.New(m.chainID),
+		UserOpHash: hash,
+		EntryPoint: m.cfg.EntryPoint,
+		Sender:     m.cfg.Account,
+		SessionKey: &sessionKey,
+		Nonce:      *ubig.New(op.Nonce.ToInt()),
+		ToAddress:  toAddress,
+		UserOp:     encoded,
+	}
+	// The user operation is saved before it is sent, so that it is tracked even if the node stops right after.
+	if err = m.orm.CreateUserOp(ctx, &userOp); err != nil {
+		return UserOp{}, fmt.Errorf("failed to save user operation: %w", err)
+	}
+
+	bundlerHash, err := m.bundler.SendUserOperation(ctx, op, m.cfg.EntryPoint)
+	if err == nil && bundlerHash != hash {
+		err = fmt.Errorf("bundler returned userOpHash %s, expected %s", bundlerHash, hash)
+	}
+	if err != nil {
+		if markErr := m.orm.MarkFailed(ctx, userOp.ID, err.Error()); markErr != nil {
+			m.eng.Errorw("Failed to mark user operation as failed", "userOpHash", hash, "err", markErr)
+		}
+		return UserOp{}, fmt.Errorf("bundler rejected user operation: %w", err)
+	}
+	return userOp, nil
+}
+
+// nextNonce returns the lowest nonce in the lane of the manager which is neither used on-chain nor by a pending user
+// operation. User operations that are still pending are not yet reflected by the EntryPoint, so they are accounted for
+// locally. The nonces of abandoned user operations are reused, since later user operations cannot be included before
+// they are.
+func (m *Manager) nextNonce(ctx context.Context) (*big.Int, error) {
+	nonce, err := m.onChainNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := m.pendingUserOps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]bool, len(pending))
+	for _, op := range pending {
+		used[op.Nonce.String()] = true
+	}
+	for used[nonce.String()] {
+		nonce = new(big.Int).Add(nonce, big.NewInt(1))
+	}
+	return nonce, nil
+}
+
+func (m *Manager) onChainNonce(ctx context.Context) (*big.Int, error) {
+	data, err := entryPointContract.Pack("getNonce", m.cfg.Account, m.nonceKey())
+	if err != nil {
+		return nil, err
+	}
+	b, err := m.client.CallContract(ctx, ethereum.CallMsg{To: &m.cfg.EntryPoint, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account nonce: %w", err)
+	}
+	out, err := entryPointContract.Unpack("getNonce", b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode account nonce: %w", err)
+	}
+	return abi.ConvertType(out[0], new(big.Int)).(*big.Int), nil
+}
+
+func (m *Manager) nonceKey() *big.Int {
+	if m.cfg.NonceKey == nil {
+		return new(big.Int)
+	}
+	return m.cfg.NonceKey
+}
+
+// pendingUserOps returns the pending user operations in the nonce lane of the manager.
+func (m *Manager) pendingUserOps(ctx context.Context) ([]UserOp, error) {
+	ops, err := m.orm.FindPendingUserOps(ctx, m.chainID, m.cfg.EntryPoint, m.cfg.Account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pending user operations: %w", err)
+	}
+	var lane []UserOp
+	for _, op := range ops {
+		// The upper 192 bits of a nonce are its key, the lower 64 bits its sequence.
+		if new(big.Int).Rsh(op.Nonce.ToInt(), 64).Cmp(m.nonceKey()) == 0 {
+			lane = append(lane, op)
+		}
+	}
+	return lane, nil
+}
+
+func (m *Manager) checkPending(ctx context.Context) {
+	if err := m.CheckPending(ctx); err != nil {
+		m.eng.Errorw("Failed to check pending user operations", "err", err)
+	}
+}
+
+// CheckPending looks up the receipts of pending user operations and moves them to their final state. User operations
+// which are still pending after SubmitTimeout are resubmitted with a bumped fee.
+func (m *Manager) CheckPending(ctx context.Context) error {
+	pending, err := m.pendingUserOps(ctx)
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+	// The nonce is read before the receipts, so that an operation whose nonce has been used is guaranteed to have a
+	// receipt by the time it is looked up.
+	nonce, err := m.onChainNonce(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	// The pending user operations of each nonce which has not been used yet, oldest first
+	var unresolved [][]UserOp
+	for _, op := range pending {
+		receipt, err := m.bundler.GetUserOperationReceipt(ctx, op.UserOpHash)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to get receipt for user operation %s: %w", op.UserOpHash, err))
+			continue
+		}
+		switch {
+		case receipt == nil && op.Nonce.Cmp(ubig.New(nonce)) < 0:
+			m.eng.Warnw("User operation was replaced", "userOpHash", op.UserOpHash, "nonce", op.Nonce.String())
+			err = m.orm.MarkFailed(ctx, op.ID, "replaced by another user operation with the same nonce")
+		case receipt == nil:
+			if n := len(unresolved); n > 0 && unresolved[n-1][0].Nonce.Cmp(&op.Nonce) == 0 {
+				unresolved[n-1] = append(unresolved[n-1], op)
+			} else {
+				unresolved = append(unresolved, []UserOp{op})
+			}
+			continue
+		case receipt.Success:
+			m.eng.Debugw("User operation included", "userOpHash", op.UserOpHash, "txHash", receipt.Receipt.TransactionHash)
+			err = m.orm.MarkIncluded(ctx, op.ID, receipt.Receipt.TransactionHash, bigOrZero(receipt.Receipt.BlockNumber).Int64())
+		default:
+			reason := receipt.Reason
+			if reason == "" {
+				reason = "execution reverted"
+			}
+			m.eng.Warnw("User operation failed", "userOpHash", op.UserOpHash, "txHash", receipt.Receipt.TransactionHash, "reason", reason)
+			err = m.orm.MarkFailed(ctx, op.ID, reason)
+		}
+		errs = errors.Join(errs, err)
+	}
+
+	if m.cfg.SubmitTimeout > 0 {
+		for _, ops := range unresolved {
+			// Only the latest submission counts, earlier ones have been replaced by it already
+			latest := ops[len(ops)-1]
+			if time.Since(latest.CreatedAt) >= m.cfg.SubmitTimeout {
+				errs = errors.Join(errs, m.resubmit(ctx, latest, ops))
+			}
+		}
+	}
+	return errs
+}
+
+// resubmit replaces op, which has been pending for longer than SubmitTimeout, by a user operation with a bumped fee.
+// If the fee cannot be bumped any further, every pending user operation with the nonce of op is abandoned, so that
+// the nonce is reused by the next user operation.
+func (m *Manager) resubmit(ctx context.Context, op UserOp, sameNonce []UserOp) error {
+	if op.SessionKey == nil {
+		// Sent before session keys were stored, so it cannot be signed again
+		return nil
+	}
+	var uo UserOperation
+	if err := json.Unmarshal(op.UserOp, &uo); err != nil {
+		return fmt.Errorf("failed to decode user operation %s: %w", op.UserOpHash, err)
+	}
+	original := gas.EvmFee{DynamicFee: gas.DynamicFee{GasFeeCap: assets.NewWei(bigOrZero(uo.MaxFeePerGas)), GasTipCap: assets.NewWei(bigOrZero(uo.MaxPriorityFeePerGas))}}
+	if bigOrZero(uo.MaxFeePerGas).Cmp(bigOrZero(uo.MaxPriorityFeePerGas)) == 0 {
+		original = gas.EvmFee{GasPrice: assets.NewWei(bigOrZero(uo.MaxFeePerGas))}
+	}
+
+	m.sendMu.Lock()
+	defer m.sendMu.Unlock()
+
+	bumped, _, err := m.estimator.BumpFee(ctx, original, bigOrZero(uo.CallGasLimit).Uint64(), m.cfg.MaxFeePrice, nil)
+	if errors.Is(err, commonfee.ErrBumpFeeExceedsLimit) {
+		m.eng.Warnw("Abandoning user operation, its fee cannot be bumped any further", "userOpHash", op.UserOpHash, "nonce", op.Nonce.String(), "err", err)
+		var errs error
+		for _, o := range sameNonce {
+			errs = errors.Join(errs, m.orm.MarkFailed(ctx, o.ID, "abandoned: "+err.Error()))
+		}
+		return errs
+	} else if err != nil {
+		return fmt.Errorf("failed to bump fee of user operation %s: %w", op.UserOpHash, err)
+	}
+	if bumped.ValidDynamic() {
+		uo.MaxFeePerGas = (*hexutil.Big)(bumped.GasFeeCap.ToInt())
+		uo.MaxPriorityFeePerGas = (*hexutil.Big)(bumped.GasTipCap.ToInt())
+	} else {
+		uo.MaxFeePerGas = (*hexutil.Big)(bumped.GasPrice.ToInt())
+		uo.MaxPriorityFeePerGas = (*hexutil.Big)(bumped.GasPrice.ToInt())
+	}
+
+	replacement, err := m.submit(ctx, uo, *op.SessionKey, op.ToAddress)
+	if err != nil {
+		return fmt.Errorf("failed to resubmit user operation %s: %w", op.UserOpHash, err)
+	}
+	m.eng.Infow("Resubmitted user operation with a bumped fee", "userOpHash", op.UserOpHash, "replacementUserOpHash", replacement.UserOpHash, "nonce", op.Nonce.String())
+	return nil
+}
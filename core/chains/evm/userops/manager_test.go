@@ -0,0 +1,304 @@
+package userops_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/userops"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/userops/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+type nonceClient struct {
+	nonce *big.Int
+}
+
+func (c *nonceClient) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return common.BigToHash(c.nonce).Bytes(), nil
+}
+
+type keySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *keySigner) SignMessage(_ context.Context, _ common.Address, data []byte) ([]byte, error) {
+	sig, err := crypto.Sign(accounts.TextHash(data), s.key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+type testManager struct {
+	*userops.Manager
+	cfg       userops.Config
+	chainID   *big.Int
+	orm       *mocks.ORM
+	bundler   *mocks.Bundler
+	client    *nonceClient
+	estimator *gasmocks.EvmFeeEstimator
+	key       *ecdsa.PrivateKey
+}
+
+func newTestManager(t *testing.T) *testManager {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	m := &testManager{
+		cfg: userops.Config{
+			EntryPoint:   testutils.NewAddress(),
+			Account:      testutils.NewAddress(),
+			NonceKey:     big.NewInt(7),
+			MaxFeePrice:  assets.GWei(100),
+			PollInterval: userops.DefaultPollInterval,
+		},
+		chainID:   big.NewInt(11155111),
+		orm:       mocks.NewORM(t),
+		bundler:   mocks.NewBundler(t),
+		client:    &nonceClient{},
+		estimator: gasmocks.NewEvmFeeEstimator(t),
+		key:       key,
+	}
+	m.Manager = userops.NewManager(logger.Test(t), m.cfg, m.chainID, m.orm, m.bundler, m.client, m.estimator, &keySigner{key: key})
+	return m
+}
+
+// laneNonce returns the nonce with the given sequence in the lane of key.
+func laneNonce(key, seq int64) *big.Int {
+	n := new(big.Int).Lsh(big.NewInt(key), 64)
+	return n.Add(n, big.NewInt(seq))
+}
+
+func pendingOp(id int64, nonce *big.Int) userops.UserOp {
+	return userops.UserOp{ID: id, UserOpHash: utils.NewHash(), Nonce: *ubig.New(nonce), State: userops.StatePending}
+}
+
+func TestManager_SendUserOperation(t *testing.T) {
+	t.Parallel()
+
+	toAddress := testutils.NewAddress()
+	payload := []byte{1, 2, 3}
+	sessionKey := testutils.NewAddress()
+	estimate := userops.GasEstimate{
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100_000)),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(200_000)),
+	}
+
+	t.Run("sends a signed user operation after the pending ones in its lane", func(t *testing.T) {
+		m := newTestManager(t)
+		m.client.nonce = laneNonce(7, 3)
+		m.orm.On("FindPendingUserOps", mock.Anything, m.chainID, m.cfg.EntryPoint, m.cfg.Account).Return([]userops.UserOp{
+			pendingOp(1, laneNonce(7, 3)),
+			pendingOp(2, laneNonce(7, 4)),
+			pendingOp(3, laneNonce(8, 10)),
+		}, nil).Once()
+		m.estimator.On("GetFee", mock.Anything, mock.Anything, uint64(500_000), m.cfg.MaxFeePrice, &m.cfg.Account, &m.cfg.EntryPoint).
+			Return(gas.EvmFee{DynamicFee: gas.DynamicFee{GasFeeCap: assets.GWei(20), GasTipCap: assets.GWei(2)}}, uint64(500_000), nil).Once()
+		m.bundler.On("EstimateUserOperationGas", mock.Anything, mock.Anything, m.cfg.EntryPoint).Return(estimate, nil).Once()
+
+		var sent userops.UserOperation
+		m.orm.On("CreateUserOp", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(1).(*userops.UserOp).ID = 42
+		}).Return(nil).Once()
+		m.bundler.EXPECT().SendUserOperation(mock.Anything, mock.Anything, m.cfg.EntryPoint).
+			RunAndReturn(func(_ context.Context, op userops.UserOperation, entryPoint common.Address) (common.Hash, error) {
+				sent = op
+				return op.Hash(entryPoint, m.chainID)
+			}).Once()
+
+		userOp, err := m.SendUserOperation(tests.Context(t), sessionKey, toAddress, payload, 500_000)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(42), userOp.ID)
+		assert.Equal(t, laneNonce(7, 5), userOp.Nonce.ToInt())
+		assert.Equal(t, toAddress, userOp.ToAddress)
+		assert.Equal(t, &sessionKey, userOp.SessionKey)
+		assert.Equal(t, m.cfg.Account, sent.Sender)
+		assert.Equal(t, laneNonce(7, 5), sent.Nonce.ToInt())
+		assert.Equal(t, assets.GWei(20).ToInt(), sent.MaxFeePerGas.ToInt())
+		assert.Equal(t, assets.GWei(2).ToInt(), sent.MaxPriorityFeePerGas.ToInt())
+		assert.Equal(t, estimate.CallGasLimit, sent.CallGasLimit)
+
+		hash, err := sent.Hash(m.cfg.EntryPoint, m.chainID)
+		require.NoError(t, err)
+		assert.Equal(t, hash, userOp.UserOpHash)
+		sig := append([]byte{}, sent.Signature...)
+		sig[crypto.RecoveryIDOffset] -= 27
+		pub, err := crypto.SigToPub(accounts.TextHash(hash.Bytes()), sig)
+		require.NoError(t, err)
+		assert.Equal(t, crypto.PubkeyToAddress(m.key.PublicKey), crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("reuses the nonce of an abandoned user operation", func(t *testing.T) {
+		m := newTestManager(t)
+		m.client.nonce = laneNonce(7, 3)
+		m.orm.On("FindPendingUserOps", mock.Anything, m.chainID, m.cfg.EntryPoint, m.cfg.Account).Return([]userops.UserOp{
+			pendingOp(1, laneNonce(7, 3)),
+			pendingOp(3, laneNonce(7, 5)),
+		}, nil).Once()
+		m.estimator.On("GetFee", mock.Anything, mock.Anything, uint64(500_000), m.cfg.MaxFeePrice, &m.cfg.Account, &m.cfg.EntryPoint).
+			Return(gas.EvmFee{GasPrice: assets.GWei(20)}, uint64(500_000), nil).Once()
+		m.bundler.On("EstimateUserOperationGas", mock.Anything, mock.Anything, m.cfg.EntryPoint).Return(estimate, nil).Once()
+		m.orm.On("CreateUserOp", mock.Anything, mock.Anything).Return(nil).Once()
+		m.bundler.EXPECT().SendUserOperation(mock.Anything, mock.Anything, m.cfg.EntryPoint).
+			RunAndReturn(func(_ context.Context, op userops.UserOperation, entryPoint common.Address) (common.Hash, error) {
+				return op.Hash(entryPoint, m.chainID)
+			}).Once()
+
+		userOp, err := m.SendUserOperation(tests.Context(t), sessionKey, toAddress, payload, 500_000)
+		require.NoError(t, err)
+		assert.Equal(t, laneNonce(7, 4), userOp.Nonce.ToInt())
+	})
+
+	t.Run("marks the user operation failed if the bundler rejects it", func(t *testing.T) {
+		m := newTestManager(t)
+		m.client.nonce = laneNonce(7, 0)
+		m.orm.On("FindPendingUserOps", mock.Anything, m.chainID, m.cfg.EntryPoint, m.cfg.Account).Return(nil, nil).Once()
+		m.estimator.On("GetFee", mock.Anything, mock.Anything, uint64(500_000), m.cfg.MaxFeePrice, &m.cfg.Account, &m.cfg.EntryPoint).
+			Return(gas.EvmFee{GasPrice: assets.GWei(20)}, uint64(500_000), nil).Once()
+		m.bundler.On("EstimateUserOperationGas", mock.Anything, mock.Anything, m.cfg.EntryPoint).Return(estimate, nil).Once()
+		m.orm.On("CreateUserOp", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(1).(*userops.UserOp).ID = 42
+		}).Return(nil).Once()
+		m.bundler.On("SendUserOperation", mock.Anything, mock.Anything, m.cfg.EntryPoint).Return(common.Hash{}, assert.AnError).Once()
+		m.orm.On("MarkFailed", mock.Anything, int64(42), assert.AnError.Error()).Return(nil).Once()
+
+		_, err := m.SendUserOperation(tests.Context(t), sessionKey, toAddress, payload, 500_000)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestManager_CheckPending(t *testing.T) {
+	t.Parallel()
+
+	m := newTestManager(t)
+	m.client.nonce = laneNonce(7, 3)
+
+	replaced := pendingOp(1, laneNonce(7, 1))
+	included := pendingOp(2, laneNonce(7, 2))
+	reverted := pendingOp(3, laneNonce(7, 2))
+	waiting := pendingOp(4, laneNonce(7, 3))
+	otherLane := pendingOp(5, laneNonce(8, 0))
+	m.orm.On("FindPendingUserOps", mock.Anything, m.chainID, m.cfg.EntryPoint, m.cfg.Account).
+		Return([]userops.UserOp{replaced, included, reverted, waiting, otherLane}, nil).Once()
+
+	txHash := utils.NewHash()
+	success := &userops.Receipt{Success: true}
+	success.Receipt.TransactionHash = txHash
+	success.Receipt.BlockNumber = (*hexutil.Big)(big.NewInt(100))
+	m.bundler.On("GetUserOperationReceipt", mock.Anything, replaced.UserOpHash).Return(nil, nil).Once()
+	m.bundler.On("GetUserOperationReceipt", mock.Anything, included.UserOpHash).Return(success, nil).Once()
+	m.bundler.On("GetUserOperationReceipt", mock.Anything, reverted.UserOpHash).Return(&userops.Receipt{Success: false}, nil).Once()
+	m.bundler.On("GetUserOperationReceipt", mock.Anything, waiting.UserOpHash).Return(nil, nil).Once()
+
+	m.orm.On("MarkFailed", mock.Anything, replaced.ID, "replaced by another user operation with the same nonce").Return(nil).Once()
+	m.orm.On("MarkIncluded", mock.Anything, included.ID, txHash, int64(100)).Return(nil).Once()
+	m.orm.On("MarkFailed", mock.Anything, reverted.ID, "execution reverted").Return(nil).Once()
+
+	require.NoError(t, m.CheckPending(tests.Context(t)))
+}
+
+func TestManager_CheckPending_Resubmit(t *testing.T) {
+	t.Parallel()
+
+	sessionKey := testutils.NewAddress()
+	stuckOp := func(t *testing.T, id int64, nonce *big.Int, createdAt time.Time) userops.UserOp {
+		encoded, err := json.Marshal(userops.UserOperation{
+			Nonce:                (*hexutil.Big)(nonce),
+			CallGasLimit:         (*hexutil.Big)(big.NewInt(200_000)),
+			MaxFeePerGas:         (*hexutil.Big)(assets.GWei(20).ToInt()),
+			MaxPriorityFeePerGas: (*hexutil.Big)(assets.GWei(2).ToInt()),
+		})
+		require.NoError(t, err)
+		op := pendingOp(id, nonce)
+		op.SessionKey = &sessionKey
+		op.ToAddress = testutils.NewAddress()
+		op.UserOp = encoded
+		op.CreatedAt = createdAt
+		return op
+	}
+	newManager := func(t *testing.T) *testManager {
+		m := newTestManager(t)
+		m.cfg.SubmitTimeout = time.Minute
+		m.Manager = userops.NewManager(logger.Test(t), m.cfg, m.chainID, m.orm, m.bundler, m.client, m.estimator, &keySigner{key: m.key})
+		m.client.nonce = laneNonce(7, 3)
+		return m
+	}
+	originalFee := gas.EvmFee{DynamicFee: gas.DynamicFee{GasFeeCap: assets.GWei(20), GasTipCap: assets.GWei(2)}}
+
+	t.Run("resubmits the latest stuck user operation of a nonce with a bumped fee", func(t *testing.T) {
+		m := newManager(t)
+		first := stuckOp(t, 1, laneNonce(7, 3), time.Now().Add(-5*time.Minute))
+		latest := stuckOp(t, 2, laneNonce(7, 3), time.Now().Add(-2*time.Minute))
+		recent := stuckOp(t, 3, laneNonce(7, 4), time.Now())
+		m.orm.On("FindPendingUserOps", mock.Anything, m.chainID, m.cfg.EntryPoint, m.cfg.Account).
+			Return([]userops.UserOp{first, latest, recent}, nil).Once()
+		m.bundler.On("GetUserOperationReceipt", mock.Anything, mock.Anything).Return(nil, nil).Times(3)
+		m.estimator.On("BumpFee", mock.Anything, originalFee, uint64(200_000), m.cfg.MaxFeePrice, []gas.EvmPriorAttempt(nil)).
+			Return(gas.EvmFee{DynamicFee: gas.DynamicFee{GasFeeCap: assets.GWei(24), GasTipCap: assets.GWei(3)}}, uint64(200_000), nil).Once()
+
+		var saved *userops.UserOp
+		m.orm.On("CreateUserOp", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*userops.UserOp)
+		}).Return(nil).Once()
+		var sent userops.UserOperation
+		m.bundler.EXPECT().SendUserOperation(mock.Anything, mock.Anything, m.cfg.EntryPoint).
+			RunAndReturn(func(_ context.Context, op userops.UserOperation, entryPoint common.Address) (common.Hash, error) {
+				sent = op
+				return op.Hash(entryPoint, m.chainID)
+			}).Once()
+
+		require.NoError(t, m.CheckPending(tests.Context(t)))
+
+		require.NotNil(t, saved)
+		assert.Equal(t, laneNonce(7, 3), saved.Nonce.ToInt())
+		assert.Equal(t, latest.ToAddress, saved.ToAddress)
+		assert.Equal(t, &sessionKey, saved.SessionKey)
+		assert.NotEqual(t, latest.UserOpHash, saved.UserOpHash)
+		assert.Equal(t, assets.GWei(24).ToInt(), sent.MaxFeePerGas.ToInt())
+		assert.Equal(t, assets.GWei(3).ToInt(), sent.MaxPriorityFeePerGas.ToInt())
+	})
+
+	t.Run("abandons a stuck user operation whose fee cannot be bumped", func(t *testing.T) {
+		m := newManager(t)
+		first := stuckOp(t, 1, laneNonce(7, 3), time.Now().Add(-5*time.Minute))
+		latest := stuckOp(t, 2, laneNonce(7, 3), time.Now().Add(-2*time.Minute))
+		m.orm.On("FindPendingUserOps", mock.Anything, m.chainID, m.cfg.EntryPoint, m.cfg.Account).
+			Return([]userops.UserOp{first, latest}, nil).Once()
+		m.bundler.On("GetUserOperationReceipt", mock.Anything, mock.Anything).Return(nil, nil).Times(2)
+		m.estimator.On("BumpFee", mock.Anything, originalFee, uint64(200_000), m.cfg.MaxFeePrice, []gas.EvmPriorAttempt(nil)).
+			Return(gas.EvmFee{}, uint64(0), commonfee.ErrBumpFeeExceedsLimit).Once()
+		m.orm.On("MarkFailed", mock.Anything, first.ID, mock.MatchedBy(func(reason string) bool {
+			return strings.HasPrefix(reason, "abandoned: ")
+		})).Return(nil).Once()
+		m.orm.On("MarkFailed", mock.Anything, latest.ID, mock.MatchedBy(func(reason string) bool {
+			return strings.HasPrefix(reason, "abandoned: ")
+		})).Return(nil).Once()
+
+		require.NoError(t, m.CheckPending(tests.Context(t)))
+	})
+}
@@ -0,0 +1,132 @@
+package userops
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// UserOperation is an ERC-4337 user operation, in the format accepted by v0.6 EntryPoint contracts.
+type UserOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+var (
+	packedUserOpArgs = mustArguments("address", "uint256", "bytes32", "bytes32", "uint256", "uint256", "uint256", "uint256", "uint256", "bytes32")
+	userOpHashArgs   = mustArguments("bytes32", "address", "uint256")
+)
+
+func mustArguments(types ...string) (args abi.Arguments) {
+	for _, t := range types {
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, abi.Argument{Type: typ})
+	}
+	return
+}
+
+// Hash returns the userOpHash that the EntryPoint at entryPoint assigns to op on chainID. This is the hash that
+// the account signs over, and the one bundlers use to identify the operation.
+func (op UserOperation) Hash(entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	packed, err := packedUserOpArgs.Pack(
+		op.Sender,
+		bigOrZero(op.Nonce),
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		bigOrZero(op.CallGasLimit),
+		bigOrZero(op.VerificationGasLimit),
+		bigOrZero(op.PreVerificationGas),
+		bigOrZero(op.MaxFeePerGas),
+		bigOrZero(op.MaxPriorityFeePerGas),
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	encoded, err := userOpHashArgs.Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+func bigOrZero(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
+
+// GasEstimate is the result of eth_estimateUserOperationGas.
+type GasEstimate struct {
+	PreVerificationGas   *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit         *hexutil.Big `json:"callGasLimit"`
+}
+
+// Receipt is the result of eth_getUserOperationReceipt.
+type Receipt struct {
+	UserOpHash    common.Hash    `json:"userOpHash"`
+	Sender        common.Address `json:"sender"`
+	Nonce         *hexutil.Big   `json:"nonce"`
+	ActualGasCost *hexutil.Big   `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big   `json:"actualGasUsed"`
+	Success       bool           `json:"success"`
+	Reason        string         `json:"reason"`
+	Receipt       struct {
+		TransactionHash common.Hash  `json:"transactionHash"`
+		BlockNumber     *hexutil.Big `json:"blockNumber"`
+	} `json:"receipt"`
+}
+
+// State is the lifecycle state of a user operation sent by this node.
+type State string
+
+const (
+	// StatePending user operations have been handed to the bundler, but are not yet part of a bundle that was mined.
+	StatePending State = "pending"
+	// StateIncluded user operations were mined and executed successfully.
+	StateIncluded State = "included"
+	// StateFailed user operations were rejected by the bundler, reverted on-chain, were replaced or were abandoned.
+	StateFailed State = "failed"
+)
+
+// UserOp is a user operation sent by this node, along with its lifecycle. It is the user operation counterpart to
+// txmgr.Tx.
+type UserOp struct {
+	ID          int64
+	EVMChainID  ubig.Big
+	UserOpHash  common.Hash
+	EntryPoint  common.Address
+	Sender      common.Address
+	SessionKey  *common.Address
+	Nonce       ubig.Big
+	ToAddress   common.Address
+	UserOp      sqlutil.JSON
+	State       State
+	TxHash      *common.Hash
+	BlockNumber *int64
+	Error       *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
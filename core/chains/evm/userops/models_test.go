@@ -0,0 +1,84 @@
+package userops_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/userops"
+)
+
+func TestUserOperation_Hash(t *testing.T) {
+	t.Parallel()
+
+	entryPoint := common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	op := userops.UserOperation{
+		Sender:               common.HexToAddress("0x1306b01bC3e4AD202612D3843387e94737673F53"),
+		Nonce:                (*hexutil.Big)(big.NewInt(1)),
+		CallData:             hexutil.MustDecode("0xb61d27f6"),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100_000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100_000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(2e9)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1e9)),
+	}
+
+	hash, err := op.Hash(entryPoint, big.NewInt(1))
+	require.NoError(t, err)
+
+	t.Run("does not cover the signature", func(t *testing.T) {
+		signed := op
+		signed.Signature = []byte{1, 2, 3}
+		h, err := signed.Hash(entryPoint, big.NewInt(1))
+		require.NoError(t, err)
+		assert.Equal(t, hash, h)
+	})
+
+	t.Run("covers the entry point and chain", func(t *testing.T) {
+		h, err := op.Hash(common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032"), big.NewInt(1))
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, h)
+
+		h, err = op.Hash(entryPoint, big.NewInt(137))
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, h)
+	})
+
+	t.Run("covers the fields", func(t *testing.T) {
+		changed := op
+		changed.CallData = hexutil.MustDecode("0xb61d27f7")
+		h, err := changed.Hash(entryPoint, big.NewInt(1))
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, h)
+	})
+}
+
+func TestUserOperation_JSON(t *testing.T) {
+	t.Parallel()
+
+	op := userops.UserOperation{
+		Sender:       common.HexToAddress("0x1306b01bC3e4AD202612D3843387e94737673F53"),
+		Nonce:        (*hexutil.Big)(big.NewInt(16)),
+		CallData:     []byte{0xab},
+		MaxFeePerGas: (*hexutil.Big)(big.NewInt(255)),
+	}
+	b, err := json.Marshal(op)
+	require.NoError(t, err)
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(b, &fields))
+	assert.Equal(t, "0x10", fields["nonce"])
+	assert.Equal(t, "0xab", fields["callData"])
+	assert.Equal(t, "0xff", fields["maxFeePerGas"])
+	assert.Equal(t, "0x", fields["initCode"])
+
+	var decoded userops.UserOperation
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, op.Sender, decoded.Sender)
+	assert.Equal(t, op.Nonce, decoded.Nonce)
+}
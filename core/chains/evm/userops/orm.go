@@ -0,0 +1,63 @@
+package userops
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+type ORM interface {
+	CreateUserOp(ctx context.Context, op *UserOp) error
+	FindUserOpByHash(ctx context.Context, chainID *big.Int, userOpHash common.Hash) (UserOp, error)
+	FindPendingUserOps(ctx context.Context, chainID *big.Int, entryPoint, sender common.Address) ([]UserOp, error)
+	MarkIncluded(ctx context.Context, id int64, txHash common.Hash, blockNumber int64) error
+	MarkFailed(ctx context.Context, id int64, reason string) error
+}
+
+type DSORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = &DSORM{}
+
+func NewORM(ds sqlutil.DataSource) *DSORM {
+	return &DSORM{ds: ds}
+}
+
+// CreateUserOp inserts a pending user operation, and sets the ID and timestamps of op.
+func (o *DSORM) CreateUserOp(ctx context.Context, op *UserOp) error {
+	op.State = StatePending
+	sql := `INSERT INTO evm.user_ops (evm_chain_id, user_op_hash, entry_point, sender, session_key, nonce, to_address, user_op, state, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now()) RETURNING id, created_at, updated_at`
+	return o.ds.QueryRowxContext(ctx, sql, op.EVMChainID, op.UserOpHash, op.EntryPoint, op.Sender, op.SessionKey, op.Nonce, op.ToAddress, op.UserOp, op.State).
+		Scan(&op.ID, &op.CreatedAt, &op.UpdatedAt)
+}
+
+func (o *DSORM) FindUserOpByHash(ctx context.Context, chainID *big.Int, userOpHash common.Hash) (op UserOp, err error) {
+	err = o.ds.GetContext(ctx, &op, `SELECT * FROM evm.user_ops WHERE evm_chain_id = $1 AND user_op_hash = $2`, ubig.New(chainID), userOpHash)
+	return
+}
+
+// FindPendingUserOps returns the pending user operations of sender, in nonce order and oldest first for the same nonce.
+func (o *DSORM) FindPendingUserOps(ctx context.Context, chainID *big.Int, entryPoint, sender common.Address) (ops []UserOp, err error) {
+	sql := `SELECT * FROM evm.user_ops WHERE evm_chain_id = $1 AND entry_point = $2 AND sender = $3 AND state = $4 ORDER BY nonce ASC, id ASC`
+	err = o.ds.SelectContext(ctx, &ops, sql, ubig.New(chainID), entryPoint, sender, StatePending)
+	return
+}
+
+func (o *DSORM) MarkIncluded(ctx context.Context, id int64, txHash common.Hash, blockNumber int64) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.user_ops SET state = $2, tx_hash = $3, block_number = $4, updated_at = now() WHERE id = $1`,
+		id, StateIncluded, txHash, blockNumber)
+	return err
+}
+
+func (o *DSORM) MarkFailed(ctx context.Context, id int64, reason string) error {
+	_, err := o.ds.ExecContext(ctx, `UPDATE evm.user_ops SET state = $2, error = $3, updated_at = now() WHERE id = $1`,
+		id, StateFailed, reason)
+	return err
+}
@@ -0,0 +1,75 @@
+package userops_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/userops"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestORM_UserOpLifecycle(t *testing.T) {
+	t.Parallel()
+
+	orm := userops.NewORM(pgtest.NewSqlxDB(t))
+	ctx := testutils.Context(t)
+	chainID := testutils.FixtureChainID
+	entryPoint, sender, sessionKey := testutils.NewAddress(), testutils.NewAddress(), testutils.NewAddress()
+
+	newUserOp := func(nonce int64) *userops.UserOp {
+		op := &userops.UserOp{
+			EVMChainID: *ubig.New(chainID),
+			UserOpHash: utils.NewHash(),
+			EntryPoint: entryPoint,
+			Sender:     sender,
+			SessionKey: &sessionKey,
+			Nonce:      *ubig.NewI(nonce),
+			ToAddress:  testutils.NewAddress(),
+			UserOp:     []byte(`{"sender":"` + sender.Hex() + `"}`),
+		}
+		require.NoError(t, orm.CreateUserOp(ctx, op))
+		require.NotZero(t, op.ID)
+		return op
+	}
+	op2, op1, op3 := newUserOp(2), newUserOp(1), newUserOp(3)
+
+	pending, err := orm.FindPendingUserOps(ctx, chainID, entryPoint, sender)
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+	assert.Equal(t, []int64{op1.ID, op2.ID, op3.ID}, []int64{pending[0].ID, pending[1].ID, pending[2].ID})
+	assert.Equal(t, userops.StatePending, pending[0].State)
+	assert.JSONEq(t, string(op1.UserOp), string(pending[0].UserOp))
+	assert.Equal(t, &sessionKey, pending[0].SessionKey)
+
+	txHash := utils.NewHash()
+	require.NoError(t, orm.MarkIncluded(ctx, op1.ID, txHash, 42))
+	require.NoError(t, orm.MarkFailed(ctx, op2.ID, "execution reverted"))
+
+	pending, err = orm.FindPendingUserOps(ctx, chainID, entryPoint, sender)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, op3.ID, pending[0].ID)
+
+	included, err := orm.FindUserOpByHash(ctx, chainID, op1.UserOpHash)
+	require.NoError(t, err)
+	assert.Equal(t, userops.StateIncluded, included.State)
+	require.NotNil(t, included.TxHash)
+	assert.Equal(t, txHash, *included.TxHash)
+	require.NotNil(t, included.BlockNumber)
+	assert.Equal(t, int64(42), *included.BlockNumber)
+
+	failed, err := orm.FindUserOpByHash(ctx, chainID, op2.UserOpHash)
+	require.NoError(t, err)
+	assert.Equal(t, userops.StateFailed, failed.State)
+	require.NotNil(t, failed.Error)
+	assert.Equal(t, "execution reverted", *failed.Error)
+
+	_, err = orm.FindUserOpByHash(ctx, big.NewInt(1), op3.UserOpHash)
+	require.Error(t, err)
+}
@@ -32,6 +32,7 @@ import (
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 )
 
 type Chain interface {
@@ -42,6 +43,8 @@ type Chain interface {
 	Config() evmconfig.ChainScopedConfig
 	LogBroadcaster() log.Broadcaster
 	HeadBroadcaster() httypes.HeadBroadcaster
+	// ReorgBroadcaster relays the reorgs detected by the HeadTracker of the chain.
+	ReorgBroadcaster() httypes.ReorgBroadcaster
 	TxManager() txmgr.TxManager
 	HeadTracker() httypes.HeadTracker
 	Logger() logger.Logger
@@ -103,18 +106,19 @@ func (c *LegacyChains) Get(id string) (Chain, error) {
 
 type chain struct {
 	services.StateMachine
-	id              *big.Int
-	cfg             *evmconfig.ChainScoped
-	client          evmclient.Client
-	txm             txmgr.TxManager
-	logger          logger.Logger
-	headBroadcaster httypes.HeadBroadcaster
-	headTracker     httypes.HeadTracker
-	logBroadcaster  log.Broadcaster
-	logPoller       logpoller.LogPoller
-	balanceMonitor  monitor.BalanceMonitor
-	keyStore        keystore.Eth
-	gasEstimator    gas.EvmFeeEstimator
+	id               *big.Int
+	cfg              *evmconfig.ChainScoped
+	client           evmclient.Client
+	txm              txmgr.TxManager
+	logger           logger.Logger
+	headBroadcaster  httypes.HeadBroadcaster
+	reorgBroadcaster httypes.ReorgBroadcaster
+	headTracker      httypes.HeadTracker
+	logBroadcaster   log.Broadcaster
+	logPoller        logpoller.LogPoller
+	balanceMonitor   monitor.BalanceMonitor
+	keyStore         keystore.Eth
+	gasEstimator     gas.EvmFeeEstimator
 }
 
 type errChainDisabled struct {
@@ -142,6 +146,8 @@ type ChainOpts struct {
 
 	MailMon      *mailbox.Monitor
 	GasEstimator gas.EvmFeeEstimator
+	// AuditLogger records top-ups sent by the balance monitor and nonce gaps healed by the transaction manager, if set
+	AuditLogger audit.AuditLogger
 
 	DS sqlutil.DataSource
 
@@ -205,6 +211,8 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 	}
 
 	headBroadcaster := headtracker.NewHeadBroadcaster(l)
+	reorgORM := headtracker.NewReorgORM(*chainID, opts.DS)
+	reorgBroadcaster := headtracker.NewReorgBroadcaster(l, reorgORM)
 	headSaver := headtracker.NullSaver
 	var headTracker httypes.HeadTracker
 	if !opts.AppConfig.EVMRPCEnabled() {
@@ -216,8 +224,8 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		} else {
 			orm = headtracker.NewNullORM()
 		}
-		headSaver = headtracker.NewHeadSaver(l, orm, cfg.EVM(), cfg.EVM().HeadTracker())
-		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, headSaver, opts.MailMon)
+		headSaver = headtracker.NewHeadSaver(l, orm, reorgORM, cfg.EVM(), cfg.EVM().HeadTracker())
+		headTracker = headtracker.NewHeadTracker(l, client, cfg.EVM(), cfg.EVM().HeadTracker(), headBroadcaster, reorgBroadcaster, headSaver, opts.MailMon)
 	} else {
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
@@ -232,6 +240,7 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 				UseFinalityTag:           cfg.EVM().FinalityTagEnabled(),
 				FinalityDepth:            int64(cfg.EVM().FinalityDepth()),
 				BackfillBatchSize:        int64(cfg.EVM().LogBackfillBatchSize()),
+				BackfillConcurrency:      int64(cfg.EVM().LogBackfillConcurrency()),
 				RpcBatchSize:             int64(cfg.EVM().RPCDefaultBatchSize()),
 				KeepFinalizedBlocksDepth: int64(cfg.EVM().LogKeepBlocksDepth()),
 				LogPrunePageSize:         int64(cfg.EVM().LogPrunePageSize()),
@@ -258,7 +267,11 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 
 	var balanceMonitor monitor.BalanceMonitor
 	if opts.AppConfig.EVMRPCEnabled() && cfg.EVM().BalanceMonitor().Enabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		bm := monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		if cfg.EVM().BalanceMonitor().TopUp().Enabled() {
+			bm.SetTopUpper(monitor.NewTopUpper(cfg.EVM().BalanceMonitor().TopUp(), txm, monitor.NewTopUpORM(opts.DS), opts.AuditLogger, chainID, cfg.EVM().GasEstimator().LimitTransfer(), l))
+		}
+		balanceMonitor = bm
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
@@ -282,18 +295,19 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 	headBroadcaster.Subscribe(logBroadcaster)
 
 	return &chain{
-		id:              chainID,
-		cfg:             cfg,
-		client:          client,
-		txm:             txm,
-		logger:          l,
-		headBroadcaster: headBroadcaster,
-		headTracker:     headTracker,
-		logBroadcaster:  logBroadcaster,
-		logPoller:       logPoller,
-		balanceMonitor:  balanceMonitor,
-		keyStore:        opts.KeyStore,
-		gasEstimator:    gasEstimator,
+		id:               chainID,
+		cfg:              cfg,
+		client:           client,
+		txm:              txm,
+		logger:           l,
+		headBroadcaster:  headBroadcaster,
+		reorgBroadcaster: reorgBroadcaster,
+		headTracker:      headTracker,
+		logBroadcaster:   logBroadcaster,
+		logPoller:        logPoller,
+		balanceMonitor:   balanceMonitor,
+		keyStore:         opts.KeyStore,
+		gasEstimator:     gasEstimator,
 	}, nil
 }
 
@@ -312,7 +326,7 @@ func (c *chain) Start(ctx context.Context) error {
 		// We do not start the log poller here, it gets
 		// started after the jobs so they have a chance to apply their filters.
 		var ms services.MultiStart
-		if err := ms.Start(ctx, c.txm, c.headBroadcaster, c.headTracker, c.logBroadcaster); err != nil {
+		if err := ms.Start(ctx, c.txm, c.headBroadcaster, c.reorgBroadcaster, c.headTracker, c.logBroadcaster); err != nil {
 			return err
 		}
 		if c.balanceMonitor != nil {
@@ -337,6 +351,8 @@ func (c *chain) Close() error {
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
 		c.logger.Debug("Chain: stopping headTracker")
 		merr = multierr.Combine(merr, c.headTracker.Close())
+		c.logger.Debug("Chain: stopping reorgBroadcaster")
+		merr = multierr.Combine(merr, c.reorgBroadcaster.Close())
 		c.logger.Debug("Chain: stopping headBroadcaster")
 		merr = multierr.Combine(merr, c.headBroadcaster.Close())
 		c.logger.Debug("Chain: stopping evmTxm")
@@ -353,6 +369,7 @@ func (c *chain) Ready() (merr error) {
 		c.StateMachine.Ready(),
 		c.txm.Ready(),
 		c.headBroadcaster.Ready(),
+		c.reorgBroadcaster.Ready(),
 		c.headTracker.Ready(),
 		c.logBroadcaster.Ready(),
 	)
@@ -370,6 +387,7 @@ func (c *chain) HealthReport() map[string]error {
 	report := map[string]error{c.Name(): c.Healthy()}
 	services.CopyHealth(report, c.txm.HealthReport())
 	services.CopyHealth(report, c.headBroadcaster.HealthReport())
+	services.CopyHealth(report, c.reorgBroadcaster.HealthReport())
 	services.CopyHealth(report, c.headTracker.HealthReport())
 	services.CopyHealth(report, c.logBroadcaster.HealthReport())
 
@@ -458,14 +476,15 @@ func (c *chain) ListNodeStatuses(ctx context.Context, pageSize int32, pageToken
 	return common.ListNodeStatuses(int(pageSize), pageToken, c.listNodeStatuses)
 }
 
-func (c *chain) ID() *big.Int                             { return c.id }
-func (c *chain) Client() evmclient.Client                 { return c.client }
-func (c *chain) Config() evmconfig.ChainScopedConfig      { return c.cfg }
-func (c *chain) LogBroadcaster() log.Broadcaster          { return c.logBroadcaster }
-func (c *chain) LogPoller() logpoller.LogPoller           { return c.logPoller }
-func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster { return c.headBroadcaster }
-func (c *chain) TxManager() txmgr.TxManager               { return c.txm }
-func (c *chain) HeadTracker() httypes.HeadTracker         { return c.headTracker }
-func (c *chain) Logger() logger.Logger                    { return c.logger }
-func (c *chain) BalanceMonitor() monitor.BalanceMonitor   { return c.balanceMonitor }
-func (c *chain) GasEstimator() gas.EvmFeeEstimator        { return c.gasEstimator }
+func (c *chain) ID() *big.Int                               { return c.id }
+func (c *chain) Client() evmclient.Client                   { return c.client }
+func (c *chain) Config() evmconfig.ChainScopedConfig        { return c.cfg }
+func (c *chain) LogBroadcaster() log.Broadcaster            { return c.logBroadcaster }
+func (c *chain) LogPoller() logpoller.LogPoller             { return c.logPoller }
+func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster   { return c.headBroadcaster }
+func (c *chain) ReorgBroadcaster() httypes.ReorgBroadcaster { return c.reorgBroadcaster }
+func (c *chain) TxManager() txmgr.TxManager                 { return c.txm }
+func (c *chain) HeadTracker() httypes.HeadTracker           { return c.headTracker }
+func (c *chain) Logger() logger.Logger                      { return c.logger }
+func (c *chain) BalanceMonitor() monitor.BalanceMonitor     { return c.balanceMonitor }
+func (c *chain) GasEstimator() gas.EvmFeeEstimator          { return c.gasEstimator }
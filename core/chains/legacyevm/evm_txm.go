@@ -66,7 +66,8 @@ func newEvmTxm(
 			logPoller,
 			opts.KeyStore,
 			estimator,
-			headTracker)
+			headTracker,
+			opts.AuditLogger)
 	} else {
 		txm = opts.GenTxManager(chainID)
 	}
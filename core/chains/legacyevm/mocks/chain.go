@@ -884,6 +884,53 @@ func (_c *Chain_Ready_Call) RunAndReturn(run func() error) *Chain_Ready_Call {
 	return _c
 }
 
+// ReorgBroadcaster provides a mock function with given fields:
+func (_m *Chain) ReorgBroadcaster() headtracker.ReorgBroadcaster[common.Hash] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorgBroadcaster")
+	}
+
+	var r0 headtracker.ReorgBroadcaster[common.Hash]
+	if rf, ok := ret.Get(0).(func() headtracker.ReorgBroadcaster[common.Hash]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(headtracker.ReorgBroadcaster[common.Hash])
+		}
+	}
+
+	return r0
+}
+
+// Chain_ReorgBroadcaster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorgBroadcaster'
+type Chain_ReorgBroadcaster_Call struct {
+	*mock.Call
+}
+
+// ReorgBroadcaster is a helper method to define mock.On call
+func (_e *Chain_Expecter) ReorgBroadcaster() *Chain_ReorgBroadcaster_Call {
+	return &Chain_ReorgBroadcaster_Call{Call: _e.mock.On("ReorgBroadcaster")}
+}
+
+func (_c *Chain_ReorgBroadcaster_Call) Run(run func()) *Chain_ReorgBroadcaster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_ReorgBroadcaster_Call) Return(_a0 headtracker.ReorgBroadcaster[common.Hash]) *Chain_ReorgBroadcaster_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_ReorgBroadcaster_Call) RunAndReturn(run func() headtracker.ReorgBroadcaster[common.Hash]) *Chain_ReorgBroadcaster_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Chain) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -131,6 +131,53 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "tokens",
+			Usage: "Create, list, or revoke your named API tokens",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists your named API tokens",
+					Action: s.ListAPITokens,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a named API token, with a role no higher than yours. Its secret is only shown once",
+					Action: s.CreateAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the new token, unique among your tokens",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "role",
+							Usage:    "Permission level of the new token. Options: 'admin', 'edit', 'run', 'view'.",
+							Required: true,
+						},
+						cli.DurationFlag{
+							Name:     "ttl",
+							Usage:    "Time until the new token expires, e.g. 720h",
+							Required: true,
+						},
+						cli.StringSliceFlag{
+							Name:  "allowed-ip",
+							Usage: "IP or CIDR range the new token can be used from, can be repeated. If unset, the token can be used from any IP",
+						},
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding your API password. If unset, the password is prompted for",
+						},
+					},
+				},
+				{
+					Name:      "revoke",
+					Usage:     "Revoke a named API token",
+					ArgsUsage: "NAME",
+					Action:    s.RevokeAPIToken,
+				},
+			},
+		},
 	}
 }
 
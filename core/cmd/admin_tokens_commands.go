@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+type UserAPITokenPresenter struct {
+	JAID
+	presenters.UserAPITokenResource
+}
+
+var userAPITokensTableHeaders = []string{"Name", "Role", "Access key", "Allowed IPs", "Expires at", "Last used", "Created at"}
+
+func (p *UserAPITokenPresenter) ToRow() []string {
+	allowedIPs := "any"
+	if len(p.AllowedIPs) > 0 {
+		allowedIPs = strings.Join(p.AllowedIPs, ", ")
+	}
+	lastUsed := "never"
+	if p.LastUsed != nil {
+		lastUsed = p.LastUsed.String()
+	}
+	return []string{
+		p.Name,
+		string(p.Role),
+		p.AccessKey,
+		allowedIPs,
+		p.ExpiresAt.String(),
+		lastUsed,
+		p.CreatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *UserAPITokenPresenter) RenderTable(rt RendererTable) error {
+	headers, row := userAPITokensTableHeaders, p.ToRow()
+	if p.Secret != "" {
+		headers = append(headers[:len(headers):len(headers)], "Secret")
+		row = append(row, p.Secret)
+	}
+
+	renderList(headers, [][]string{row}, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type UserAPITokenPresenters []UserAPITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps UserAPITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API tokens\n")); err != nil {
+		return err
+	}
+	renderList(userAPITokensTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders the named API tokens of the current user
+func (s *Shell) ListAPITokens(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/user/tokens", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &UserAPITokenPresenters{})
+}
+
+// CreateAPIToken creates a named API token for the current user, and renders it along with its secret
+func (s *Shell) CreateAPIToken(c *cli.Context) (err error) {
+	if c.Duration("ttl") <= 0 {
+		return s.errorOut(errors.New("--ttl must be positive"))
+	}
+
+	var pwd string
+	if c.IsSet("password") {
+		pwd, err = utils.PasswordFromFile(c.String("password"))
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "error reading password"))
+		}
+	} else {
+		fmt.Println("Your API password:")
+		pwd = s.PasswordPrompter.Prompt()
+	}
+
+	request := sessions.CreateAPITokenRequest{
+		Name:       c.String("name"),
+		Role:       sessions.UserRole(c.String("role")),
+		ExpiresAt:  time.Now().Add(c.Duration("ttl")),
+		AllowedIPs: c.StringSlice("allowed-ip"),
+		Password:   pwd,
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/user/tokens", buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &UserAPITokenPresenter{}, "Successfully created API token. Store its secret now, it cannot be shown again")
+}
+
+// RevokeAPIToken revokes a named API token of the current user
+func (s *Shell) RevokeAPIToken(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the API token to revoke"))
+	}
+
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/user/tokens/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &UserAPITokenPresenter{}, "Successfully revoked API token")
+}
@@ -0,0 +1,61 @@
+package cmd_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestShell_APITokens(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{
+		Password: cltest.Password,
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.CreateAPIToken, set, "")
+	require.NoError(t, set.Set("name", "ci"))
+	require.NoError(t, set.Set("role", "run"))
+	require.NoError(t, set.Set("ttl", "1h"))
+	require.NoError(t, set.Set("allowed-ip", "10.0.0.0/8"))
+	require.NoError(t, client.CreateAPIToken(cli.NewContext(nil, set, nil)))
+
+	require.Len(t, r.Renders, 1)
+	created := r.Renders[0].(*cmd.UserAPITokenPresenter)
+	assert.Equal(t, "ci", created.Name)
+	assert.Equal(t, sessions.UserRoleRun, created.Role)
+	assert.Equal(t, []string{"10.0.0.0/8"}, created.AllowedIPs)
+	assert.NotEmpty(t, created.Secret)
+
+	tokens, err := app.AuthenticationProvider().ListAPITokens(ctx, cltest.APIEmailAdmin)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, created.AccessKey, tokens[0].TokenKey)
+
+	require.NoError(t, client.ListAPITokens(cltest.EmptyCLIContext()))
+	require.Len(t, r.Renders, 2)
+	listed := *r.Renders[1].(*cmd.UserAPITokenPresenters)
+	require.Len(t, listed, 1)
+	assert.Empty(t, listed[0].Secret)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RevokeAPIToken, set, "")
+	require.NoError(t, set.Parse([]string{"ci"}))
+	require.NoError(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)))
+
+	tokens, err = app.AuthenticationProvider().ListAPITokens(ctx, cltest.APIEmailAdmin)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	require.Error(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)))
+}
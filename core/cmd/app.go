@@ -201,6 +201,7 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("Aptos", NewAptosKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
+				initKeystoreBackupSubCmd(s),
 			},
 		},
 		{
@@ -36,6 +36,18 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "replay-progress",
+			Usage:  "Shows the progress of the replay in progress",
+			Action: s.ReplayProgress,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: false,
+				},
+			},
+		},
 		{
 			Name:   "find-lca",
 			Usage:  "Find latest common block stored in DB and on chain",
@@ -90,6 +102,66 @@ func (s *Shell) ReplayFromBlock(c *cli.Context) (err error) {
 	return nil
 }
 
+// ReplayProgressPresenter implements TableRenderer for a ReplayProgressResponse.
+type ReplayProgressPresenter struct {
+	web.ReplayProgressResponse
+}
+
+// ToRow presents the ReplayProgressResponse as a slice of strings.
+func (p *ReplayProgressPresenter) ToRow() []string {
+	if !p.InProgress {
+		return []string{p.EVMChainID.String(), "false", "", "", "", "", "", ""}
+	}
+	eta := p.ETA
+	if eta == "" {
+		eta = "unknown"
+	}
+	return []string{
+		p.EVMChainID.String(),
+		"true",
+		strconv.FormatInt(p.FromBlock, 10),
+		strconv.FormatInt(p.ToBlock, 10),
+		strconv.FormatInt(p.CompletedBlock, 10),
+		strconv.FormatInt(p.RemainingBlocks, 10),
+		p.StartedAt.String(),
+		eta,
+	}
+}
+
+// RenderTable implements TableRenderer
+// Just renders a single row
+func (p ReplayProgressPresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"ChainID", "In Progress", "From Block", "To Block", "Completed Block", "Remaining Blocks", "Started At", "ETA"}, [][]string{p.ToRow()}, rt.Writer)
+
+	return nil
+}
+
+// ReplayProgress shows the progress of the replay in progress, if any.
+func (s *Shell) ReplayProgress(c *cli.Context) (err error) {
+	v := url.Values{}
+
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(),
+		fmt.Sprintf(
+			"/v2/replay_progress?%s",
+			v.Encode(),
+		))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &ReplayProgressPresenter{}, "Replay Progress")
+}
+
 // LCAPresenter implements TableRenderer for an LCAResponse.
 type LCAPresenter struct {
 	web.LCAResponse
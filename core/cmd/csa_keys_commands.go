@@ -23,8 +23,14 @@ func initCSAKeysSubCmd(s *Shell) cli.Command {
 		Usage: "Remote commands for administering the node's CSA keys",
 		Subcommands: cli.Commands{
 			{
-				Name:   "create",
-				Usage:  format(`Create a CSA key, encrypted with password from the password file, and store it in the database.`),
+				Name:  "create",
+				Usage: format(`Create a CSA key, encrypted with password from the password file, and store it in the database.`),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "signer-key-id",
+						Usage: "Optional ID of a key held by the remote signer, to add instead of generating a new key.",
+					},
+				},
 				Action: s.CreateCSAKey,
 			},
 			{
@@ -122,8 +128,12 @@ func (s *Shell) ListCSAKeys(_ *cli.Context) (err error) {
 }
 
 // CreateCSAKey creates a new CSA key
-func (s *Shell) CreateCSAKey(_ *cli.Context) (err error) {
-	resp, err := s.HTTP.Post(s.ctx(), "/v2/keys/csa", nil)
+func (s *Shell) CreateCSAKey(c *cli.Context) (err error) {
+	createUrl := url.URL{Path: "/v2/keys/csa"}
+	if c.IsSet("signer-key-id") {
+		createUrl.RawQuery = url.Values{"signerKeyID": {c.String("signer-key-id")}}.Encode()
+	}
+	resp, err := s.HTTP.Post(s.ctx(), createUrl.String(), nil)
 	if err != nil {
 		return s.errorOut(err)
 	}
@@ -38,6 +38,10 @@ func initEthKeysSubCmd(s *Shell) cli.Command {
 						Name:  "max-gas-price-gwei, maxGasPriceGWei",
 						Usage: "Optional maximum gas price (GWei) for the creating key.",
 					},
+					cli.StringFlag{
+						Name:  "signer-key-id",
+						Usage: "Optional ID of a key held by the remote signer, to add instead of generating a new key.",
+					},
 				},
 			},
 			{
@@ -204,6 +208,9 @@ func (s *Shell) CreateETHKey(c *cli.Context) (err error) {
 	if c.IsSet("max-gas-price-gwei") {
 		query.Set("maxGasPriceGWei", c.String("max-gas-price-gwei"))
 	}
+	if c.IsSet("signer-key-id") {
+		query.Set("signerKeyID", c.String("signer-key-id"))
+	}
 
 	createUrl.RawQuery = query.Encode()
 	resp, err := s.HTTP.Post(s.ctx(), createUrl.String(), nil)
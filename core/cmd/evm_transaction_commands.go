@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
+	"net/url"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -62,6 +64,22 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "heal",
+				Usage:  "Fill nonce gaps of a node ETH account by sending transactions to self",
+				Action: s.HealNonceGaps,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "address",
+						Usage:    "address of the key whose nonce gaps should be filled",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:  "evm-chain-id, evmChainID",
+						Usage: "chain ID of the key",
+					},
+				},
+			},
 		},
 	}
 }
@@ -131,6 +149,56 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+type NonceGapHealPresenter struct {
+	JAID
+	presenters.NonceGapHealResource
+}
+
+type NonceGapHealPresenters []NonceGapHealPresenter
+
+// RenderTable implements TableRenderer
+func (ps NonceGapHealPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Address", "Nonce", "Hash", "Fee"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.From.Hex(),
+			p.Nonce,
+			p.Hash.Hex(),
+			p.Fee,
+		})
+	}
+
+	render("Healed Nonce Gaps", table)
+	return nil
+}
+
+// HealNonceGaps fills the nonce gaps of the given address by sending transactions to self
+func (s *Shell) HealNonceGaps(c *cli.Context) (err error) {
+	healURL := url.URL{Path: "/v2/transactions/evm/heal"}
+	query := healURL.Query()
+	query.Set("address", c.String("address"))
+	if c.IsSet("evm-chain-id") {
+		query.Set("evmChainID", c.String("evm-chain-id"))
+	}
+	healURL.RawQuery = query.Encode()
+
+	resp, err := s.HTTP.Post(s.ctx(), healURL.String(), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error healing nonce gaps: %w", httpError(resp)))
+	}
+
+	return s.renderAPIResponse(resp, &NonceGapHealPresenters{})
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -45,11 +47,68 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Delete a job",
 			Action: s.DeleteJob,
 		},
+		{
+			Name:   "pause",
+			Usage:  "Stop the services of a job without deleting it",
+			Action: s.PauseJob,
+		},
+		{
+			Name:   "resume",
+			Usage:  "Start the services of a paused job",
+			Action: s.ResumeJob,
+		},
 		{
 			Name:   "run",
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Simulate a run of an existing job (by ID) or a job spec (TOML or filepath) without saving it, using stubbed responses for http, bridge, ethcall and ethtx tasks",
+			Action: s.SimulatePipelineRun,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "stubs",
+					Usage: `JSON or filepath mapping task names to a stubbed response, e.g. {"ds1": {"value": "{\"price\": 1}"}, "ds2": {"error": "timeout"}}`,
+				},
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON or filepath with the variables to start the run with",
+				},
+			},
+		},
+		{
+			Name:   "versions",
+			Usage:  "List the spec versions of a job",
+			Action: s.ListJobVersions,
+		},
+		{
+			Name:   "diff",
+			Usage:  "Show the diff between two spec versions of a job",
+			Action: s.DiffJobVersions,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "from",
+					Usage: "version to diff from, defaults to the version before --to",
+				},
+				cli.IntFlag{
+					Name:  "to",
+					Usage: "version to diff to, defaults to the latest version",
+				},
+			},
+		},
+		{
+			Name:   "rollback",
+			Usage:  "Replace a job with the spec of one of its versions",
+			Action: s.RollbackJob,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:     "version",
+					Usage:    "the version to roll back to",
+					Required: true,
+				},
+			},
+		},
 	}
 }
 
@@ -294,6 +353,42 @@ func (s *Shell) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PauseJob stops the services of a job until it is resumed
+func (s *Shell) PauseJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to be paused"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/pause", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Job paused")
+}
+
+// ResumeJob starts the services of a paused job
+func (s *Shell) ResumeJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id to be resumed"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/resume", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, "Job resumed")
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -313,3 +408,193 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineSimulationPresenter wraps the JSONAPI Pipeline Run Resource of a simulated run
+type PipelineSimulationPresenter struct {
+	presenters.PipelineRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		var output, errStr string
+		if tr.Output != nil {
+			output = *tr.Output
+		}
+		if tr.Error != nil {
+			errStr = *tr.Error
+		}
+		table.Append([]string{tr.DotID, string(tr.Type), output, errStr})
+	}
+	render("Simulated Task Runs", table)
+
+	var outputs []string
+	for _, o := range p.Outputs {
+		if o != nil {
+			outputs = append(outputs, *o)
+		}
+	}
+	var fatalErrors []string
+	for _, e := range p.FatalErrors {
+		if e != nil {
+			fatalErrors = append(fatalErrors, *e)
+		}
+	}
+	table = rt.newTable([]string{"Outputs", "Fatal Errors"})
+	table.Append([]string{strings.Join(outputs, "\n"), strings.Join(fatalErrors, "\n")})
+	render("Simulated Run", table)
+	return nil
+}
+
+// SimulatePipelineRun runs a job's pipeline with stubbed I/O and renders the task results, without persisting the run
+func (s *Shell) SimulatePipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the job id, or TOML or filepath of a job spec"))
+	}
+
+	var request web.SimulatePipelineRunRequest
+	if stubs := c.String("stubs"); stubs != "" {
+		buf, ferr := getBufferFromJSON(stubs)
+		if ferr != nil {
+			return s.errorOut(ferr)
+		}
+		if err = json.Unmarshal(buf.Bytes(), &request.Stubs); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to parse stubs"))
+		}
+	}
+	if vars := c.String("vars"); vars != "" {
+		buf, ferr := getBufferFromJSON(vars)
+		if ferr != nil {
+			return s.errorOut(ferr)
+		}
+		if err = json.Unmarshal(buf.Bytes(), &request.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to parse vars"))
+		}
+	}
+
+	path := "/v2/pipeline/simulate"
+	arg := c.Args().First()
+	if _, perr := strconv.ParseInt(arg, 10, 32); perr == nil {
+		path = "/v2/jobs/" + arg + "/simulate"
+	} else {
+		request.TOML, err = getTOMLString(arg)
+		if err != nil {
+			return s.errorOut(err)
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), path, bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineSimulationPresenter{})
+}
+
+// JobSpecVersionPresenters wraps the JSONAPI Job Spec Version Resources and adds rendering functionality
+type JobSpecVersionPresenters []presenters.JobSpecVersionResource
+
+// RenderTable implements TableRenderer
+func (ps JobSpecVersionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Version", "Author", "Created At"})
+	for _, p := range ps {
+		table.Append([]string{
+			strconv.Itoa(int(p.Version)),
+			p.Author,
+			p.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	render("Job Spec Versions", table)
+	return nil
+}
+
+// JobSpecVersionDiffPresenter wraps the JSONAPI Job Spec Version Diff Resource and adds rendering functionality
+type JobSpecVersionDiffPresenter struct {
+	presenters.JobSpecVersionDiffResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSpecVersionDiffPresenter) RenderTable(rt RendererTable) error {
+	_, err := fmt.Fprintf(rt, "Job %d: version %d -> %d\n%s\n", p.JobID, p.From, p.To, p.Diff)
+	return err
+}
+
+// ListJobVersions lists the spec versions of a job
+func (s *Shell) ListJobVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().First()+"/versions")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobSpecVersionPresenters{})
+}
+
+// DiffJobVersions displays the diff between two spec versions of a job
+func (s *Shell) DiffJobVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	query := url.Values{}
+	if c.IsSet("from") {
+		query.Set("from", strconv.Itoa(c.Int("from")))
+	}
+	if c.IsSet("to") {
+		query.Set("to", strconv.Itoa(c.Int("to")))
+	}
+	path := "/v2/jobs/" + c.Args().First() + "/versions/diff"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobSpecVersionDiffPresenter{})
+}
+
+// RollbackJob replaces a job with the spec of one of its versions
+func (s *Shell) RollbackJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	request, err := json.Marshal(web.RollbackJobRequest{Version: int32(c.Int("version"))})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/"+c.Args().First()+"/rollback", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &JobPresenter{}, fmt.Sprintf("Job rolled back to version %d", c.Int("version")))
+}
@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeystoreBackupSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "backup",
+		Usage: "Remote commands for backing up every key of the node at once. Backups are restored with \"node restore-keystore-backup\"",
+		Subcommands: cli.Commands{
+			{
+				Name:  "export",
+				Usage: format(`Exports every key, and the chains each ETH key is enabled for, to an encrypted JSON file`),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "new-password, newpassword, p",
+						Usage: "`FILE` containing the password to encrypt the backup (required unless --share-password is set)",
+					},
+					cli.StringFlag{
+						Name:  "output, o",
+						Usage: "`FILE` where the JSON backup will be saved (required). With --share-password, share N is saved to FILE.share-N",
+					},
+					cli.StringSliceFlag{
+						Name:  "share-password",
+						Usage: "`FILE` containing the password of a share holder, repeated once per holder. Encrypts the backup with a random secret split into one Shamir share per holder, each encrypted with their password, instead of a password",
+					},
+					cli.IntFlag{
+						Name:  "threshold",
+						Usage: "number of shares required to restore the backup (required with --share-password)",
+					},
+				},
+				Action: s.ExportKeystoreBackup,
+			},
+		},
+	}
+}
+
+// ExportKeystoreBackup exports every key of the node to a single encrypted file
+func (s *Shell) ExportKeystoreBackup(c *cli.Context) (err error) {
+	if c.IsSet("share-password") {
+		return s.exportKeystoreBackupShares(c)
+	}
+
+	newPasswordFile := c.String("new-password")
+	if len(newPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --new-password/-p flag"))
+	}
+	newPassword, err := os.ReadFile(newPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return s.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	exportURL := url.URL{Path: "/v2/keys/backup/export"}
+	query := exportURL.Query()
+	query.Set("newpassword", strings.TrimSpace(string(newPassword)))
+	exportURL.RawQuery = query.Encode()
+
+	resp, err := s.HTTP.Post(s.ctx(), exportURL.String(), nil)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error exporting: %w", httpError(resp)))
+	}
+
+	backup, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+
+	err = utils.WriteFileWithMaxPerms(filepath, backup, 0o600)
+	if err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString("🔑 Exported keystore backup to " + filepath + "\n")
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	return nil
+}
+
+// exportKeystoreBackupShares exports every key of the node to a file encrypted with a secret, and the shares of the
+// secret, each encrypted with the password of its holder, to one file each
+func (s *Shell) exportKeystoreBackupShares(c *cli.Context) (err error) {
+	if c.IsSet("new-password") {
+		return s.errorOut(errors.New("Cannot specify both --new-password/-p and --share-password flags"))
+	}
+	if !c.IsSet("threshold") {
+		return s.errorOut(errors.New("Must specify --threshold flag with --share-password"))
+	}
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return s.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+	passwords, err := readSharePasswords(c.StringSlice("share-password"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	body, err := json.Marshal(web.ExportKeystoreBackupSharesRequest{
+		Passwords: passwords,
+		Threshold: c.Int("threshold"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/keys/backup/export-shares", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error exporting: %w", httpError(resp)))
+	}
+
+	var export presenters.KeystoreBackupShares
+	if err = json.NewDecoder(resp.Body).Decode(&export); err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not parse response body"))
+	}
+
+	if err = utils.WriteFileWithMaxPerms(filepath, export.Backup, 0o600); err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+	for i, share := range export.Shares {
+		sharePath := fmt.Sprintf("%s.share-%d", filepath, i+1)
+		if err = utils.WriteFileWithMaxPerms(sharePath, share, 0o600); err != nil {
+			return s.errorOut(errors.Wrapf(err, "Could not write %v", sharePath))
+		}
+	}
+
+	msg := fmt.Sprintf("🔑 Exported keystore backup to %s, and its %d shares to %s.share-1 to %s.share-%d. "+
+		"Share N is encrypted with the Nth --share-password: hand it to the holder of that password. "+
+		"Any %d of them can restore the backup\n",
+		filepath, len(export.Shares), filepath, filepath, len(export.Shares), c.Int("threshold"))
+	_, err = os.Stderr.WriteString(msg)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	return nil
+}
+
+// readSharePasswords reads the password of each share holder from passwordPaths
+func readSharePasswords(passwordPaths []string) ([]string, error) {
+	passwords := make([]string, len(passwordPaths))
+	for i, passwordPath := range passwordPaths {
+		password, err := utils.PasswordFromFile(passwordPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not read share password file %v", passwordPath)
+		}
+		passwords[i] = password
+	}
+	return passwords, nil
+}
+
+// readSharesWithPasswords reads each share from sharePaths, and its password from passwordPaths, in the same order
+func readSharesWithPasswords(sharePaths, passwordPaths []string) ([][]byte, []string, error) {
+	if len(passwordPaths) != len(sharePaths) {
+		return nil, nil, errors.Errorf("Must specify one --share-password flag per --share flag, got %d for %d shares", len(passwordPaths), len(sharePaths))
+	}
+	shares := make([][]byte, len(sharePaths))
+	for i, sharePath := range sharePaths {
+		share, err := os.ReadFile(sharePath)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "Could not read share file %v", sharePath)
+		}
+		shares[i] = share
+	}
+	passwords, err := readSharePasswords(passwordPaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shares, passwords, nil
+}
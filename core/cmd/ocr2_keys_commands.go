@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 
 	"github.com/pkg/errors"
@@ -27,6 +28,20 @@ func initOCR2KeysSubCmd(s *Shell) cli.Command {
 				Name:   "create",
 				Usage:  format(`Create an OCR2 key bundle, encrypted with password from the password file, and store it in the database`),
 				Action: s.CreateOCR2KeyBundle,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "onchain-signer-key-id",
+						Usage: "ID of the secp256k1 onchain signing key held by the remote signer (evm only)",
+					},
+					cli.StringFlag{
+						Name:  "offchain-signer-key-id",
+						Usage: "ID of the ed25519 offchain signing key held by the remote signer (evm only)",
+					},
+					cli.StringFlag{
+						Name:  "config-encryption-signer-key-id",
+						Usage: "ID of the X25519 config encryption key held by the remote signer (evm only)",
+					},
+				},
 			},
 			{
 				Name:  "delete",
@@ -149,7 +164,19 @@ func (s *Shell) CreateOCR2KeyBundle(c *cli.Context) error {
 		)
 	}
 	chainType := c.Args().Get(0)
-	resp, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/keys/ocr2/%s", chainType), nil)
+	createURL := url.URL{Path: fmt.Sprintf("/v2/keys/ocr2/%s", chainType)}
+	query := createURL.Query()
+	if c.IsSet("onchain-signer-key-id") {
+		query.Set("onchainSigningKeyID", c.String("onchain-signer-key-id"))
+	}
+	if c.IsSet("offchain-signer-key-id") {
+		query.Set("offchainSigningKeyID", c.String("offchain-signer-key-id"))
+	}
+	if c.IsSet("config-encryption-signer-key-id") {
+		query.Set("configEncryptionKeyID", c.String("config-encryption-signer-key-id"))
+	}
+	createURL.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), createURL.String(), nil)
 	if err != nil {
 		return s.errorOut(err)
 	}
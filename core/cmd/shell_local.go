@@ -42,6 +42,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -119,6 +120,50 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "rotate-keystore-password",
+			Usage:  "Re-encrypt the keystore with a new password, in a single database transaction. Stop the node before running this command, and update its password file afterwards",
+			Action: s.RotateKeystorePassword,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "password, p",
+					Usage: "text file holding the current keystore password. If left blank, the configured keystore password will be used",
+				},
+				cli.StringFlag{
+					Name:     "new-password, n",
+					Usage:    "text file holding the new keystore password",
+					Required: true,
+				},
+				cli.IntFlag{
+					Name:  "scrypt-n",
+					Usage: "OPTIONAL: scrypt N (CPU/memory cost) parameter to encrypt the keystore with, must be a power of 2",
+				},
+				cli.IntFlag{
+					Name:  "scrypt-p",
+					Usage: "OPTIONAL: scrypt P (parallelization) parameter to encrypt the keystore with",
+				},
+			},
+		},
+		{
+			Name:      "restore-keystore-backup",
+			Usage:     "Restore a backup created with 'keys backup export' into the keystore, which must not hold any key, in a single database transaction. Run this command before starting a new node for the first time",
+			ArgsUsage: "BACKUP_FILE",
+			Action:    s.RestoreKeystoreBackup,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "backup-password, p",
+					Usage: "text file holding the password the backup was encrypted with. Required unless --share is set",
+				},
+				cli.StringSliceFlag{
+					Name:  "share",
+					Usage: "text file holding a share of the secret the backup was encrypted with, repeated for a quorum of shares",
+				},
+				cli.StringSliceFlag{
+					Name:  "share-password",
+					Usage: "text file holding the password of a share, repeated once per --share, in the same order",
+				},
+			},
+		},
 		{
 			Name:   "status",
 			Usage:  "Displays the health of various services running inside the node.",
@@ -1226,6 +1271,170 @@ func insertFixtures(dbURL url.URL, pathToFixtures string) (err error) {
 	return err
 }
 
+// RotateKeystorePassword re-encrypts the keystore with a new password and, optionally, new scrypt params
+func (s *Shell) RotateKeystorePassword(c *cli.Context) error {
+	cfg := s.Config
+	err := cfg.Validate()
+	if err != nil {
+		return s.errorOut(fmt.Errorf("error validating configuration: %+v", err))
+	}
+
+	oldPassword := cfg.Password().Keystore()
+	if c.IsSet("password") {
+		oldPassword, err = utils.PasswordFromFile(c.String("password"))
+		if err != nil {
+			return s.errorOut(fmt.Errorf("error reading password: %+v", err))
+		}
+	}
+	if oldPassword == "" {
+		return s.errorOut(errors.New("the current keystore password must be configured or passed with '--password'"))
+	}
+	newPassword, err := utils.PasswordFromFile(c.String("new-password"))
+	if err != nil {
+		return s.errorOut(fmt.Errorf("error reading new password: %+v", err))
+	}
+	if err = utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return s.errorOut(errors.Wrap(err, "new password does not meet the complexity requirements"))
+	}
+	if newPassword == oldPassword {
+		return s.errorOut(errors.New("new password must be different from the current one"))
+	}
+
+	scryptParams := utils.GetScryptParams(cfg)
+	if c.IsSet("scrypt-n") {
+		scryptParams.N = c.Int("scrypt-n")
+	}
+	if c.IsSet("scrypt-p") {
+		scryptParams.P = c.Int("scrypt-p")
+	}
+	if scryptParams.N <= 1 || scryptParams.N&(scryptParams.N-1) != 0 {
+		return s.errorOut(fmt.Errorf("invalid scrypt N %d: must be a power of 2 greater than 1", scryptParams.N))
+	}
+	if scryptParams.P <= 0 {
+		return s.errorOut(fmt.Errorf("invalid scrypt P %d: must be positive", scryptParams.P))
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("RotateKeystorePassword"))
+	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go shutdown.HandleShutdown(func(sig string) {
+		cancel()
+		lggr.Info("received signal to stop - closing the database and releasing lock")
+
+		if cErr := ldb.Close(); cErr != nil {
+			lggr.Criticalf("Failed to close LockedDB: %v", cErr)
+		}
+
+		if cErr := s.CloseLogger(); cErr != nil {
+			log.Printf("Failed to close Logger: %v", cErr)
+		}
+	})
+
+	if err = ldb.Open(ctx); err != nil {
+		// If not successful, we know neither locks nor connection remains opened
+		return s.errorOut(errors.Wrap(err, "opening db"))
+	}
+	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
+
+	app, err := s.AppFactory.NewApplication(ctx, s.Config, s.Logger, ldb.DB())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "fatal error instantiating application"))
+	}
+
+	if err = app.GetKeyStore().RotatePassword(ctx, oldPassword, newPassword, scryptParams); err != nil {
+		return s.errorOut(err)
+	}
+
+	lggr.Infow("RotateKeystorePassword: successfully re-encrypted the keystore, update the keystore password file before starting the node",
+		"scryptN", scryptParams.N, "scryptP", scryptParams.P)
+	return nil
+}
+
+// RestoreKeystoreBackup restores a keystore backup into the empty keystore of a stopped node
+func (s *Shell) RestoreKeystoreBackup(c *cli.Context) error {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the path of the backup file"))
+	}
+	cfg := s.Config
+	err := cfg.Validate()
+	if err != nil {
+		return s.errorOut(fmt.Errorf("error validating configuration: %+v", err))
+	}
+	keystorePassword := cfg.Password().Keystore()
+	if keystorePassword == "" {
+		return s.errorOut(errors.New("the keystore password must be configured"))
+	}
+	var backupPassword string
+	var shares [][]byte
+	var sharePasswords []string
+	switch {
+	case c.IsSet("backup-password") && c.IsSet("share"):
+		return s.errorOut(errors.New("must specify either --backup-password or --share, not both"))
+	case c.IsSet("share"):
+		shares, sharePasswords, err = readSharesWithPasswords(c.StringSlice("share"), c.StringSlice("share-password"))
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "error reading backup shares"))
+		}
+	case c.IsSet("backup-password"):
+		backupPassword, err = utils.PasswordFromFile(c.String("backup-password"))
+		if err != nil {
+			return s.errorOut(fmt.Errorf("error reading backup password: %+v", err))
+		}
+	default:
+		return s.errorOut(errors.New("must specify either --backup-password or --share"))
+	}
+	backup, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error reading backup file"))
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("RestoreKeystoreBackup"))
+	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go shutdown.HandleShutdown(func(sig string) {
+		cancel()
+		lggr.Info("received signal to stop - closing the database and releasing lock")
+
+		if cErr := ldb.Close(); cErr != nil {
+			lggr.Criticalf("Failed to close LockedDB: %v", cErr)
+		}
+
+		if cErr := s.CloseLogger(); cErr != nil {
+			log.Printf("Failed to close Logger: %v", cErr)
+		}
+	})
+
+	if err = ldb.Open(ctx); err != nil {
+		// If not successful, we know neither locks nor connection remains opened
+		return s.errorOut(errors.Wrap(err, "opening db"))
+	}
+	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
+
+	app, err := s.AppFactory.NewApplication(ctx, s.Config, s.Logger, ldb.DB())
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "fatal error instantiating application"))
+	}
+
+	keyStore := app.GetKeyStore()
+	if err = keyStore.Unlock(ctx, keystorePassword); err != nil {
+		return s.errorOut(errors.Wrap(err, "error unlocking keystore"))
+	}
+	var summary keystore.BackupSummary
+	if len(shares) > 0 {
+		summary, err = keyStore.RestoreBackupFromShares(ctx, backup, shares, sharePasswords)
+	} else {
+		summary, err = keyStore.RestoreBackup(ctx, backup, backupPassword)
+	}
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	lggr.Infof("RestoreKeystoreBackup: restored %s", summary)
+	return nil
+}
+
 // RemoveBlocks - removes blocks after the specified blocks number
 func (s *Shell) RemoveBlocks(c *cli.Context) error {
 	start := c.Int64("start")
@@ -51,6 +51,7 @@ type AppConfig interface {
 	Password() Password
 	Prometheus() Prometheus
 	Pyroscope() Pyroscope
+	RemoteSigner() RemoteSigner
 	Sentry() Sentry
 	TelemetryIngress() TelemetryIngress
 	Threshold() Threshold
@@ -54,7 +54,8 @@ func TestDoc(t *testing.T) {
 		// clean up KeySpecific as a special case
 		require.Equal(t, 1, len(docDefaults.KeySpecific))
 		ks := evmcfg.KeySpecific{Key: new(types.EIP55Address),
-			GasEstimator: evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)}}
+			GasEstimator:   evmcfg.KeySpecificGasEstimator{PriceMax: new(assets.Wei)},
+			BalanceMonitor: evmcfg.KeySpecificBalanceMonitor{TopUpThreshold: new(assets.Wei), TopUpTarget: new(assets.Wei)}}
 		require.Equal(t, ks, docDefaults.KeySpecific[0])
 		docDefaults.KeySpecific = nil
 
@@ -100,6 +101,13 @@ func TestDoc(t *testing.T) {
 		// GasEstimator.DAOracle.OracleAddress is only set if DA oracle config is used
 		docDefaults.GasEstimator.DAOracle.OracleAddress = nil
 
+		// GasEstimator.Composite.Weights is only set if Weighted aggregation is used
+		docDefaults.GasEstimator.Composite.Weights = nil
+
+		// BalanceMonitor.TopUp.TreasuryKey is only set if top-ups are used
+		require.Empty(t, docDefaults.BalanceMonitor.TopUp.TreasuryKey)
+		docDefaults.BalanceMonitor.TopUp.TreasuryKey = nil
+
 		assertTOML(t, fallbackDefaults, docDefaults)
 	})
 
@@ -16,4 +16,23 @@ type JobPipeline interface {
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
 	VerboseLogging() bool
+	Export() JobPipelineExport
+	Limits() JobPipelineLimits
+}
+
+type JobPipelineExport interface {
+	NDJSONPath() string
+	HTTPURL() string
+	BatchSize() uint32
+	FlushInterval() time.Duration
+	QueueDepth() uint32
+}
+
+type JobPipelineLimits interface {
+	MaxConcurrentRuns() uint32
+	MaxRunsPerMinute() uint32
+	MaxTaskTimePerMinute() time.Duration
+	Mode() string
+	MaxQueuedRuns() uint32
+	ExemptJobTypes() []string
 }
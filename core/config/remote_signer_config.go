@@ -0,0 +1,13 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+type RemoteSigner interface {
+	// URL of the remote signer, or nil if keys are only held locally.
+	URL() *url.URL
+	Timeout() time.Duration
+	AuthToken() string
+}
@@ -60,6 +60,7 @@ type Core struct {
 	Mercury          Mercury          `toml:",omitempty"`
 	Capabilities     Capabilities     `toml:",omitempty"`
 	Telemetry        Telemetry        `toml:",omitempty"`
+	RemoteSigner     RemoteSigner     `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -97,6 +98,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.Insecure.setFrom(&f.Insecure)
 	c.Tracing.setFrom(&f.Tracing)
 	c.Telemetry.setFrom(&f.Telemetry)
+	c.RemoteSigner.setFrom(&f.RemoteSigner)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -119,13 +121,14 @@ func (c *Core) ValidateConfig() (err error) {
 }
 
 type Secrets struct {
-	Database   DatabaseSecrets          `toml:",omitempty"`
-	Password   Passwords                `toml:",omitempty"`
-	WebServer  WebServerSecrets         `toml:",omitempty"`
-	Pyroscope  PyroscopeSecrets         `toml:",omitempty"`
-	Prometheus PrometheusSecrets        `toml:",omitempty"`
-	Mercury    MercurySecrets           `toml:",omitempty"`
-	Threshold  ThresholdKeyShareSecrets `toml:",omitempty"`
+	Database     DatabaseSecrets          `toml:",omitempty"`
+	Password     Passwords                `toml:",omitempty"`
+	WebServer    WebServerSecrets         `toml:",omitempty"`
+	Pyroscope    PyroscopeSecrets         `toml:",omitempty"`
+	Prometheus   PrometheusSecrets        `toml:",omitempty"`
+	Mercury      MercurySecrets           `toml:",omitempty"`
+	Threshold    ThresholdKeyShareSecrets `toml:",omitempty"`
+	RemoteSigner RemoteSignerSecrets      `toml:",omitempty"`
 }
 
 func dbURLPasswordComplexity(err error) string {
@@ -874,6 +877,8 @@ type JobPipeline struct {
 	VerboseLogging            *bool
 
 	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	Export      JobPipelineExport      `toml:",omitempty"`
+	Limits      JobPipelineLimits      `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -899,6 +904,8 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.VerboseLogging = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.Export.setFrom(&f.Export)
+	j.Limits.setFrom(&f.Limits)
 }
 
 type JobPipelineHTTPRequest struct {
@@ -915,6 +922,93 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobPipelineExport struct {
+	NDJSONPath    *string
+	HTTPURL       *string
+	BatchSize     *uint32
+	FlushInterval *commonconfig.Duration
+	QueueDepth    *uint32
+}
+
+func (j *JobPipelineExport) setFrom(f *JobPipelineExport) {
+	if v := f.NDJSONPath; v != nil {
+		j.NDJSONPath = v
+	}
+	if v := f.HTTPURL; v != nil {
+		j.HTTPURL = v
+	}
+	if v := f.BatchSize; v != nil {
+		j.BatchSize = v
+	}
+	if v := f.FlushInterval; v != nil {
+		j.FlushInterval = v
+	}
+	if v := f.QueueDepth; v != nil {
+		j.QueueDepth = v
+	}
+}
+
+func (j *JobPipelineExport) ValidateConfig() (err error) {
+	if j.HTTPURL != nil && *j.HTTPURL != "" {
+		if u, perr := url.Parse(*j.HTTPURL); perr != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "HTTPURL", Value: *j.HTTPURL, Msg: "must be an http or https URL"})
+		}
+	}
+	if j.BatchSize != nil && *j.BatchSize == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "BatchSize", Value: 0, Msg: "must be greater than zero"})
+	}
+	if j.FlushInterval != nil && j.FlushInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "FlushInterval", Value: j.FlushInterval.String(), Msg: "must be greater than zero"})
+	}
+	return
+}
+
+// Run limit modes for JobPipelineLimits.Mode
+const (
+	JobPipelineLimitsModeQueue  = "queue"
+	JobPipelineLimitsModeReject = "reject"
+)
+
+type JobPipelineLimits struct {
+	MaxConcurrentRuns    *uint32
+	MaxRunsPerMinute     *uint32
+	MaxTaskTimePerMinute *commonconfig.Duration
+	Mode                 *string
+	MaxQueuedRuns        *uint32
+	ExemptJobTypes       *[]string
+}
+
+func (j *JobPipelineLimits) setFrom(f *JobPipelineLimits) {
+	if v := f.MaxConcurrentRuns; v != nil {
+		j.MaxConcurrentRuns = v
+	}
+	if v := f.MaxRunsPerMinute; v != nil {
+		j.MaxRunsPerMinute = v
+	}
+	if v := f.MaxTaskTimePerMinute; v != nil {
+		j.MaxTaskTimePerMinute = v
+	}
+	if v := f.Mode; v != nil {
+		j.Mode = v
+	}
+	if v := f.MaxQueuedRuns; v != nil {
+		j.MaxQueuedRuns = v
+	}
+	if v := f.ExemptJobTypes; v != nil {
+		j.ExemptJobTypes = v
+	}
+}
+
+func (j *JobPipelineLimits) ValidateConfig() (err error) {
+	if j.Mode != nil && *j.Mode != JobPipelineLimitsModeQueue && *j.Mode != JobPipelineLimitsModeReject {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Mode", Value: *j.Mode, Msg: fmt.Sprintf("must be %q or %q", JobPipelineLimitsModeQueue, JobPipelineLimitsModeReject)})
+	}
+	if j.MaxTaskTimePerMinute != nil && j.MaxTaskTimePerMinute.Duration() < 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "MaxTaskTimePerMinute", Value: j.MaxTaskTimePerMinute.String(), Msg: "must not be negative"})
+	}
+	return
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
@@ -1709,6 +1803,58 @@ func (b *Telemetry) ValidateConfig() (err error) {
 	return err
 }
 
+type RemoteSigner struct {
+	URL     *commonconfig.URL
+	Timeout *commonconfig.Duration
+}
+
+func (r *RemoteSigner) setFrom(f *RemoteSigner) {
+	if v := f.URL; v != nil {
+		r.URL = v
+	}
+	if v := f.Timeout; v != nil {
+		r.Timeout = v
+	}
+}
+
+func (r *RemoteSigner) ValidateConfig() (err error) {
+	if r.URL == nil || r.URL.String() == "" {
+		return nil
+	}
+	if scheme := r.URL.Scheme; scheme != "http" && scheme != "https" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "URL", Value: r.URL.String(), Msg: "must be an http or https URL"})
+	}
+	if r.Timeout != nil && r.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Timeout", Value: r.Timeout.String(), Msg: "must be positive"})
+	}
+	return err
+}
+
+type RemoteSignerSecrets struct {
+	AuthToken *models.Secret
+}
+
+func (r *RemoteSignerSecrets) SetFrom(f *RemoteSignerSecrets) (err error) {
+	err = r.validateMerge(f)
+	if err != nil {
+		return err
+	}
+
+	if v := f.AuthToken; v != nil {
+		r.AuthToken = v
+	}
+
+	return nil
+}
+
+func (r *RemoteSignerSecrets) validateMerge(f *RemoteSignerSecrets) (err error) {
+	if r.AuthToken != nil && f.AuthToken != nil {
+		err = multierr.Append(err, configutils.ErrOverride{Name: "AuthToken"})
+	}
+
+	return err
+}
+
 var hostnameRegex = regexp.MustCompile(`^[a-zA-Z0-9-]+(\.[a-zA-Z0-9-]+)*$`)
 
 // Validates uri is valid external or local URI
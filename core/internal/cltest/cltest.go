@@ -1183,6 +1183,11 @@ func UnauthenticatedGet(t testing.TB, url string, headers map[string]string) (*h
 	return unauthenticatedHTTP(t, "GET", url, nil, headers)
 }
 
+func UnauthenticatedDelete(t testing.TB, url string, headers map[string]string) (*http.Response, func()) {
+	t.Helper()
+	return unauthenticatedHTTP(t, "DELETE", url, nil, headers)
+}
+
 func unauthenticatedHTTP(t testing.TB, method string, url string, body io.Reader, headers map[string]string) (*http.Response, func()) {
 	t.Helper()
 
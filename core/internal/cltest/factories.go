@@ -164,15 +164,19 @@ func NewLegacyTransaction(nonce uint64, to common.Address, value *big.Int, gasLi
 func MustInsertUnconfirmedEthTx(t *testing.T, txStore txmgr.TestEvmTxStore, nonce int64, fromAddress common.Address, opts ...interface{}) txmgr.Tx {
 	broadcastAt := time.Now()
 	chainID := &FixtureChainID
+	priority := txmgrtypes.TxPriorityNormal
 	for _, opt := range opts {
 		switch v := opt.(type) {
 		case time.Time:
 			broadcastAt = v
 		case *big.Int:
 			chainID = v
+		case txmgrtypes.TxPriority:
+			priority = v
 		}
 	}
 	etx := NewEthTx(fromAddress)
+	etx.Priority = priority
 
 	etx.BroadcastAt = &broadcastAt
 	etx.InitialBroadcastAt = &broadcastAt
@@ -1153,6 +1153,53 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type Application_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) PauseJob(ctx interface{}, jobID interface{}) *Application_PauseJob_Call {
+	return &Application_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, jobID)}
+}
+
+func (_c *Application_PauseJob_Call) Run(run func(ctx context.Context, jobID int32)) *Application_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_PauseJob_Call) Return(_a0 error) *Application_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *Application_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1248,6 +1295,172 @@ func (_c *Application_ReplayFromBlock_Call) RunAndReturn(run func(*big.Int, uint
 	return _c
 }
 
+// ReplayPipelineRun provides a mock function with given fields: ctx, runID, dotDagSource
+func (_m *Application) ReplayPipelineRun(ctx context.Context, runID int64, dotDagSource string) (*pipeline.RunReplay, error) {
+	ret := _m.Called(ctx, runID, dotDagSource)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayPipelineRun")
+	}
+
+	var r0 *pipeline.RunReplay
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*pipeline.RunReplay, error)); ok {
+		return rf(ctx, runID, dotDagSource)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *pipeline.RunReplay); ok {
+		r0 = rf(ctx, runID, dotDagSource)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.RunReplay)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, runID, dotDagSource)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ReplayPipelineRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayPipelineRun'
+type Application_ReplayPipelineRun_Call struct {
+	*mock.Call
+}
+
+// ReplayPipelineRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+//   - dotDagSource string
+func (_e *Application_Expecter) ReplayPipelineRun(ctx interface{}, runID interface{}, dotDagSource interface{}) *Application_ReplayPipelineRun_Call {
+	return &Application_ReplayPipelineRun_Call{Call: _e.mock.On("ReplayPipelineRun", ctx, runID, dotDagSource)}
+}
+
+func (_c *Application_ReplayPipelineRun_Call) Run(run func(ctx context.Context, runID int64, dotDagSource string)) *Application_ReplayPipelineRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRun_Call) Return(_a0 *pipeline.RunReplay, _a1 error) *Application_ReplayPipelineRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ReplayPipelineRun_Call) RunAndReturn(run func(context.Context, int64, string) (*pipeline.RunReplay, error)) *Application_ReplayPipelineRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayProgress provides a mock function with given fields: ctx, chainID
+func (_m *Application) ReplayProgress(ctx context.Context, chainID *big.Int) (*logpoller.BackfillProgress, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayProgress")
+	}
+
+	var r0 *logpoller.BackfillProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) (*logpoller.BackfillProgress, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) *logpoller.BackfillProgress); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.BackfillProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ReplayProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayProgress'
+type Application_ReplayProgress_Call struct {
+	*mock.Call
+}
+
+// ReplayProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *Application_Expecter) ReplayProgress(ctx interface{}, chainID interface{}) *Application_ReplayProgress_Call {
+	return &Application_ReplayProgress_Call{Call: _e.mock.On("ReplayProgress", ctx, chainID)}
+}
+
+func (_c *Application_ReplayProgress_Call) Run(run func(ctx context.Context, chainID *big.Int)) *Application_ReplayProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *Application_ReplayProgress_Call) Return(_a0 *logpoller.BackfillProgress, _a1 error) *Application_ReplayProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ReplayProgress_Call) RunAndReturn(run func(context.Context, *big.Int) (*logpoller.BackfillProgress, error)) *Application_ReplayProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type Application_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Application_Expecter) ResumeJob(ctx interface{}, jobID interface{}) *Application_ResumeJob_Call {
+	return &Application_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, jobID)}
+}
+
+func (_c *Application_ResumeJob_Call) Run(run func(ctx context.Context, jobID int32)) *Application_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Application_ResumeJob_Call) Return(_a0 error) *Application_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *Application_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -1506,6 +1719,67 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars, stubs
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}, stubs pipeline.SimulationStubs) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, jb, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationStubs) (*pipeline.Run, error)); ok {
+		return rf(ctx, jb, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationStubs) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationStubs) error); ok {
+		r1 = rf(ctx, jb, vars, stubs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb job.Job
+//   - vars map[string]interface{}
+//   - stubs pipeline.SimulationStubs
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, jb interface{}, vars interface{}, stubs interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, jb, vars, stubs)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, jb job.Job, vars map[string]interface{}, stubs pipeline.SimulationStubs)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(job.Job), args[2].(map[string]interface{}), args[3].(pipeline.SimulationStubs))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.Run, _a1 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, job.Job, map[string]interface{}, pipeline.SimulationStubs) (*pipeline.Run, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -48,12 +48,23 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystoreBackupExported EventID = "KEYSTORE_BACKUP_EXPORTED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTopUpCreated          EventID = "ETH_TOP_UP_CREATED"
+	EthNonceGapHealed        EventID = "ETH_NONCE_GAP_HEALED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
-	JobCreated EventID = "JOB_CREATED"
-	JobDeleted EventID = "JOB_DELETED"
+	JobCreated    EventID = "JOB_CREATED"
+	JobDeleted    EventID = "JOB_DELETED"
+	JobRolledBack EventID = "JOB_ROLLED_BACK"
+	JobPaused     EventID = "JOB_PAUSED"
+	JobResumed    EventID = "JOB_RESUMED"
+
+	JobTemplateCreated EventID = "JOB_TEMPLATE_CREATED"
+	JobTemplateUpdated EventID = "JOB_TEMPLATE_UPDATED"
+	JobTemplateDeleted EventID = "JOB_TEMPLATE_DELETED"
 
 	ChainAdded       EventID = "CHAIN_ADDED"
 	ChainSpecUpdated EventID = "CHAIN_SPEC_UPDATED"
@@ -108,8 +108,12 @@ type Application interface {
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	PauseJob(ctx context.Context, jobID int32) error
+	ResumeJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}, stubs pipeline.SimulationStubs) (*pipeline.Run, error)
+	ReplayPipelineRun(ctx context.Context, runID int64, dotDagSource string) (*pipeline.RunReplay, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -129,6 +133,8 @@ type Application interface {
 	FindLCA(ctx context.Context, chainID *big.Int) (*logpoller.LogPollerBlock, error)
 	// DeleteLogPollerDataAfter - delete LogPoller state starting from the specified block
 	DeleteLogPollerDataAfter(ctx context.Context, chainID *big.Int, start int64) error
+	// ReplayProgress - returns the progress of LogPoller's replay in progress, or nil if there is none
+	ReplayProgress(ctx context.Context, chainID *big.Int) (*logpoller.BackfillProgress, error)
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -404,6 +410,24 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	srvcs = append(srvcs, pipelineORM)
 
+	var runSinks []pipeline.RunSink
+	exportCfg := cfg.JobPipeline().Export()
+	if path := exportCfg.NDJSONPath(); path != "" {
+		runSinks = append(runSinks, pipeline.NewNDJSONFileSink(path))
+	}
+	if url := exportCfg.HTTPURL(); url != "" {
+		runSinks = append(runSinks, pipeline.NewHTTPBatchSink(url, unrestrictedHTTPClient))
+	}
+	if len(runSinks) > 0 {
+		// the exporter is closed after the runner, so that it flushes the last finished runs
+		runExporter := pipeline.NewRunExporter(exportCfg, globalLogger, runSinks...)
+		pipelineRunner.SetExporter(runExporter)
+		srvcs = append(srvcs, runExporter)
+	}
+
+	runLimiter := pipeline.NewRunLimiter(cfg.JobPipeline().Limits(), globalLogger, jobORM.TryRecordError)
+	pipelineRunner.SetLimiter(runLimiter)
+
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register, opts.LoopRegistry.Unregister)
 
 	var (
@@ -587,6 +611,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		lbs = append(lbs, c.LogBroadcaster())
 	}
 	jobSpawner := job.NewSpawner(jobORM, cfg.Database(), healthChecker, delegates, globalLogger, lbs)
+	jobSpawner.SetRunLimiter(runLimiter)
 	srvcs = append(srvcs, jobSpawner, pipelineRunner)
 
 	// We start the log poller after the job spawner
@@ -869,6 +894,14 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(ctx, nil, jobID)
 }
 
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.PauseJob(ctx, jobID)
+}
+
+func (app *ChainlinkApplication) ResumeJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.ResumeJob(ctx, jobID)
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
@@ -946,6 +979,49 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+// SimulateJobV2 runs the job's pipeline in-memory, returning the given stubs in place of tasks performing I/O.
+// The job does not need to exist in the database, and nothing is persisted.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	vars map[string]interface{},
+	stubs pipeline.SimulationStubs,
+) (*pipeline.Run, error) {
+	var spec pipeline.Spec
+	if jb.PipelineSpec != nil {
+		spec = *jb.PipelineSpec
+	} else {
+		spec.DotDagSource = jb.Pipeline.Source
+	}
+	if spec.DotDagSource == "" {
+		return nil, errors.Errorf("job %v has no pipeline to simulate", jb.ID)
+	}
+	spec.JobName = jb.Name.ValueOrZero()
+	spec.JobID = jb.ID
+	spec.JobType = string(jb.Type)
+	spec.ForwardingAllowed = jb.ForwardingAllowed
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	spec.MaxTaskDuration = jb.MaxTaskDuration
+
+	if vars == nil {
+		vars = map[string]interface{}{
+			"jobRun": map[string]interface{}{
+				"meta": map[string]interface{}{},
+			},
+		}
+	}
+	run, _, err := app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), stubs)
+	return run, err
+}
+
+// ReplayPipelineRun reruns a finished pipeline run in-memory, optionally with an edited DAG, and compares the results
+// to the original run. Nothing is persisted.
+func (app *ChainlinkApplication) ReplayPipelineRun(ctx context.Context, runID int64, dotDagSource string) (*pipeline.RunReplay, error) {
+	return app.pipelineRunner.ReplayRun(ctx, runID, dotDagSource)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -1012,6 +1088,24 @@ func (app *ChainlinkApplication) FindLCA(ctx context.Context, chainID *big.Int)
 	return lca, nil
 }
 
+// ReplayProgress - returns the progress of LogPoller's replay in progress, or nil if there is none
+func (app *ChainlinkApplication) ReplayProgress(ctx context.Context, chainID *big.Int) (*logpoller.BackfillProgress, error) {
+	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !app.Config.Feature().LogPoller() {
+		return nil, fmt.Errorf("ReplayProgress is only available if LogPoller is enabled")
+	}
+
+	progress, err := chain.LogPoller().BackfillProgress(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replay progress: %w", err)
+	}
+
+	return progress, nil
+}
+
 // DeleteLogPollerDataAfter - delete LogPoller state starting from the specified block
 func (app *ChainlinkApplication) DeleteLogPollerDataAfter(ctx context.Context, chainID *big.Int, start int64) error {
 	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
@@ -517,6 +517,10 @@ func (g *generalConfig) Mercury() coreconfig.Mercury {
 	return &mercuryConfig{c: g.c.Mercury, s: g.secrets.Mercury}
 }
 
+func (g *generalConfig) RemoteSigner() coreconfig.RemoteSigner {
+	return &remoteSignerConfig{c: g.c.RemoteSigner, s: g.secrets.RemoteSigner}
+}
+
 func (g *generalConfig) Threshold() coreconfig.Threshold {
 	return &thresholdConfig{s: g.secrets.Threshold}
 }
@@ -49,3 +49,70 @@ func (j *jobPipelineConfig) ExternalInitiatorsEnabled() bool {
 func (j *jobPipelineConfig) VerboseLogging() bool {
 	return *j.c.VerboseLogging
 }
+
+func (j *jobPipelineConfig) Export() config.JobPipelineExport {
+	return &jobPipelineExportConfig{c: j.c.Export}
+}
+
+func (j *jobPipelineConfig) Limits() config.JobPipelineLimits {
+	return &jobPipelineLimitsConfig{c: j.c.Limits}
+}
+
+var _ config.JobPipelineExport = (*jobPipelineExportConfig)(nil)
+
+type jobPipelineExportConfig struct {
+	c toml.JobPipelineExport
+}
+
+func (e *jobPipelineExportConfig) NDJSONPath() string {
+	return *e.c.NDJSONPath
+}
+
+func (e *jobPipelineExportConfig) HTTPURL() string {
+	return *e.c.HTTPURL
+}
+
+func (e *jobPipelineExportConfig) BatchSize() uint32 {
+	return *e.c.BatchSize
+}
+
+func (e *jobPipelineExportConfig) FlushInterval() time.Duration {
+	return e.c.FlushInterval.Duration()
+}
+
+func (e *jobPipelineExportConfig) QueueDepth() uint32 {
+	return *e.c.QueueDepth
+}
+
+var _ config.JobPipelineLimits = (*jobPipelineLimitsConfig)(nil)
+
+type jobPipelineLimitsConfig struct {
+	c toml.JobPipelineLimits
+}
+
+func (l *jobPipelineLimitsConfig) MaxConcurrentRuns() uint32 {
+	return *l.c.MaxConcurrentRuns
+}
+
+func (l *jobPipelineLimitsConfig) MaxRunsPerMinute() uint32 {
+	return *l.c.MaxRunsPerMinute
+}
+
+func (l *jobPipelineLimitsConfig) MaxTaskTimePerMinute() time.Duration {
+	return l.c.MaxTaskTimePerMinute.Duration()
+}
+
+func (l *jobPipelineLimitsConfig) Mode() string {
+	return *l.c.Mode
+}
+
+func (l *jobPipelineLimitsConfig) MaxQueuedRuns() uint32 {
+	return *l.c.MaxQueuedRuns
+}
+
+func (l *jobPipelineLimitsConfig) ExemptJobTypes() []string {
+	if l.c.ExemptJobTypes == nil {
+		return nil
+	}
+	return *l.c.ExemptJobTypes
+}
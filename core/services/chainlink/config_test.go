@@ -421,6 +421,21 @@ func TestConfig_Marshal(t *testing.T) {
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
 		},
+		Export: toml.JobPipelineExport{
+			NDJSONPath:    ptr("/var/lib/chainlink/runs.ndjson"),
+			HTTPURL:       ptr("https://example.com/runs"),
+			BatchSize:     ptr[uint32](50),
+			FlushInterval: commoncfg.MustNewDuration(10 * time.Second),
+			QueueDepth:    ptr[uint32](500),
+		},
+		Limits: toml.JobPipelineLimits{
+			MaxConcurrentRuns:    ptr[uint32](4),
+			MaxRunsPerMinute:     ptr[uint32](60),
+			MaxTaskTimePerMinute: commoncfg.MustNewDuration(30 * time.Second),
+			Mode:                 ptr("reject"),
+			MaxQueuedRuns:        ptr[uint32](10),
+			ExemptJobTypes:       &[]string{"offchainreporting2"},
+		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -563,6 +578,10 @@ func TestConfig_Marshal(t *testing.T) {
 		ResourceAttributes: map[string]string{"Baz": "test", "Foo": "bar"},
 		TraceSampleRatio:   ptr(0.01),
 	}
+	full.RemoteSigner = toml.RemoteSigner{
+		URL:     mustURL("https://signer.example.com"),
+		Timeout: commoncfg.MustNewDuration(10 * time.Second),
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: ubig.NewI(1),
@@ -571,6 +590,13 @@ func TestConfig_Marshal(t *testing.T) {
 				AutoCreateKey: ptr(false),
 				BalanceMonitor: evmcfg.BalanceMonitor{
 					Enabled: ptr(true),
+					TopUp: evmcfg.BalanceMonitorTopUp{
+						Enabled:     ptr(true),
+						TreasuryKey: mustAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292"),
+						Threshold:   assets.NewWeiI(100_000_000_000_000_000),
+						Target:      assets.NewWeiI(500_000_000_000_000_000),
+						DailyCap:    assets.NewWeiI(5_000_000_000_000_000_000),
+					},
 				},
 				BlockBackfillDepth:   ptr[uint32](100),
 				BlockBackfillSkip:    ptr(true),
@@ -595,6 +621,7 @@ func TestConfig_Marshal(t *testing.T) {
 					EstimateLimit:      ptr(false),
 					TipCapDefault:      assets.NewWeiI(2),
 					TipCapMin:          assets.NewWeiI(1),
+					BlobPriceMax:       assets.GWei(10),
 					PriceDefault:       assets.NewWeiI(math.MaxInt64),
 					PriceMax:           assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFF")),
 					PriceMin:           assets.NewWeiI(13),
@@ -619,6 +646,12 @@ func TestConfig_Marshal(t *testing.T) {
 					FeeHistory: evmcfg.FeeHistoryEstimator{
 						CacheTimeout: &second,
 					},
+					Composite: evmcfg.CompositeEstimator{
+						Estimators:    &[]string{"BlockHistory", "SuggestedPrice"},
+						Aggregation:   ptr(evmcfg.CompositeAggregationWeighted),
+						Weights:       &[]uint32{3, 1},
+						OutlierFactor: mustDecimal("2.5"),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -627,11 +660,16 @@ func TestConfig_Marshal(t *testing.T) {
 						GasEstimator: evmcfg.KeySpecificGasEstimator{
 							PriceMax: assets.NewWei(mustHexToBig(t, "FFFFFFFFFFFFFFFFFFFFFFFF")),
 						},
+						BalanceMonitor: evmcfg.KeySpecificBalanceMonitor{
+							TopUpThreshold: assets.NewWeiI(1_000_000_000_000_000_000),
+							TopUpTarget:    assets.NewWeiI(2_000_000_000_000_000_000),
+						},
 					},
 				},
 
 				LinkContractAddress:          mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
 				LogBackfillBatchSize:         ptr[uint32](17),
+				LogBackfillConcurrency:       ptr[uint32](4),
 				LogPollInterval:              &minute,
 				LogKeepBlocksDepth:           ptr[uint32](100000),
 				LogPrunePageSize:             ptr[uint32](0),
@@ -656,6 +694,15 @@ func TestConfig_Marshal(t *testing.T) {
 					AutoPurge: evmcfg.AutoPurgeConfig{
 						Enabled: ptr(false),
 					},
+					Preemption: evmcfg.PreemptionConfig{
+						Enabled:        ptr(true),
+						MinPendingTime: &minute,
+					},
+					NonceGapHealing: evmcfg.NonceGapHealingConfig{
+						Enabled:   ptr(true),
+						Threshold: ptr[uint32](10),
+						PriceMax:  assets.GWei(100),
+					},
 				},
 
 				HeadTracker: evmcfg.HeadTracker{
@@ -665,6 +712,7 @@ func TestConfig_Marshal(t *testing.T) {
 					FinalityTagBypass:       ptr[bool](false),
 					MaxAllowedFinalityDepth: ptr[uint32](1500),
 					PersistenceEnabled:      ptr(false),
+					ReorgRetention:          &hour,
 				},
 
 				NodePool: evmcfg.NodePool{
@@ -678,6 +726,7 @@ func TestConfig_Marshal(t *testing.T) {
 					EnforceRepeatableRead:      ptr(true),
 					DeathDeclarationDelay:      &minute,
 					NewHeadsPollInterval:       &zeroSeconds,
+					HedgeDelay:                 &zeroSeconds,
 					Errors: evmcfg.ClientErrors{
 						NonceTooLow:                       ptr[string]("(: |^)nonce too low"),
 						NonceTooHigh:                      ptr[string]("(: |^)nonce too high"),
@@ -998,6 +1047,21 @@ VerboseLogging = false
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.Export]
+NDJSONPath = '/var/lib/chainlink/runs.ndjson'
+HTTPURL = 'https://example.com/runs'
+BatchSize = 50
+FlushInterval = '10s'
+QueueDepth = 500
+
+[JobPipeline.Limits]
+MaxConcurrentRuns = 4
+MaxRunsPerMinute = 60
+MaxTaskTimePerMinute = '30s'
+Mode = 'reject'
+MaxQueuedRuns = 10
+ExemptJobTypes = ['offchainreporting2']
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -1092,6 +1156,7 @@ FinalityTagEnabled = true
 FlagsContractAddress = '0xae4E781a6218A8031764928E88d457937A954fC3'
 LinkContractAddress = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
 LogBackfillBatchSize = 17
+LogBackfillConcurrency = 4
 LogPollInterval = '1m0s'
 LogKeepBlocksDepth = 100000
 LogPrunePageSize = 0
@@ -1118,9 +1183,25 @@ ResendAfterThreshold = '1h0m0s'
 [EVM.Transactions.AutoPurge]
 Enabled = false
 
+[EVM.Transactions.Preemption]
+Enabled = true
+MinPendingTime = '1m0s'
+
+[EVM.Transactions.NonceGapHealing]
+Enabled = true
+Threshold = 10
+PriceMax = '100 gwei'
+
 [EVM.BalanceMonitor]
 Enabled = true
 
+[EVM.BalanceMonitor.TopUp]
+Enabled = true
+TreasuryKey = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
+Threshold = '100 milli'
+Target = '500 milli'
+DailyCap = '5 ether'
+
 [EVM.GasEstimator]
 Mode = 'SuggestedPrice'
 PriceDefault = '9.223372036854775807 ether'
@@ -1139,6 +1220,7 @@ EIP1559DynamicFees = true
 FeeCapDefault = '9.223372036854775807 ether'
 TipCapDefault = '2 wei'
 TipCapMin = '1 wei'
+BlobPriceMax = '10 gwei'
 
 [EVM.GasEstimator.LimitJobType]
 OCR = 1001
@@ -1159,6 +1241,12 @@ TransactionPercentile = 15
 [EVM.GasEstimator.FeeHistory]
 CacheTimeout = '1s'
 
+[EVM.GasEstimator.Composite]
+Estimators = ['BlockHistory', 'SuggestedPrice']
+Aggregation = 'Weighted'
+Weights = [3, 1]
+OutlierFactor = '2.5'
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -1166,6 +1254,7 @@ SamplingInterval = '1h0m0s'
 MaxAllowedFinalityDepth = 1500
 FinalityTagBypass = false
 PersistenceEnabled = false
+ReorgRetention = '1h0m0s'
 
 [[EVM.KeySpecific]]
 Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
@@ -1173,6 +1262,10 @@ Key = '0x2a3e23c6f242F5345320814aC8a1b4E58707D292'
 [EVM.KeySpecific.GasEstimator]
 PriceMax = '79.228162514264337593543950335 gether'
 
+[EVM.KeySpecific.BalanceMonitor]
+TopUpThreshold = '1 ether'
+TopUpTarget = '2 ether'
+
 [EVM.NodePool]
 PollFailureThreshold = 5
 PollInterval = '1m0s'
@@ -1184,6 +1277,7 @@ FinalizedBlockPollInterval = '1s'
 EnforceRepeatableRead = true
 DeathDeclarationDelay = '1m0s'
 NewHeadsPollInterval = '0s'
+HedgeDelay = '0s'
 
 [EVM.NodePool.Errors]
 NonceTooLow = '(: |^)nonce too low'
@@ -1337,6 +1337,53 @@ func (_c *GeneralConfig_Pyroscope_Call) RunAndReturn(run func() config.Pyroscope
 	return _c
 }
 
+// RemoteSigner provides a mock function with given fields:
+func (_m *GeneralConfig) RemoteSigner() config.RemoteSigner {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteSigner")
+	}
+
+	var r0 config.RemoteSigner
+	if rf, ok := ret.Get(0).(func() config.RemoteSigner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.RemoteSigner)
+		}
+	}
+
+	return r0
+}
+
+// GeneralConfig_RemoteSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteSigner'
+type GeneralConfig_RemoteSigner_Call struct {
+	*mock.Call
+}
+
+// RemoteSigner is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) RemoteSigner() *GeneralConfig_RemoteSigner_Call {
+	return &GeneralConfig_RemoteSigner_Call{Call: _e.mock.On("RemoteSigner")}
+}
+
+func (_c *GeneralConfig_RemoteSigner_Call) Run(run func()) *GeneralConfig_RemoteSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_RemoteSigner_Call) Return(_a0 config.RemoteSigner) *GeneralConfig_RemoteSigner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GeneralConfig_RemoteSigner_Call) RunAndReturn(run func() config.RemoteSigner) *GeneralConfig_RemoteSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
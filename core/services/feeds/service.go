@@ -860,6 +860,15 @@ func (s *service) ApproveSpec(ctx context.Context, id int64, force bool) error {
 				}
 			}
 
+			// Keep the replacement job paused if the existing job is paused
+			existingJob := foundJob
+			if existingJob.ID != existingJobID {
+				if existingJob, serr = tx.jobORM.FindJobWithoutSpecErrors(ctx, existingJobID); serr != nil {
+					return errors.Wrap(serr, "FindJobWithoutSpecErrors failed")
+				}
+			}
+			j.Paused = existingJob.Paused
+
 			// Delete the job
 			if serr = s.jobSpawner.DeleteJob(ctx, tx.ds, existingJobID); serr != nil {
 				logger.Errorw("Failed to delete the job", "err", serr)
@@ -1104,6 +1113,9 @@ func (s *service) getCSAPrivateKey() (privkey []byte, err error) {
 	if len(keys) < 1 {
 		return privkey, errors.New("CSA key does not exist")
 	}
+	if _, remote := keys[0].SignerKeyID(); remote {
+		return privkey, fmt.Errorf("%w: wsrpc connections need a local CSA key", keystore.ErrRemoteKey)
+	}
 	return keys[0].Raw(), nil
 }
 
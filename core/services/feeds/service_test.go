@@ -965,6 +965,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.jobORM.On("FindJobIDByWorkflow", mock.Anything, mock.Anything).Return(jobIDWF, sql.ErrNoRows)
 				svc.orm.On("GetApprovedSpec", mock.Anything, acceptedjpWF.ID).Return(&autoApprovableProposalSpecWF, nil)
 				svc.orm.On("CancelSpec", mock.Anything, autoApprovableProposalSpecWF.ID).Return(nil)
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, jobIDWF).Return(job.Job{ID: jobIDWF}, nil)
 				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, jobIDWF).Return(nil)
 				svc.spawner.
 					On("CreateJob",
@@ -2339,6 +2340,7 @@ answer1 [type=median index=0];
 				svc.jobORM.On("FindJobIDByAddress", mock.Anything, address, evmChainID, mock.Anything).Return(j.ID, nil)
 				svc.orm.EXPECT().GetApprovedSpec(mock.Anything, jp.ID).Return(nil, sql.ErrNoRows)
 
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, j.ID).Return(j, nil)
 				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, j.ID).Return(nil)
 
 				svc.spawner.
@@ -2370,6 +2372,50 @@ answer1 [type=median index=0];
 			id:    spec.ID,
 			force: true,
 		},
+		{
+			name:        "already existing paused job replacement stays paused if forced",
+			httpTimeout: commonconfig.MustNewDuration(1 * time.Minute),
+			before: func(svc *TestService) {
+				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.EXPECT().GetSpec(mock.Anything, spec.ID).Return(spec, nil)
+				svc.orm.EXPECT().GetJobProposal(mock.Anything, jp.ID).Return(jp, nil)
+				svc.jobORM.On("AssertBridgesExist", mock.Anything, mock.IsType(pipeline.Pipeline{})).Return(nil)
+				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, externalJobID).Return(job.Job{}, sql.ErrNoRows)
+				svc.jobORM.On("FindJobIDByAddress", mock.Anything, address, evmChainID, mock.Anything).Return(j.ID, nil)
+				svc.orm.EXPECT().GetApprovedSpec(mock.Anything, jp.ID).Return(nil, sql.ErrNoRows)
+
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, j.ID).Return(job.Job{ID: j.ID, Paused: true}, nil)
+				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, j.ID).Return(nil)
+
+				svc.spawner.
+					On("CreateJob",
+						mock.Anything,
+						mock.Anything,
+						mock.MatchedBy(func(j *job.Job) bool {
+							return j.Paused
+						}),
+					).
+					Run(func(args mock.Arguments) { (args.Get(2).(*job.Job)).ID = 1 }).
+					Return(nil)
+				svc.orm.On("ApproveSpec",
+					mock.Anything,
+					spec.ID,
+					externalJobID,
+				).Return(nil)
+				svc.fmsClient.On("ApprovedJob",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					&proto.ApprovedJobRequest{
+						Uuid:    jp.RemoteUUID.String(),
+						Version: int64(spec.Version),
+					},
+				).Return(&proto.ApprovedJobResponse{}, nil)
+				svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+				svc.orm.On("WithDataSource", mock.Anything).Return(feeds.ORM(svc.orm))
+				svc.jobORM.On("WithDataSource", mock.Anything).Return(job.ORM(svc.jobORM))
+			},
+			id:    spec.ID,
+			force: true,
+		},
 		{
 			name:        "already existing FMS managed job replacement success if forced",
 			httpTimeout: commonconfig.MustNewDuration(1 * time.Minute),
@@ -2383,6 +2429,7 @@ answer1 [type=median index=0];
 				svc.orm.EXPECT().GetApprovedSpec(mock.Anything, jp.ID).Return(&feeds.JobProposalSpec{ID: 100}, nil)
 				svc.orm.EXPECT().CancelSpec(mock.Anything, int64(100)).Return(nil)
 
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, j.ID).Return(j, nil)
 				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, j.ID).Return(nil)
 
 				svc.spawner.
@@ -2912,6 +2959,7 @@ updateInterval = "20m"
 				svc.orm.EXPECT().GetApprovedSpec(mock.Anything, jp.ID).Return(nil, sql.ErrNoRows)
 				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, externalJobID).Return(job.Job{}, sql.ErrNoRows)
 				svc.jobORM.On("FindOCR2JobIDByAddress", mock.Anything, address, (*common.Hash)(nil)).Return(j.ID, nil)
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, j.ID).Return(j, nil)
 				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, j.ID).Return(nil)
 
 				svc.spawner.
@@ -2960,6 +3008,7 @@ updateInterval = "20m"
 				svc.orm.EXPECT().GetApprovedSpec(mock.Anything, jp.ID).Return(nil, sql.ErrNoRows)
 				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, externalJobID).Return(job.Job{}, sql.ErrNoRows)
 				svc.jobORM.On("FindOCR2JobIDByAddress", mock.Anything, address, &feedID).Return(j.ID, nil)
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, j.ID).Return(j, nil)
 				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, j.ID).Return(nil)
 
 				svc.spawner.
@@ -3003,6 +3052,7 @@ updateInterval = "20m"
 				svc.orm.EXPECT().CancelSpec(mock.Anything, int64(100)).Return(nil)
 				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, externalJobID).Return(job.Job{}, sql.ErrNoRows)
 				svc.jobORM.On("FindOCR2JobIDByAddress", mock.Anything, address, (*common.Hash)(nil)).Return(j.ID, nil)
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, j.ID).Return(j, nil)
 				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, j.ID).Return(nil)
 
 				svc.spawner.
@@ -3444,6 +3494,7 @@ chainID = 0
 				svc.orm.EXPECT().GetApprovedSpec(mock.Anything, jp.ID).Return(nil, sql.ErrNoRows)
 				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, externalJobID).Return(job.Job{}, sql.ErrNoRows)
 				svc.jobORM.On("FindOCR2JobIDByAddress", mock.Anything, address, (*common.Hash)(nil)).Return(j.ID, nil)
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, j.ID).Return(j, nil)
 				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, j.ID).Return(nil)
 
 				svc.spawner.
@@ -3492,6 +3543,7 @@ chainID = 0
 				svc.orm.EXPECT().GetApprovedSpec(mock.Anything, jp.ID).Return(nil, sql.ErrNoRows)
 				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, externalJobID).Return(job.Job{}, sql.ErrNoRows)
 				svc.jobORM.On("FindOCR2JobIDByAddress", mock.Anything, address, &feedID).Return(j.ID, nil)
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, j.ID).Return(j, nil)
 				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, j.ID).Return(nil)
 
 				svc.spawner.
@@ -3535,6 +3587,7 @@ chainID = 0
 				svc.orm.EXPECT().CancelSpec(mock.Anything, int64(100)).Return(nil)
 				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, externalJobID).Return(job.Job{}, sql.ErrNoRows)
 				svc.jobORM.On("FindOCR2JobIDByAddress", mock.Anything, address, (*common.Hash)(nil)).Return(j.ID, nil)
+				svc.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, j.ID).Return(j, nil)
 				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, j.ID).Return(nil)
 
 				svc.spawner.
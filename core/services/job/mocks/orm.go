@@ -1520,6 +1520,54 @@ func (_c *ORM_RecordError_Call) RunAndReturn(run func(context.Context, int32, st
 	return _c
 }
 
+// SetJobPaused provides a mock function with given fields: ctx, id, paused
+func (_m *ORM) SetJobPaused(ctx context.Context, id int32, paused bool) error {
+	ret := _m.Called(ctx, id, paused)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetJobPaused")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, bool) error); ok {
+		r0 = rf(ctx, id, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_SetJobPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetJobPaused'
+type ORM_SetJobPaused_Call struct {
+	*mock.Call
+}
+
+// SetJobPaused is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+//   - paused bool
+func (_e *ORM_Expecter) SetJobPaused(ctx interface{}, id interface{}, paused interface{}) *ORM_SetJobPaused_Call {
+	return &ORM_SetJobPaused_Call{Call: _e.mock.On("SetJobPaused", ctx, id, paused)}
+}
+
+func (_c *ORM_SetJobPaused_Call) Run(run func(ctx context.Context, id int32, paused bool)) *ORM_SetJobPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ORM_SetJobPaused_Call) Return(_a0 error) *ORM_SetJobPaused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_SetJobPaused_Call) RunAndReturn(run func(context.Context, int32, bool) error) *ORM_SetJobPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TryRecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) TryRecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
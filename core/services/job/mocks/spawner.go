@@ -164,6 +164,66 @@ func (_c *Spawner_CreateJob_Call) RunAndReturn(run func(context.Context, sqlutil
 	return _c
 }
 
+// CreateJobInTx provides a mock function with given fields: ctx, tx, jb
+func (_m *Spawner) CreateJobInTx(ctx context.Context, tx sqlutil.DataSource, jb *job.Job) (func(context.Context) error, error) {
+	ret := _m.Called(ctx, tx, jb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobInTx")
+	}
+
+	var r0 func(context.Context) error
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, *job.Job) (func(context.Context) error, error)); ok {
+		return rf(ctx, tx, jb)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, *job.Job) func(context.Context) error); ok {
+		r0 = rf(ctx, tx, jb)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func(context.Context) error)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlutil.DataSource, *job.Job) error); ok {
+		r1 = rf(ctx, tx, jb)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Spawner_CreateJobInTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobInTx'
+type Spawner_CreateJobInTx_Call struct {
+	*mock.Call
+}
+
+// CreateJobInTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx sqlutil.DataSource
+//   - jb *job.Job
+func (_e *Spawner_Expecter) CreateJobInTx(ctx interface{}, tx interface{}, jb interface{}) *Spawner_CreateJobInTx_Call {
+	return &Spawner_CreateJobInTx_Call{Call: _e.mock.On("CreateJobInTx", ctx, tx, jb)}
+}
+
+func (_c *Spawner_CreateJobInTx_Call) Run(run func(ctx context.Context, tx sqlutil.DataSource, jb *job.Job)) *Spawner_CreateJobInTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlutil.DataSource), args[2].(*job.Job))
+	})
+	return _c
+}
+
+func (_c *Spawner_CreateJobInTx_Call) Return(start func(context.Context) error, err error) *Spawner_CreateJobInTx_Call {
+	_c.Call.Return(start, err)
+	return _c
+}
+
+func (_c *Spawner_CreateJobInTx_Call) RunAndReturn(run func(context.Context, sqlutil.DataSource, *job.Job) (func(context.Context) error, error)) *Spawner_CreateJobInTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: ctx, ds, jobID
 func (_m *Spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error {
 	ret := _m.Called(ctx, ds, jobID)
@@ -212,6 +272,75 @@ func (_c *Spawner_DeleteJob_Call) RunAndReturn(run func(context.Context, sqlutil
 	return _c
 }
 
+// DeleteJobInTx provides a mock function with given fields: ctx, tx, jobID
+func (_m *Spawner) DeleteJobInTx(ctx context.Context, tx sqlutil.DataSource, jobID int32) (func(), func(), error) {
+	ret := _m.Called(ctx, tx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJobInTx")
+	}
+
+	var r0 func()
+	var r1 func()
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, int32) (func(), func(), error)); ok {
+		return rf(ctx, tx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlutil.DataSource, int32) func()); ok {
+		r0 = rf(ctx, tx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlutil.DataSource, int32) func()); ok {
+		r1 = rf(ctx, tx, jobID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, sqlutil.DataSource, int32) error); ok {
+		r2 = rf(ctx, tx, jobID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Spawner_DeleteJobInTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJobInTx'
+type Spawner_DeleteJobInTx_Call struct {
+	*mock.Call
+}
+
+// DeleteJobInTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx sqlutil.DataSource
+//   - jobID int32
+func (_e *Spawner_Expecter) DeleteJobInTx(ctx interface{}, tx interface{}, jobID interface{}) *Spawner_DeleteJobInTx_Call {
+	return &Spawner_DeleteJobInTx_Call{Call: _e.mock.On("DeleteJobInTx", ctx, tx, jobID)}
+}
+
+func (_c *Spawner_DeleteJobInTx_Call) Run(run func(ctx context.Context, tx sqlutil.DataSource, jobID int32)) *Spawner_DeleteJobInTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(sqlutil.DataSource), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_DeleteJobInTx_Call) Return(beforeDelete func(), stop func(), err error) *Spawner_DeleteJobInTx_Call {
+	_c.Call.Return(beforeDelete, stop, err)
+	return _c
+}
+
+func (_c *Spawner_DeleteJobInTx_Call) RunAndReturn(run func(context.Context, sqlutil.DataSource, int32) (func(), func(), error)) *Spawner_DeleteJobInTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HealthReport provides a mock function with given fields:
 func (_m *Spawner) HealthReport() map[string]error {
 	ret := _m.Called()
@@ -304,6 +433,53 @@ func (_c *Spawner_Name_Call) RunAndReturn(run func() string) *Spawner_Name_Call
 	return _c
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_PauseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseJob'
+type Spawner_PauseJob_Call struct {
+	*mock.Call
+}
+
+// PauseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Spawner_Expecter) PauseJob(ctx interface{}, jobID interface{}) *Spawner_PauseJob_Call {
+	return &Spawner_PauseJob_Call{Call: _e.mock.On("PauseJob", ctx, jobID)}
+}
+
+func (_c *Spawner_PauseJob_Call) Run(run func(ctx context.Context, jobID int32)) *Spawner_PauseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) Return(_a0 error) *Spawner_PauseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_PauseJob_Call) RunAndReturn(run func(context.Context, int32) error) *Spawner_PauseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function with given fields:
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -349,6 +525,53 @@ func (_c *Spawner_Ready_Call) RunAndReturn(run func() error) *Spawner_Ready_Call
 	return _c
 }
 
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Spawner_ResumeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeJob'
+type Spawner_ResumeJob_Call struct {
+	*mock.Call
+}
+
+// ResumeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int32
+func (_e *Spawner_Expecter) ResumeJob(ctx interface{}, jobID interface{}) *Spawner_ResumeJob_Call {
+	return &Spawner_ResumeJob_Call{Call: _e.mock.On("ResumeJob", ctx, jobID)}
+}
+
+func (_c *Spawner_ResumeJob_Call) Run(run func(ctx context.Context, jobID int32)) *Spawner_ResumeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) Return(_a0 error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Spawner_ResumeJob_Call) RunAndReturn(run func(context.Context, int32) error) *Spawner_ResumeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
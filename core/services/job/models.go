@@ -192,8 +192,18 @@ type Job struct {
 	ForwardingAllowed             bool          `toml:"forwardingAllowed"`
 	Name                          null.String   `toml:"name"`
 	MaxTaskDuration               models.Interval
+	MaxConcurrentRuns             clnull.Uint32     `toml:"maxConcurrentRuns"`
+	MaxRunsPerMinute              clnull.Uint32     `toml:"maxRunsPerMinute"`
+	MaxTaskTimePerMinute          *models.Interval  `toml:"maxTaskTimePerMinute"`
 	Pipeline                      pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                     time.Time
+
+	// Paused jobs keep their spec and run history, but their services are not started.
+	Paused bool `toml:"-"`
+
+	// SpecVersion, if set when the job is created, is saved as the next spec version of the job in the same
+	// transaction. Its job ID and version number are assigned on insert.
+	SpecVersion *SpecVersion `toml:"-" db:"-" json:"-"`
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
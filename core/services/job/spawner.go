@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 type (
@@ -23,13 +24,26 @@ type (
 	Spawner interface {
 		services.Service
 
-		// CreateJob creates a new job and starts services.
+		// CreateJob creates a new job and starts services, unless the job is paused.
 		// All services must start without errors for the job to be active.
 		CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error)
 		// DeleteJob deletes a job and stops any active services.
 		DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID int32) error
+		// CreateJobInTx creates a new job within the transaction tx, without starting its services. The returned func
+		// starts them and must only be called once tx has been committed.
+		CreateJobInTx(ctx context.Context, tx sqlutil.DataSource, jb *Job) (start func(context.Context) error, err error)
+		// DeleteJobInTx deletes a job within the transaction tx, without stopping its services. beforeDelete runs the
+		// BeforeJobDeleted callback of the job's delegate, which may have side effects outside of the database, so it
+		// must be called within tx once nothing else can fail. stop stops the services and must only be called once tx
+		// has been committed.
+		DeleteJobInTx(ctx context.Context, tx sqlutil.DataSource, jobID int32) (beforeDelete func(), stop func(), err error)
 		// ActiveJobs returns a map of jobs with active services (started without error).
 		ActiveJobs() map[int32]Job
+		// PauseJob stops the services of a job and marks it as paused, so that they are not started again until
+		// the job is resumed. The job, its pipeline spec and its runs are kept.
+		PauseJob(ctx context.Context, jobID int32) error
+		// ResumeJob marks a paused job as resumed and starts its services.
+		ResumeJob(ctx context.Context, jobID int32) error
 
 		// StartService starts services for the given job spec.
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -50,7 +64,11 @@ type (
 		jobTypeDelegates map[Type]Delegate
 		activeJobs       map[int32]activeJob
 		activeJobsMu     sync.RWMutex
-		lggr             logger.Logger
+		// pauseMu serializes pausing and resuming jobs.
+		pauseMu sync.Mutex
+		lggr    logger.Logger
+		// runLimiter is optional, and is given the run limits of each job that is started.
+		runLimiter *pipeline.RunLimiter
 
 		chStop              services.StopChan
 		lbDependentAwaiters []utils.DependentAwaiter
@@ -100,6 +118,11 @@ func NewSpawner(orm ORM, config Config, checker Checker, jobTypeDelegates map[Ty
 	return s
 }
 
+// SetRunLimiter sets the limiter enforcing the run limits of the jobs started by this Spawner.
+func (js *spawner) SetRunLimiter(limiter *pipeline.RunLimiter) {
+	js.runLimiter = limiter
+}
+
 // Start starts Spawner.
 func (js *spawner) Start(ctx context.Context) error {
 	return js.StartOnce("JobSpawner", func() error {
@@ -135,6 +158,10 @@ func (js *spawner) startAllServices(ctx context.Context) {
 	}
 
 	for _, spec := range specs {
+		if spec.Paused {
+			js.lggr.Infow("Not starting services for paused job", "jobID", spec.ID)
+			continue
+		}
 		if err = js.StartService(ctx, spec); err != nil {
 			js.lggr.Errorf("Couldn't start service %q: %v", spec.Name.ValueOrZero(), err)
 		}
@@ -180,6 +207,7 @@ func (js *spawner) stopService(jobID int32) {
 	}
 	lggr.Debug("Stopped all services for job")
 
+	js.runLimiter.RemoveJob(jobID)
 	delete(js.activeJobs, jobID)
 }
 
@@ -206,6 +234,17 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	if jb.GasLimit.Valid {
 		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
 	}
+	if jb.MaxConcurrentRuns.Valid {
+		jb.PipelineSpec.Limits.MaxConcurrentRuns = &jb.MaxConcurrentRuns.Uint32
+	}
+	if jb.MaxRunsPerMinute.Valid {
+		jb.PipelineSpec.Limits.MaxRunsPerMinute = &jb.MaxRunsPerMinute.Uint32
+	}
+	if jb.MaxTaskTimePerMinute != nil {
+		d := jb.MaxTaskTimePerMinute.Duration()
+		jb.PipelineSpec.Limits.MaxTaskTimePerMinute = &d
+	}
+	js.runLimiter.SetJobLimits(*jb.PipelineSpec)
 
 	srvs, err := delegate.ServicesForSpec(ctx, jb)
 	if err != nil {
@@ -242,35 +281,47 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 
 // Should not get called before Start()
 func (js *spawner) CreateJob(ctx context.Context, ds sqlutil.DataSource, jb *Job) (err error) {
+	start, err := js.CreateJobInTx(ctx, ds, jb)
+	if err != nil {
+		return err
+	}
+	return start(ctx)
+}
+
+// Should not get called before Start()
+func (js *spawner) CreateJobInTx(ctx context.Context, tx sqlutil.DataSource, jb *Job) (start func(context.Context) error, err error) {
 	orm := js.orm
-	if ds != nil {
-		orm = orm.WithDataSource(ds)
+	if tx != nil {
+		orm = orm.WithDataSource(tx)
 	}
 	delegate, exists := js.jobTypeDelegates[jb.Type]
 	if !exists {
 		js.lggr.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
-		err = pkgerrors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
-		return
+		return nil, pkgerrors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
 	}
 
 	err = orm.CreateJob(ctx, jb)
 	if err != nil {
 		js.lggr.Errorw("Error creating job", "type", jb.Type, "err", err)
-		return
+		return nil, err
 	}
 	js.lggr.Infow("Created job", "type", jb.Type, "jobID", jb.ID)
 
-	delegate.BeforeJobCreated(*jb)
-	err = js.StartService(ctx, *jb)
-	if err != nil {
-		js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
-	} else {
-		js.lggr.Infow("Started job services", "type", jb.Type, "jobID", jb.ID)
-	}
+	return func(ctx context.Context) error {
+		delegate.BeforeJobCreated(*jb)
+		var err error
+		if jb.Paused {
+			js.lggr.Infow("Job is paused, not starting job services", "type", jb.Type, "jobID", jb.ID)
+		} else if err = js.StartService(ctx, *jb); err != nil {
+			js.lggr.Errorw("Error starting job services", "type", jb.Type, "jobID", jb.ID, "err", err)
+		} else {
+			js.lggr.Infow("Started job services", "type", jb.Type, "jobID", jb.ID)
+		}
 
-	delegate.AfterJobCreated(*jb)
+		delegate.AfterJobCreated(*jb)
 
-	return err
+		return err
+	}, nil
 }
 
 // Should not get called before Start()
@@ -278,15 +329,52 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 	if ds == nil {
 		ds = js.orm.DataSource()
 	}
+	aj, exists, err := js.findJobToDelete(ctx, ds, jobID)
+	if err != nil {
+		return err
+	}
+
+	js.beforeJobDeleted(aj)
+	err = js.deleteJob(ctx, ds, aj)
+
+	if exists {
+		// Stop the service and remove the job from memory, which will always happen even if closing the services fail.
+		js.stopService(jobID)
+	}
+	js.lggr.Infow("Stopped and deleted job", "jobID", jobID)
+
+	return err
+}
+
+// Should not get called before Start()
+func (js *spawner) DeleteJobInTx(ctx context.Context, tx sqlutil.DataSource, jobID int32) (beforeDelete func(), stop func(), err error) {
+	if tx == nil {
+		tx = js.orm.DataSource()
+	}
+	aj, exists, err := js.findJobToDelete(ctx, tx, jobID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = js.deleteJob(ctx, tx, aj); err != nil {
+		return nil, nil, err
+	}
+
+	beforeDelete = func() { js.beforeJobDeleted(aj) }
+	stop = func() {}
+	if exists {
+		stop = func() { js.stopService(jobID) }
+	}
+	return beforeDelete, stop, nil
+}
+
+// findJobToDelete returns the active job, or looks up the spec and delegate of an inactive job.
+func (js *spawner) findJobToDelete(ctx context.Context, ds sqlutil.DataSource, jobID int32) (aj activeJob, exists bool, err error) {
 	if jobID == 0 {
-		return pkgerrors.New("will not delete job with 0 ID")
+		return aj, false, pkgerrors.New("will not delete job with 0 ID")
 	}
 
-	lggr := js.lggr.With("jobID", jobID)
-	lggr.Debugw("Deleting job")
+	js.lggr.Debugw("Deleting job", "jobID", jobID)
 
-	var aj activeJob
-	var exists bool
 	func() {
 		js.activeJobsMu.RLock()
 		defer js.activeJobsMu.RUnlock()
@@ -296,7 +384,7 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 	if !exists { // inactive, so look up the spec and delegate
 		jb, err := js.orm.WithDataSource(ds).FindJob(ctx, jobID)
 		if err != nil {
-			return pkgerrors.Wrapf(err, "job %d not found", jobID)
+			return aj, false, pkgerrors.Wrapf(err, "job %d not found", jobID)
 		}
 		aj.spec = jb
 		if !func() (ok bool) {
@@ -306,15 +394,23 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 			return ok
 		}() {
 			js.lggr.Errorw("Job type has not been registered with job.Spawner", "type", jb.Type, "jobID", jb.ID)
-			return pkgerrors.Errorf("unregistered type %q for job: %d", jb.Type, jb.ID)
+			return aj, false, pkgerrors.Errorf("unregistered type %q for job: %d", jb.Type, jb.ID)
 		}
 	}
+	return aj, exists, nil
+}
 
+func (js *spawner) beforeJobDeleted(aj activeJob) {
+	lggr := js.lggr.With("jobID", aj.spec.ID)
 	lggr.Debugw("Callback: BeforeDeleteJob")
 	aj.delegate.BeforeJobDeleted(aj.spec)
 	lggr.Debugw("Callback: BeforeDeleteJob done")
+}
 
-	err := sqlutil.Transact(ctx, js.orm.WithDataSource, ds, nil, func(tx ORM) error {
+func (js *spawner) deleteJob(ctx context.Context, ds sqlutil.DataSource, aj activeJob) error {
+	jobID := aj.spec.ID
+	lggr := js.lggr.With("jobID", jobID)
+	return sqlutil.Transact(ctx, js.orm.WithDataSource, ds, nil, func(tx ORM) error {
 		err := tx.DeleteJob(ctx, jobID)
 		if err != nil {
 			js.lggr.Errorw("Error deleting job", "jobID", jobID, "err", err)
@@ -332,14 +428,53 @@ func (js *spawner) DeleteJob(ctx context.Context, ds sqlutil.DataSource, jobID i
 		lggr.Debugw("Callback: OnDeleteJob done")
 		return nil
 	})
+}
 
-	if exists {
-		// Stop the service and remove the job from memory, which will always happen even if closing the services fail.
+// Should not get called before Start()
+func (js *spawner) PauseJob(ctx context.Context, jobID int32) error {
+	js.pauseMu.Lock()
+	defer js.pauseMu.Unlock()
+	if _, err := js.orm.FindJob(ctx, jobID); err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	// The job is marked as paused first, so that it is not started on the next boot even if stopping fails.
+	if err := js.orm.SetJobPaused(ctx, jobID, true); err != nil {
+		return err
+	}
+	if js.isActive(jobID) {
 		js.stopService(jobID)
 	}
-	lggr.Infow("Stopped and deleted job")
+	js.lggr.Infow("Paused job", "jobID", jobID)
+	return nil
+}
 
-	return err
+// Should not get called before Start()
+func (js *spawner) ResumeJob(ctx context.Context, jobID int32) error {
+	js.pauseMu.Lock()
+	defer js.pauseMu.Unlock()
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "job %d not found", jobID)
+	}
+	if err = js.orm.SetJobPaused(ctx, jobID, false); err != nil {
+		return err
+	}
+	if js.isActive(jobID) {
+		return nil
+	}
+	jb.Paused = false
+	if err = js.StartService(ctx, jb); err != nil {
+		return err
+	}
+	js.lggr.Infow("Resumed job", "jobID", jobID)
+	return nil
+}
+
+func (js *spawner) isActive(jobID int32) bool {
+	js.activeJobsMu.RLock()
+	defer js.activeJobsMu.RUnlock()
+	_, exists := js.activeJobs[jobID]
+	return exists
 }
 
 func (js *spawner) ActiveJobs() map[int32]Job {
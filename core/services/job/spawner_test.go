@@ -2,6 +2,7 @@ package job_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -271,6 +272,84 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		clearDB(t, db)
 	})
 
+	t.Run("stops job services on 'PauseJob()' and restarts them on 'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Twice()
+		serviceA2.On("Start", mock.Anything).Return(nil).Twice()
+		serviceA1.On("Close").Return(nil).Twice()
+		serviceA2.On("Close").Return(nil).Twice()
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		newSpawner := func() job.Spawner {
+			return job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+				jobA.Type: delegateA,
+			}, lggr, nil)
+		}
+
+		ctx := testutils.Context(t)
+		require.NoError(t, orm.CreateJob(ctx, jobA))
+		delegateA.jobID = jobA.ID
+
+		spawner := newSpawner()
+		require.NoError(t, spawner.Start(ctx))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		require.NoError(t, spawner.PauseJob(ctx, jobA.ID))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.Paused)
+
+		// paused jobs are not started on boot
+		require.NoError(t, spawner.Close())
+		spawner = newSpawner()
+		require.NoError(t, spawner.Start(ctx))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		require.NoError(t, spawner.ResumeJob(ctx, jobA.ID))
+		assert.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err = orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.False(t, jb.Paused)
+
+		require.ErrorIs(t, spawner.PauseJob(ctx, 999999), sql.ErrNoRows)
+
+		require.NoError(t, spawner.Close())
+		clearDB(t, db)
+	})
+
+	t.Run("does not start the services of a job created paused", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		jobA.Paused = true
+
+		lggr := logger.TestLogger(t)
+		orm := NewTestORM(t, db, pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns()), bridges.NewORM(db), keyStore)
+		mailMon := servicetest.Run(t, mailboxtest.NewMonitor(t))
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, legacyChains, logger.TestLogger(t), config, mailMon)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{mocks.NewServiceCtx(t)}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config.Database(), noopChecker{}, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, lggr, nil)
+
+		ctx := testutils.Context(t)
+		require.NoError(t, spawner.Start(ctx))
+		require.NoError(t, spawner.CreateJob(ctx, nil, jobA))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		jb, err := orm.FindJob(ctx, jobA.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.Paused)
+
+		require.NoError(t, spawner.Close())
+		clearDB(t, db)
+	})
+
 	t.Run("Unregisters filters on 'DeleteJob()'", func(t *testing.T) {
 		config = configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
 			c.Feature.LogPoller = func(b bool) *bool { return &b }(true)
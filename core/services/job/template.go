@@ -0,0 +1,305 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// JobTemplate is a TOML job spec referencing named parameters, such as `{{ .feedID }}`, from which one job is created
+// per parameter set. Parameters are substituted as is, so string parameters must be quoted in the template, e.g.
+// `contractAddress = "{{ .contractAddress }}"`. Parameters can only be strings, numbers or booleans, and strings
+// cannot contain quotes, backslashes or control characters, so that they cannot change the structure of the spec.
+type JobTemplate struct {
+	ID        int32     `db:"id"`
+	Name      string    `db:"name"`
+	Template  string    `db:"template"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// TemplateJob links a job to the template and parameter set it was rendered from.
+type TemplateJob struct {
+	JobID         int32      `db:"job_id"`
+	JobTemplateID int32      `db:"job_template_id"`
+	Params        JSONConfig `db:"params"`
+	// Paused is whether the job is paused.
+	Paused bool `db:"paused"`
+}
+
+// RenderTemplate renders a job template with a parameter set. Referencing a parameter missing from the set, or a
+// parameter that is not safe to substitute into TOML, is an error.
+func RenderTemplate(tmpl string, params JSONConfig) (string, error) {
+	for name, value := range params {
+		if err := validateTemplateParam(name, value); err != nil {
+			return "", err
+		}
+	}
+	t, err := template.New("job").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse job template")
+	}
+	var b strings.Builder
+	if err = t.Execute(&b, map[string]interface{}(params)); err != nil {
+		return "", errors.Wrap(err, "failed to render job template")
+	}
+	return b.String(), nil
+}
+
+// validateTemplateParam checks that a parameter cannot break out of the TOML value it is substituted into, e.g. by
+// closing a string and adding keys to the spec.
+func validateTemplateParam(name string, value interface{}) error {
+	switch v := value.(type) {
+	case bool, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, json.Number:
+		return nil
+	case string:
+		for _, r := range v {
+			if r == '"' || r == '\'' || r == '\\' || unicode.IsControl(r) {
+				return errors.Errorf("parameter %q cannot contain quotes, backslashes or control characters", name)
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("parameter %q must be a string, number or boolean, got %T", name, value)
+	}
+}
+
+// ErrInvalidJobTemplate is returned when a job template cannot be rendered into valid job specs.
+var ErrInvalidJobTemplate = errors.New("invalid job template")
+
+// SpecValidator parses and validates a rendered TOML job spec, according to its type.
+type SpecValidator func(ctx context.Context, toml string) (Job, error)
+
+type TemplateORM interface {
+	CreateTemplate(ctx context.Context, tmpl *JobTemplate) error
+	UpdateTemplate(ctx context.Context, tmpl *JobTemplate) error
+	FindTemplate(ctx context.Context, id int32) (JobTemplate, error)
+	FindTemplates(ctx context.Context) ([]JobTemplate, error)
+	DeleteTemplate(ctx context.Context, id int32) error
+	LinkJob(ctx context.Context, templateID, jobID int32, params JSONConfig) error
+	FindTemplateJobs(ctx context.Context, templateID int32) ([]TemplateJob, error)
+}
+
+type templateORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ TemplateORM = (*templateORM)(nil)
+
+func NewTemplateORM(ds sqlutil.DataSource) TemplateORM {
+	return &templateORM{ds: ds}
+}
+
+func (o *templateORM) CreateTemplate(ctx context.Context, tmpl *JobTemplate) error {
+	stmt := `INSERT INTO job_templates (name, template, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
+		RETURNING *;`
+	return errors.Wrap(o.ds.GetContext(ctx, tmpl, stmt, tmpl.Name, tmpl.Template), "failed to insert job template")
+}
+
+func (o *templateORM) UpdateTemplate(ctx context.Context, tmpl *JobTemplate) error {
+	stmt := `UPDATE job_templates SET template = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING *;`
+	return errors.Wrap(o.ds.GetContext(ctx, tmpl, stmt, tmpl.ID, tmpl.Template), "failed to update job template")
+}
+
+func (o *templateORM) FindTemplate(ctx context.Context, id int32) (tmpl JobTemplate, err error) {
+	err = o.ds.GetContext(ctx, &tmpl, `SELECT * FROM job_templates WHERE id = $1`, id)
+	return tmpl, errors.Wrap(err, "failed to find job template")
+}
+
+func (o *templateORM) FindTemplates(ctx context.Context) (tmpls []JobTemplate, err error) {
+	err = o.ds.SelectContext(ctx, &tmpls, `SELECT * FROM job_templates ORDER BY id`)
+	return tmpls, errors.Wrap(err, "failed to find job templates")
+}
+
+// DeleteTemplate deletes a template. Its jobs are kept, but they are no longer linked to the template.
+func (o *templateORM) DeleteTemplate(ctx context.Context, id int32) error {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM job_templates WHERE id = $1`, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete job template")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.Wrap(sql.ErrNoRows, "failed to delete job template")
+	}
+	return nil
+}
+
+func (o *templateORM) LinkJob(ctx context.Context, templateID, jobID int32, params JSONConfig) error {
+	if params == nil {
+		params = JSONConfig{}
+	}
+	_, err := o.ds.ExecContext(ctx, `INSERT INTO job_template_jobs (job_id, job_template_id, params) VALUES ($1, $2, $3)`, jobID, templateID, params)
+	return errors.Wrapf(err, "failed to link job %d to template %d", jobID, templateID)
+}
+
+func (o *templateORM) FindTemplateJobs(ctx context.Context, templateID int32) (jobs []TemplateJob, err error) {
+	err = o.ds.SelectContext(ctx, &jobs, `SELECT job_template_jobs.*, jobs.paused FROM job_template_jobs
+		JOIN jobs ON jobs.id = job_template_jobs.job_id
+		WHERE job_template_id = $1 ORDER BY job_id`, templateID)
+	return jobs, errors.Wrap(err, "failed to find template jobs")
+}
+
+// TemplateManager creates and updates the jobs of job templates.
+type TemplateManager struct {
+	ds       sqlutil.DataSource
+	spawner  Spawner
+	validate SpecValidator
+}
+
+func NewTemplateManager(ds sqlutil.DataSource, spawner Spawner, validate SpecValidator) *TemplateManager {
+	return &TemplateManager{ds: ds, spawner: spawner, validate: validate}
+}
+
+// CreateTemplate saves a template and creates one job per parameter set, linked to the template. Either all jobs are
+// created, or none are.
+func (m *TemplateManager) CreateTemplate(ctx context.Context, name, tmpl string, paramSets []JSONConfig) (jt JobTemplate, jobs []Job, err error) {
+	jobs, err = m.render(ctx, tmpl, paramSets)
+	if err != nil {
+		return
+	}
+	jt = JobTemplate{Name: name, Template: tmpl}
+	var starts []func(context.Context) error
+	err = sqlutil.TransactDataSource(ctx, m.ds, nil, func(tx sqlutil.DataSource) error {
+		if err = NewTemplateORM(tx).CreateTemplate(ctx, &jt); err != nil {
+			return err
+		}
+		starts, err = m.createJobs(ctx, tx, jt.ID, jobs, paramSets)
+		return err
+	})
+	if err != nil {
+		return
+	}
+	err = startJobs(ctx, jobs, starts)
+	return
+}
+
+// UpdateTemplate replaces the template, then re-renders and replaces all of its jobs. If paramSets is nil, the
+// parameter sets of the existing jobs are reused. The job rendered from the i-th parameter set replaces the i-th
+// existing job, and stays paused if that job was paused.
+func (m *TemplateManager) UpdateTemplate(ctx context.Context, id int32, tmpl string, paramSets []JSONConfig) (jt JobTemplate, jobs []Job, err error) {
+	var (
+		stops  []func()
+		starts []func(context.Context) error
+	)
+	err = sqlutil.TransactDataSource(ctx, m.ds, nil, func(tx sqlutil.DataSource) error {
+		orm := NewTemplateORM(tx)
+		jt, err = orm.FindTemplate(ctx, id)
+		if err != nil {
+			return err
+		}
+		var existing []TemplateJob
+		existing, err = orm.FindTemplateJobs(ctx, id)
+		if err != nil {
+			return err
+		}
+		if paramSets == nil {
+			paramSets = make([]JSONConfig, len(existing))
+			for i, tj := range existing {
+				paramSets[i] = tj.Params
+			}
+		}
+		// render everything before touching the existing jobs
+		jobs, err = m.render(ctx, tmpl, paramSets)
+		if err != nil {
+			return err
+		}
+		for i := range jobs {
+			if i < len(existing) {
+				jobs[i].Paused = existing[i].Paused
+			}
+		}
+
+		var beforeDeletes []func()
+		for _, tj := range existing {
+			var beforeDelete, stop func()
+			if beforeDelete, stop, err = m.spawner.DeleteJobInTx(ctx, tx, tj.JobID); err != nil {
+				return errors.Wrapf(err, "failed to delete job %d", tj.JobID)
+			}
+			beforeDeletes = append(beforeDeletes, beforeDelete)
+			stops = append(stops, stop)
+		}
+		jt.Template = tmpl
+		if err = orm.UpdateTemplate(ctx, &jt); err != nil {
+			return err
+		}
+		if starts, err = m.createJobs(ctx, tx, jt.ID, jobs, paramSets); err != nil {
+			return err
+		}
+		// the side effects of deleting the existing jobs only happen once all new jobs have been created
+		for _, beforeDelete := range beforeDeletes {
+			beforeDelete()
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	for _, stop := range stops {
+		stop()
+	}
+	err = startJobs(ctx, jobs, starts)
+	return
+}
+
+// render renders and validates one job per parameter set.
+func (m *TemplateManager) render(ctx context.Context, tmpl string, paramSets []JSONConfig) ([]Job, error) {
+	if len(paramSets) == 0 {
+		return nil, fmt.Errorf("%w: at least one parameter set is required", ErrInvalidJobTemplate)
+	}
+	jobs := make([]Job, len(paramSets))
+	for i, params := range paramSets {
+		spec, err := RenderTemplate(tmpl, params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: parameter set %d: %w", ErrInvalidJobTemplate, i, err)
+		}
+		if jobs[i], err = m.validate(ctx, spec); err != nil {
+			return nil, fmt.Errorf("%w: parameter set %d: invalid job spec: %w", ErrInvalidJobTemplate, i, err)
+		}
+		jobs[i].SpecVersion = &SpecVersion{Spec: spec}
+	}
+	return jobs, nil
+}
+
+// createJobs creates and links the jobs within tx. It returns the funcs starting their services, which must only be
+// called once tx has been committed.
+func (m *TemplateManager) createJobs(ctx context.Context, tx sqlutil.DataSource, templateID int32, jobs []Job, paramSets []JSONConfig) ([]func(context.Context) error, error) {
+	orm := NewTemplateORM(tx)
+	starts := make([]func(context.Context) error, len(jobs))
+	for i := range jobs {
+		start, err := m.spawner.CreateJobInTx(ctx, tx, &jobs[i])
+		if err != nil {
+			return nil, fmt.Errorf("parameter set %d: failed to create job: %w", i, err)
+		}
+		starts[i] = start
+		if err := orm.LinkJob(ctx, templateID, jobs[i].ID, paramSets[i]); err != nil {
+			return nil, err
+		}
+	}
+	return starts, nil
+}
+
+// startJobs starts the services of committed jobs. All jobs are started, even if some fail to start.
+func startJobs(ctx context.Context, jobs []Job, starts []func(context.Context) error) (err error) {
+	for i, start := range starts {
+		if serr := start(ctx); serr != nil {
+			err = multierr.Append(err, fmt.Errorf("failed to start services of job %d: %w", jobs[i].ID, serr))
+		}
+	}
+	return err
+}
@@ -0,0 +1,62 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	tmpl := `
+type            = "fluxmonitor"
+schemaVersion   = 1
+name            = "{{ .name }}"
+contractAddress = "{{ .contractAddress }}"
+threshold       = {{ .threshold }}
+`
+
+	t.Run("renders parameters", func(t *testing.T) {
+		spec, err := job.RenderTemplate(tmpl, job.JSONConfig{
+			"name":            "ETH/USD",
+			"contractAddress": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42",
+			"threshold":       0.5,
+		})
+		require.NoError(t, err)
+		assert.Contains(t, spec, `name            = "ETH/USD"`)
+		assert.Contains(t, spec, `contractAddress = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"`)
+		assert.Contains(t, spec, `threshold       = 0.5`)
+	})
+
+	t.Run("missing parameter", func(t *testing.T) {
+		_, err := job.RenderTemplate(tmpl, job.JSONConfig{"name": "ETH/USD", "threshold": 0.5})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "contractAddress")
+	})
+
+	t.Run("rejects parameters that could change the structure of the spec", func(t *testing.T) {
+		for _, value := range []interface{}{
+			"ETH/USD\"\nmaxTaskDuration = \"1h",
+			"ETH/USD\nmaxTaskDuration = \"1h\"",
+			`ETH/USD\`,
+			"ETH/USD'",
+			[]interface{}{"ETH", "USD"},
+			map[string]interface{}{"name": "ETH/USD"},
+		} {
+			_, err := job.RenderTemplate(tmpl, job.JSONConfig{
+				"name":            value,
+				"contractAddress": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42",
+				"threshold":       0.5,
+			})
+			require.Error(t, err, value)
+			assert.Contains(t, err.Error(), `parameter "name"`)
+		}
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		_, err := job.RenderTemplate(`name = "{{ .name "`, job.JSONConfig{"name": "ETH/USD"})
+		require.Error(t, err)
+	})
+}
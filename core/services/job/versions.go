@@ -0,0 +1,75 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/kylelemons/godebug/diff"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// SpecVersion is an immutable version of the TOML spec of a locally managed job. A new version is saved every time
+// the job is created, updated or rolled back.
+type SpecVersion struct {
+	ID        int64     `db:"id"`
+	JobID     int32     `db:"job_id"`
+	Version   int32     `db:"version"`
+	Spec      string    `db:"spec"`
+	Author    string    `db:"author"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// DiffSpecVersions returns a line diff of the specs of two versions, where removed lines are prefixed with "-" and
+// added lines with "+".
+func DiffSpecVersions(from, to SpecVersion) string {
+	return diff.Diff(from.Spec, to.Spec)
+}
+
+type VersionORM interface {
+	// InsertVersion saves spec as the next version of the job.
+	InsertVersion(ctx context.Context, jobID int32, spec, author string) (SpecVersion, error)
+	FindVersions(ctx context.Context, jobID int32) ([]SpecVersion, error)
+	FindVersion(ctx context.Context, jobID, version int32) (SpecVersion, error)
+	FindLatestVersion(ctx context.Context, jobID int32) (SpecVersion, error)
+}
+
+type versionORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ VersionORM = (*versionORM)(nil)
+
+func NewVersionORM(ds sqlutil.DataSource) VersionORM {
+	return &versionORM{ds: ds}
+}
+
+func (o *versionORM) InsertVersion(ctx context.Context, jobID int32, spec, author string) (v SpecVersion, err error) {
+	// The counter row stays locked until the transaction ends, so concurrent inserts for the same job are serialized.
+	stmt := `WITH counter AS (
+			INSERT INTO job_spec_version_counters (job_id, last_version) VALUES ($1, 1)
+			ON CONFLICT (job_id) DO UPDATE SET last_version = job_spec_version_counters.last_version + 1
+			RETURNING last_version
+		)
+		INSERT INTO job_spec_versions (job_id, version, spec, author, created_at)
+		SELECT $1, last_version, $2, $3, NOW() FROM counter
+		RETURNING *;`
+	err = o.ds.GetContext(ctx, &v, stmt, jobID, spec, author)
+	return v, errors.Wrapf(err, "failed to insert spec version of job %d", jobID)
+}
+
+func (o *versionORM) FindVersions(ctx context.Context, jobID int32) (vs []SpecVersion, err error) {
+	err = o.ds.SelectContext(ctx, &vs, `SELECT * FROM job_spec_versions WHERE job_id = $1 ORDER BY version`, jobID)
+	return vs, errors.Wrapf(err, "failed to find spec versions of job %d", jobID)
+}
+
+func (o *versionORM) FindVersion(ctx context.Context, jobID, version int32) (v SpecVersion, err error) {
+	err = o.ds.GetContext(ctx, &v, `SELECT * FROM job_spec_versions WHERE job_id = $1 AND version = $2`, jobID, version)
+	return v, errors.Wrapf(err, "failed to find version %d of job %d", version, jobID)
+}
+
+func (o *versionORM) FindLatestVersion(ctx context.Context, jobID int32) (v SpecVersion, err error) {
+	err = o.ds.GetContext(ctx, &v, `SELECT * FROM job_spec_versions WHERE job_id = $1 ORDER BY version DESC LIMIT 1`, jobID)
+	return v, errors.Wrapf(err, "failed to find latest version of job %d", jobID)
+}
@@ -0,0 +1,21 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+func TestDiffSpecVersions(t *testing.T) {
+	from := job.SpecVersion{Version: 1, Spec: "type = \"webhook\"\nname = \"a\"\n"}
+	to := job.SpecVersion{Version: 2, Spec: "type = \"webhook\"\nname = \"b\"\n"}
+
+	d := job.DiffSpecVersions(from, to)
+	assert.Contains(t, d, `-name = "a"`)
+	assert.Contains(t, d, `+name = "b"`)
+	assert.Contains(t, d, ` type = "webhook"`)
+
+	assert.NotContains(t, job.DiffSpecVersions(to, to), "+")
+}
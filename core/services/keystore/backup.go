@@ -0,0 +1,269 @@
+package keystore
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys"
+)
+
+// BackupVersion is the version of the backups written by ExportBackup. RestoreBackup rejects other versions.
+const BackupVersion = 1
+
+// backupShareKeyType is the key type of the secret shares of a backup.
+const backupShareKeyType = "keystoreBackup"
+
+// backupSecretSize is the size of the random secret encrypting a backup split into shares.
+const backupSecretSize = 32
+
+// Backup is a password-encrypted backup of every key in the keystore, along with the Eth key states.
+type Backup struct {
+	Version   int                     `json:"version"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Shares    *BackupShares           `json:"shares,omitempty"`
+	Crypto    gethkeystore.CryptoJSON `json:"crypto"`
+}
+
+// BackupShares describes the Shamir shares of the secret a Backup is encrypted with, when it was exported with
+// ExportBackupShares instead of a password.
+type BackupShares struct {
+	ID        string `json:"id"`
+	Threshold int    `json:"threshold"`
+	Total     int    `json:"total"`
+}
+
+// backupContents is the plaintext of Backup.Crypto.
+type backupContents struct {
+	// KeyRing is the key ring JSON, as encrypted in the encrypted_key_rings table.
+	KeyRing      json.RawMessage     `json:"keyRing"`
+	EthKeyStates []backupEthKeyState `json:"ethKeyStates"`
+}
+
+type backupEthKeyState struct {
+	Address    types.EIP55Address `json:"address"`
+	EVMChainID ubig.Big           `json:"evmChainID"`
+	Disabled   bool               `json:"disabled"`
+}
+
+// BackupSummary counts the keys of each type in a restored backup.
+type BackupSummary struct {
+	Keys         map[string]int
+	EthKeyStates int
+}
+
+// ExportBackup returns every key in the keystore, and the chains each Eth key is enabled or disabled for, as a JSON
+// Backup encrypted with password.
+func (km *keyManager) ExportBackup(ctx context.Context, password string) ([]byte, error) {
+	if password == "" {
+		return nil, errors.New("backup password cannot be empty")
+	}
+	return km.exportBackup(password, nil)
+}
+
+// ExportBackupShares is like ExportBackup, but encrypts the Backup with a random secret, which is split into one
+// Shamir share per password, each encrypted with its password. Any threshold of the shares, with their passwords, are
+// required to restore the Backup with RestoreBackupFromShares.
+func (km *keyManager) ExportBackupShares(ctx context.Context, passwords []string, threshold int) (backup []byte, shares [][]byte, err error) {
+	secret := make([]byte, backupSecretSize)
+	if _, err = rand.Read(secret); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate backup secret")
+	}
+	id := uuid.NewString()
+	shares, err = keys.ToSecretSharesJSON(backupShareKeyType, id, secret, passwords, threshold, km.scryptParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	backup, err = km.exportBackup(hex.EncodeToString(secret), &BackupShares{ID: id, Threshold: threshold, Total: len(passwords)})
+	if err != nil {
+		return nil, nil, err
+	}
+	return backup, shares, nil
+}
+
+func (km *keyManager) exportBackup(password string, shares *BackupShares) ([]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	if km.isLocked() {
+		return nil, ErrLocked
+	}
+
+	keyRingJSON, err := km.keyRing.marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal key ring")
+	}
+	contents := backupContents{KeyRing: keyRingJSON, EthKeyStates: []backupEthKeyState{}}
+	for _, state := range km.keyStates.All {
+		contents.EthKeyStates = append(contents.EthKeyStates, backupEthKeyState{
+			Address:    state.Address,
+			EVMChainID: state.EVMChainID,
+			Disabled:   state.Disabled,
+		})
+	}
+	plaintext, err := json.Marshal(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoJSON, err := gethkeystore.EncryptDataV3(plaintext, []byte(backupPassword(password)), km.scryptParams.N, km.scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt backup")
+	}
+	return json.Marshal(Backup{
+		Version:   BackupVersion,
+		CreatedAt: time.Now().UTC(),
+		Shares:    shares,
+		Crypto:    cryptoJSON,
+	})
+}
+
+// RestoreBackup decrypts backupJSON with password, and stores its keys and Eth key states in a single database
+// transaction. The keystore must be unlocked, and must not hold any key or Eth key state. The restored keys are
+// encrypted with the password the keystore was unlocked with.
+func (km *keyManager) RestoreBackup(ctx context.Context, backupJSON []byte, password string) (BackupSummary, error) {
+	backup, err := parseBackup(backupJSON)
+	if err != nil {
+		return BackupSummary{}, err
+	}
+	if backup.Shares != nil {
+		return BackupSummary{}, errors.Errorf("backup was split into %d shares, restore it with %d of them", backup.Shares.Total, backup.Shares.Threshold)
+	}
+	return km.restoreBackup(ctx, backup, password)
+}
+
+// RestoreBackupFromShares is like RestoreBackup, for a Backup exported with ExportBackupShares. shares must hold at
+// least the threshold number of its secret shares, and passwords the password of each share, in the same order.
+func (km *keyManager) RestoreBackupFromShares(ctx context.Context, backupJSON []byte, shares [][]byte, passwords []string) (BackupSummary, error) {
+	backup, err := parseBackup(backupJSON)
+	if err != nil {
+		return BackupSummary{}, err
+	}
+	if backup.Shares == nil {
+		return BackupSummary{}, errors.New("backup was not split into shares, restore it with its password")
+	}
+	secret, err := keys.FromSecretSharesJSON(backupShareKeyType, backup.Shares.ID, backup.Shares.Threshold, backup.Shares.Total, shares, passwords)
+	if err != nil {
+		return BackupSummary{}, err
+	}
+	return km.restoreBackup(ctx, backup, hex.EncodeToString(secret))
+}
+
+func parseBackup(backupJSON []byte) (backup Backup, err error) {
+	if err = json.Unmarshal(backupJSON, &backup); err != nil {
+		return backup, errors.Wrap(err, "invalid backup")
+	}
+	if backup.Version != BackupVersion {
+		return backup, errors.Errorf("unsupported backup version %d, expected %d", backup.Version, BackupVersion)
+	}
+	return backup, nil
+}
+
+func (km *keyManager) restoreBackup(ctx context.Context, backup Backup, password string) (BackupSummary, error) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return BackupSummary{}, ErrLocked
+	}
+	if !km.keyRing.isEmpty() || len(km.keyStates.All) > 0 {
+		return BackupSummary{}, errors.New("keystore is not empty, backups can only be restored into an empty keystore")
+	}
+
+	plaintext, err := gethkeystore.DecryptDataV3(backup.Crypto, backupPassword(password))
+	if err != nil {
+		return BackupSummary{}, errors.Wrap(err, "could not decrypt backup")
+	}
+	var contents backupContents
+	if err = json.Unmarshal(plaintext, &contents); err != nil {
+		return BackupSummary{}, errors.Wrap(err, "invalid backup contents")
+	}
+	restored, err := unmarshalKeyRing(contents.KeyRing)
+	if err != nil {
+		return BackupSummary{}, errors.Wrap(err, "invalid backup key ring")
+	}
+	for _, state := range contents.EthKeyStates {
+		if _, found := restored.Eth[state.Address.Hex()]; !found {
+			return BackupSummary{}, errors.Errorf("backup has a key state for %s, but no such eth key", state.Address)
+		}
+	}
+	restored.setRemoteSigner(km.signer)
+
+	ekr, err := restored.Encrypt(km.password, km.scryptParams)
+	if err != nil {
+		return BackupSummary{}, errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	err = km.orm.saveEncryptedKeyRing(ctx, &ekr, func(ds sqlutil.DataSource) error {
+		var exists bool
+		if err2 := ds.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM evm.key_states)`); err2 != nil {
+			return errors.Wrap(err2, "failed to check evm.key_states")
+		}
+		if exists {
+			return errors.New("evm.key_states is not empty, backups can only be restored into an empty keystore")
+		}
+		for _, state := range contents.EthKeyStates {
+			_, err2 := ds.ExecContext(ctx, `INSERT INTO evm.key_states (address, disabled, evm_chain_id, created_at, updated_at)
+				VALUES ($1, $2, $3, NOW(), NOW())`, state.Address, state.Disabled, state.EVMChainID.String())
+			if err2 != nil {
+				return errors.Wrap(err2, "failed to insert key_state")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return BackupSummary{}, errors.Wrap(err, "unable to restore backup")
+	}
+
+	keyStates, err := km.keystateORM.loadKeyStates(ctx)
+	if err != nil {
+		return BackupSummary{}, errors.Wrap(err, "unable to load key states")
+	}
+	km.keyRing = restored
+	km.keyStates = keyStates
+	restored.logPubKeys(km.logger)
+
+	return BackupSummary{Keys: restored.counts(), EthKeyStates: len(keyStates.All)}, nil
+}
+
+func backupPassword(password string) string {
+	return "keystorebackup" + password
+}
+
+// counts returns the number of keys of each type, by key ring field name.
+func (kr *keyRing) counts() map[string]int {
+	return map[string]int{
+		"CSA":      len(kr.CSA),
+		"Eth":      len(kr.Eth),
+		"OCR":      len(kr.OCR),
+		"OCR2":     len(kr.OCR2),
+		"P2P":      len(kr.P2P),
+		"Cosmos":   len(kr.Cosmos),
+		"Solana":   len(kr.Solana),
+		"StarkNet": len(kr.StarkNet),
+		"Aptos":    len(kr.Aptos),
+		"VRF":      len(kr.VRF),
+	}
+}
+
+func (kr *keyRing) isEmpty() bool {
+	for _, n := range kr.counts() {
+		if n > 0 {
+			return false
+		}
+	}
+	return len(kr.LegacyKeys.legacyRawKeys) == 0
+}
+
+func (s BackupSummary) String() string {
+	return fmt.Sprintf("%d CSA, %d Eth (%d key states), %d OCR, %d OCR2, %d P2P, %d Cosmos, %d Solana, %d StarkNet, %d Aptos and %d VRF keys",
+		s.Keys["CSA"], s.Keys["Eth"], s.EthKeyStates, s.Keys["OCR"], s.Keys["OCR2"], s.Keys["P2P"], s.Keys["Cosmos"], s.Keys["Solana"], s.Keys["StarkNet"], s.Keys["Aptos"], s.Keys["VRF"])
+}
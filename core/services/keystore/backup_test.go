@@ -0,0 +1,198 @@
+package keystore_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys"
+)
+
+func TestMasterKeystore_Backup(t *testing.T) {
+	t.Parallel()
+
+	const backupPassword = "backup-password"
+	ctx := testutils.Context(t)
+	chainID1, chainID2 := big.NewInt(1), big.NewInt(2)
+
+	source := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+	csaKey, err := source.CSA().Create(ctx)
+	require.NoError(t, err)
+	ethKey, err := source.Eth().Create(ctx, chainID1, chainID2)
+	require.NoError(t, err)
+	require.NoError(t, source.Eth().Disable(ctx, ethKey.Address, chainID2))
+	ocrKey, err := source.OCR().Create(ctx)
+	require.NoError(t, err)
+	ocr2Key, err := source.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	p2pKey, err := source.P2P().Create(ctx)
+	require.NoError(t, err)
+	solanaKey, err := source.Solana().Create(ctx)
+	require.NoError(t, err)
+	cosmosKey, err := source.Cosmos().Create(ctx)
+	require.NoError(t, err)
+	starknetKey, err := source.StarkNet().Create(ctx)
+	require.NoError(t, err)
+	aptosKey, err := source.Aptos().Create(ctx)
+	require.NoError(t, err)
+	vrfKey, err := source.VRF().Create(ctx)
+	require.NoError(t, err)
+
+	backup, err := source.ExportBackup(ctx, backupPassword)
+	require.NoError(t, err)
+	var decoded keystore.Backup
+	require.NoError(t, json.Unmarshal(backup, &decoded))
+	assert.Equal(t, keystore.BackupVersion, decoded.Version)
+	assert.NotContains(t, string(backup), ethKey.Address.Hex())
+
+	t.Run("restores every key and the eth key states", func(t *testing.T) {
+		target := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+		summary, err := target.RestoreBackup(ctx, backup, backupPassword)
+		require.NoError(t, err)
+		assert.Equal(t, 2, summary.EthKeyStates)
+		for keyType, n := range summary.Keys {
+			assert.Equal(t, 1, n, keyType)
+		}
+
+		_, err = target.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+		_, err = target.OCR().Get(ocrKey.ID())
+		require.NoError(t, err)
+		restoredOCR2, err := target.OCR2().Get(ocr2Key.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ocr2Key.OnChainPublicKey(), restoredOCR2.OnChainPublicKey())
+		_, err = target.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		_, err = target.Solana().Get(solanaKey.ID())
+		require.NoError(t, err)
+		_, err = target.Cosmos().Get(cosmosKey.ID())
+		require.NoError(t, err)
+		_, err = target.StarkNet().Get(starknetKey.ID())
+		require.NoError(t, err)
+		_, err = target.Aptos().Get(aptosKey.ID())
+		require.NoError(t, err)
+		_, err = target.VRF().Get(vrfKey.ID())
+		require.NoError(t, err)
+
+		require.NoError(t, target.Eth().CheckEnabled(ctx, ethKey.Address, chainID1))
+		require.ErrorContains(t, target.Eth().CheckEnabled(ctx, ethKey.Address, chainID2), "is disabled for chain 2")
+
+		_, err = target.RestoreBackup(ctx, backup, backupPassword)
+		require.ErrorContains(t, err, "keystore is not empty")
+	})
+
+	t.Run("rejects the wrong password", func(t *testing.T) {
+		target := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+		_, err := target.RestoreBackup(ctx, backup, "wrong-password")
+		require.ErrorContains(t, err, "could not decrypt backup")
+		ethKeys, err := target.Eth().GetAll(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, ethKeys)
+	})
+
+	t.Run("rejects unknown versions", func(t *testing.T) {
+		target := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+		decoded.Version = keystore.BackupVersion + 1
+		b, err := json.Marshal(decoded)
+		require.NoError(t, err)
+		_, err = target.RestoreBackup(ctx, b, backupPassword)
+		require.ErrorContains(t, err, "unsupported backup version 2")
+	})
+
+	t.Run("requires a password", func(t *testing.T) {
+		_, err := source.ExportBackup(ctx, "")
+		require.ErrorContains(t, err, "backup password cannot be empty")
+	})
+}
+
+func TestMasterKeystore_BackupShares(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	source := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+	ethKey, err := source.Eth().Create(ctx, big.NewInt(1))
+	require.NoError(t, err)
+	csaKey, err := source.CSA().Create(ctx)
+	require.NoError(t, err)
+
+	passwords := []string{"alice", "bob", "carol", "dave", "erin"}
+	backup, shares, err := source.ExportBackupShares(ctx, passwords, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+	for i, share := range shares {
+		var decoded keys.SecretShare
+		require.NoError(t, json.Unmarshal(share, &decoded))
+		assert.NotEmpty(t, decoded.Crypto.CipherText, i)
+	}
+	var decoded keystore.Backup
+	require.NoError(t, json.Unmarshal(backup, &decoded))
+	require.NotNil(t, decoded.Shares)
+	assert.Equal(t, 3, decoded.Shares.Threshold)
+	assert.Equal(t, 5, decoded.Shares.Total)
+
+	t.Run("restores from a quorum of shares", func(t *testing.T) {
+		target := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+		summary, err := target.RestoreBackupFromShares(ctx, backup, [][]byte{shares[4], shares[0], shares[2]}, []string{"erin", "alice", "carol"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Keys["Eth"])
+		assert.Equal(t, 1, summary.EthKeyStates)
+
+		_, err = target.Eth().Get(ctx, ethKey.Address.Hex())
+		require.NoError(t, err)
+		_, err = target.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects a share with the password of another holder", func(t *testing.T) {
+		target := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+		_, err := target.RestoreBackupFromShares(ctx, backup, shares[:3], []string{"alice", "carol", "bob"})
+		require.ErrorContains(t, err, "failed to decrypt keystoreBackup secret share 2")
+	})
+
+	t.Run("rejects fewer shares than the threshold", func(t *testing.T) {
+		target := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+		_, err := target.RestoreBackupFromShares(ctx, backup, shares[:2], passwords[:2])
+		require.ErrorContains(t, err, "3 of 5 keystoreBackup secret shares required, 2 provided")
+	})
+
+	t.Run("rejects a share with a forged threshold", func(t *testing.T) {
+		var forged keys.SecretShare
+		require.NoError(t, json.Unmarshal(shares[0], &forged))
+		forged.Threshold = 1
+		forgedJSON, err := json.Marshal(forged)
+		require.NoError(t, err)
+		target := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+		_, err = target.RestoreBackupFromShares(ctx, backup, [][]byte{forgedJSON}, passwords[:1])
+		require.ErrorContains(t, err, "3 of 5 keystoreBackup secret shares required, 1 provided")
+		_, err = target.RestoreBackupFromShares(ctx, backup, [][]byte{forgedJSON, shares[1], shares[2]}, passwords[:3])
+		require.ErrorContains(t, err, "secret share 1 is one of 5 shares with threshold 1, expected 5 with threshold 3")
+	})
+
+	t.Run("rejects shares of another backup", func(t *testing.T) {
+		_, otherShares, err := source.ExportBackupShares(ctx, passwords[:3], 2)
+		require.NoError(t, err)
+		target := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+		_, err = target.RestoreBackupFromShares(ctx, backup, [][]byte{shares[0], otherShares[0], otherShares[1]}, passwords[:3])
+		require.ErrorContains(t, err, "secret share 2 belongs to")
+	})
+
+	t.Run("rejects passwords", func(t *testing.T) {
+		target := cltest.NewKeyStore(t, pgtest.NewSqlxDB(t))
+		_, err := target.RestoreBackup(ctx, backup, "password")
+		require.ErrorContains(t, err, "backup was split into 5 shares, restore it with 3 of them")
+	})
+
+	t.Run("rejects a single share holder", func(t *testing.T) {
+		_, _, err := source.ExportBackupShares(ctx, passwords[:3], 1)
+		require.ErrorContains(t, err, "threshold must be between 2 and the number of shares (3), got 1")
+	})
+}
@@ -2,11 +2,13 @@ package keystore
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 )
 
 // ErrCSAKeyExists describes the error when the CSA key already exists
@@ -20,8 +22,11 @@ type CSA interface {
 	Add(ctx context.Context, key csakey.KeyV2) error
 	Delete(ctx context.Context, id string) (csakey.KeyV2, error)
 	Import(ctx context.Context, keyJSON []byte, password string) (csakey.KeyV2, error)
+	AddRemote(ctx context.Context, signerKeyID string) (csakey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
 	EnsureKey(ctx context.Context) error
+	// Sign signs msg with the CSA key id, either locally or with the remote signer that holds it.
+	Sign(ctx context.Context, id string, msg []byte) ([]byte, error)
 }
 
 type csa struct {
@@ -120,6 +125,35 @@ func (ks *csa) Import(ctx context.Context, keyJSON []byte, password string) (csa
 	return key, ks.keyManager.safeAddKey(ctx, key)
 }
 
+// AddRemote adds the ed25519 key held by the remote signer under signerKeyID as the CSA key. Only its public key is
+// stored in the keystore.
+func (ks *csa) AddRemote(ctx context.Context, signerKeyID string) (csakey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return csakey.KeyV2{}, ErrLocked
+	}
+	if len(ks.keyRing.CSA) > 0 {
+		return csakey.KeyV2{}, ErrCSAKeyExists
+	}
+	if ks.signer == nil {
+		return csakey.KeyV2{}, signer.ErrNotConfigured
+	}
+	pk, err := ks.signer.PublicKey(ctx, signerKeyID)
+	if err != nil {
+		return csakey.KeyV2{}, errors.Wrapf(err, "failed to get public key of %s", signerKeyID)
+	}
+	if err = signer.CheckPublicKey(pk, signer.Ed25519); err != nil {
+		return csakey.KeyV2{}, err
+	}
+	key := csakey.FromRemoteSigner(pk.Bytes, signerKeyID)
+	if err = ks.safeAddKey(ctx, key); err != nil {
+		return csakey.KeyV2{}, err
+	}
+	ks.logger.Infow(fmt.Sprintf("Added remote CSA key with ID %s", key.ID()), "signerKeyID", signerKeyID)
+	return key, nil
+}
+
 func (ks *csa) Export(id string, password string) ([]byte, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -130,9 +164,39 @@ func (ks *csa) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if _, remote := key.SignerKeyID(); remote {
+		return nil, ErrRemoteKey
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
+func (ks *csa) Sign(ctx context.Context, id string, msg []byte) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(id)
+	if err != nil {
+		return nil, err
+	}
+	signerKeyID, remote := key.SignerKeyID()
+	if !remote {
+		return key.Sign(msg)
+	}
+	if ks.signer == nil {
+		return nil, signer.ErrNotConfigured
+	}
+	sig, err := ks.signer.Sign(ctx, signerKeyID, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer failed to sign")
+	}
+	if len(sig) != ed25519.SignatureSize {
+		return nil, errors.Errorf("remote signer returned a %d byte signature", len(sig))
+	}
+	return sig, nil
+}
+
 // EnsureKey verifies whether the CSA key has been seeded, if not, it creates it.
 func (ks *csa) EnsureKey(ctx context.Context) error {
 	ks.lock.Lock()
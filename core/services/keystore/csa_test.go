@@ -2,6 +2,7 @@ package keystore_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"testing"
 
@@ -12,8 +13,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+	clutils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func Test_CSAKeyStore_E2E(t *testing.T) {
@@ -177,3 +181,60 @@ func Test_CSAKeyStore_E2E(t *testing.T) {
 		require.Equal(t, 1, len(keys))
 	})
 }
+
+func Test_CSAKeyStore_AddRemote(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	s := signer.NewLocal()
+	keyStore := keystore.NewWithRemoteSigner(db, clutils.FastScryptParams, s, logger.TestLogger(t))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ks := keyStore.CSA()
+
+	_, err := ks.AddRemote(ctx, s.MustCreate(signer.Secp256k1))
+	require.ErrorContains(t, err, "expected a ed25519 key but got secp256k1")
+	_, err = ks.AddRemote(ctx, "unknown")
+	require.ErrorIs(t, err, signer.ErrKeyNotFound)
+
+	signerKeyID := s.MustCreate(signer.Ed25519)
+	k, err := ks.AddRemote(ctx, signerKeyID)
+	require.NoError(t, err)
+	id, remote := k.SignerKeyID()
+	require.True(t, remote)
+	require.Equal(t, signerKeyID, id)
+
+	_, err = ks.AddRemote(ctx, s.MustCreate(signer.Ed25519))
+	require.ErrorIs(t, err, keystore.ErrCSAKeyExists)
+
+	t.Run("signs messages", func(t *testing.T) {
+		sig, err := ks.Sign(ctx, k.ID(), []byte("hello"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(k.PublicKey, []byte("hello"), sig))
+	})
+
+	t.Run("cannot be exported", func(t *testing.T) {
+		_, err := ks.Export(k.ID(), cltest.Password)
+		require.ErrorIs(t, err, keystore.ErrRemoteKey)
+	})
+
+	t.Run("is restored as a remote key", func(t *testing.T) {
+		reloaded := keystore.NewWithRemoteSigner(db, clutils.FastScryptParams, s, logger.TestLogger(t))
+		require.NoError(t, reloaded.Unlock(ctx, cltest.Password))
+		key, err := reloaded.CSA().Get(k.ID())
+		require.NoError(t, err)
+		id, remote := key.SignerKeyID()
+		require.True(t, remote)
+		require.Equal(t, signerKeyID, id)
+		sig, err := reloaded.CSA().Sign(ctx, k.ID(), []byte("hello"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(k.PublicKey, []byte("hello"), sig))
+	})
+
+	t.Run("fails to sign without a remote signer", func(t *testing.T) {
+		local := keystore.New(db, clutils.FastScryptParams, logger.TestLogger(t))
+		require.NoError(t, local.Unlock(ctx, cltest.Password))
+		_, err := local.CSA().Sign(ctx, k.ID(), []byte("hello"))
+		require.ErrorIs(t, err, signer.ErrNotConfigured)
+	})
+}
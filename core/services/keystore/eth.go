@@ -8,13 +8,16 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -25,6 +28,7 @@ type Eth interface {
 	Create(ctx context.Context, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Delete(ctx context.Context, id string) (ethkey.KeyV2, error)
 	Import(ctx context.Context, keyJSON []byte, password string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
+	AddRemote(ctx context.Context, signerKeyID string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Export(ctx context.Context, id string, password string) ([]byte, error)
 
 	Enable(ctx context.Context, address common.Address, chainID *big.Int) error
@@ -35,6 +39,7 @@ type Eth interface {
 	SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, unsub func())
 
 	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error)
 
 	EnabledKeysForChain(ctx context.Context, chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
@@ -168,6 +173,41 @@ func (ks *eth) Import(ctx context.Context, keyJSON []byte, password string, chai
 	return key, nil
 }
 
+// AddRemote adds the secp256k1 key held by the remote signer under signerKeyID and enables it for the given chain IDs.
+// Only its address is stored in the keystore.
+func (ks *eth) AddRemote(ctx context.Context, signerKeyID string, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	if ks.signer == nil {
+		return ethkey.KeyV2{}, signer.ErrNotConfigured
+	}
+	pk, err := ks.signer.PublicKey(ctx, signerKeyID)
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrapf(err, "failed to get public key of %s", signerKeyID)
+	}
+	if err = signer.CheckPublicKey(pk, signer.Secp256k1); err != nil {
+		return ethkey.KeyV2{}, err
+	}
+	pub, err := crypto.UnmarshalPubkey(pk.Bytes)
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "invalid public key")
+	}
+	key := ethkey.FromRemoteSigner(crypto.PubkeyToAddress(*pub), signerKeyID)
+	if _, found := ks.keyRing.Eth[key.ID()]; found {
+		return ethkey.KeyV2{}, ErrKeyExists
+	}
+	err = ks.add(ctx, key, chainIDs...)
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "unable to add eth key")
+	}
+	ks.notify()
+	ks.logger.Infow(fmt.Sprintf("Added remote EVM key with ID %s", key.Address.Hex()), "address", key.Address.Hex(), "signerKeyID", signerKeyID, "evmChainIDs", chainIDs)
+	return key, nil
+}
+
 func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -178,6 +218,9 @@ func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte,
 	if err != nil {
 		return nil, err
 	}
+	if _, remote := key.SignerKeyID(); remote {
+		return nil, ErrRemoteKey
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
@@ -317,8 +360,54 @@ func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Tra
 	if err != nil {
 		return nil, err
 	}
-	signer := types.LatestSignerForChainID(chainID)
-	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+	txSigner := types.LatestSignerForChainID(chainID)
+	if signerKeyID, remote := key.SignerKeyID(); remote {
+		sig, err := ks.signRemote(ctx, signerKeyID, txSigner.Hash(tx).Bytes())
+		if err != nil {
+			return nil, err
+		}
+		return tx.WithSignature(txSigner, sig)
+	}
+	return types.SignTx(tx, txSigner, key.ToEcdsaPrivKey())
+}
+
+// SignMessage signs data as an EIP-191 personal message, which is what smart accounts expect from their signers.
+func (ks *eth) SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	key, err := ks.getByID(address.String())
+	if err != nil {
+		return nil, err
+	}
+	var sig []byte
+	if signerKeyID, remote := key.SignerKeyID(); remote {
+		sig, err = ks.signRemote(ctx, signerKeyID, accounts.TextHash(data))
+	} else {
+		sig, err = crypto.Sign(accounts.TextHash(data), key.ToEcdsaPrivKey())
+	}
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// signRemote signs digest with the key held by the remote signer, and returns a [R || S || V] signature with V in {0, 1}.
+func (ks *eth) signRemote(ctx context.Context, signerKeyID string, digest []byte) ([]byte, error) {
+	if ks.signer == nil {
+		return nil, signer.ErrNotConfigured
+	}
+	sig, err := ks.signer.Sign(ctx, signerKeyID, digest)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer failed to sign")
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, errors.Errorf("remote signer returned a %d byte signature", len(sig))
+	}
+	return sig, nil
 }
 
 // EnabledKeysForChain returns all keys that are enabled for the given chain
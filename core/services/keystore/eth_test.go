@@ -9,7 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -20,8 +23,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+	clutils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func Test_EthKeyStore(t *testing.T) {
@@ -357,6 +363,104 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_SignMessage(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+
+	k, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+	data := []byte("hello")
+
+	_, err := ethKeyStore.SignMessage(ctx, testutils.NewAddress(), data)
+	require.EqualError(t, err, "Key not found")
+
+	sig, err := ethKeyStore.SignMessage(ctx, k.Address, data)
+	require.NoError(t, err)
+	require.Len(t, sig, 65)
+	assert.Contains(t, []byte{27, 28}, sig[64])
+
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(accounts.TextHash(data), sig)
+	require.NoError(t, err)
+	assert.Equal(t, k.Address, crypto.PubkeyToAddress(*pub))
+}
+
+func Test_EthKeyStore_AddRemote(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	s := signer.NewLocal()
+	keyStore := keystore.NewWithRemoteSigner(db, clutils.FastScryptParams, s, logger.TestLogger(t))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ethKeyStore := keyStore.Eth()
+	chainID := big.NewInt(evmclient.NullClientChainID)
+
+	signerKeyID := s.MustCreate(signer.Secp256k1)
+	k, err := ethKeyStore.AddRemote(ctx, signerKeyID, chainID)
+	require.NoError(t, err)
+	id, remote := k.SignerKeyID()
+	require.True(t, remote)
+	require.Equal(t, signerKeyID, id)
+	require.NoError(t, ethKeyStore.CheckEnabled(ctx, k.Address, chainID))
+
+	_, err = ethKeyStore.AddRemote(ctx, signerKeyID, chainID)
+	require.ErrorIs(t, err, keystore.ErrKeyExists)
+	_, err = ethKeyStore.AddRemote(ctx, s.MustCreate(signer.Ed25519), chainID)
+	require.ErrorContains(t, err, "expected a secp256k1 key but got ed25519")
+	_, err = ethKeyStore.AddRemote(ctx, "unknown", chainID)
+	require.ErrorIs(t, err, signer.ErrKeyNotFound)
+
+	t.Run("signs transactions", func(t *testing.T) {
+		tx := cltest.NewLegacyTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+		signed, err := ethKeyStore.SignTx(ctx, k.Address, tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, k.Address, sender)
+	})
+
+	t.Run("signs messages", func(t *testing.T) {
+		sig, err := ethKeyStore.SignMessage(ctx, k.Address, []byte("hello"))
+		require.NoError(t, err)
+		assert.Contains(t, []byte{27, 28}, sig[64])
+		sig[64] -= 27
+		pub, err := crypto.SigToPub(accounts.TextHash([]byte("hello")), sig)
+		require.NoError(t, err)
+		assert.Equal(t, k.Address, crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("cannot be exported", func(t *testing.T) {
+		_, err := ethKeyStore.Export(ctx, k.ID(), cltest.Password)
+		require.ErrorIs(t, err, keystore.ErrRemoteKey)
+	})
+
+	t.Run("is restored as a remote key", func(t *testing.T) {
+		reloaded := keystore.NewWithRemoteSigner(db, clutils.FastScryptParams, s, logger.TestLogger(t))
+		require.NoError(t, reloaded.Unlock(ctx, cltest.Password))
+		key, err := reloaded.Eth().Get(ctx, k.ID())
+		require.NoError(t, err)
+		id, remote := key.SignerKeyID()
+		require.True(t, remote)
+		require.Equal(t, signerKeyID, id)
+		_, err = reloaded.Eth().SignMessage(ctx, k.Address, []byte("hello"))
+		require.NoError(t, err)
+	})
+
+	t.Run("fails to sign without a remote signer", func(t *testing.T) {
+		local := keystore.New(db, clutils.FastScryptParams, logger.TestLogger(t))
+		require.NoError(t, local.Unlock(ctx, cltest.Password))
+		_, err := local.Eth().SignMessage(ctx, k.Address, []byte("hello"))
+		require.ErrorIs(t, err, signer.ErrNotConfigured)
+		_, err = local.Eth().AddRemote(ctx, signerKeyID, chainID)
+		require.ErrorIs(t, err, signer.ErrNotConfigured)
+	})
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	t.Parallel()
 
@@ -19,7 +19,7 @@ func mustNewEthKey(t *testing.T) *ethkey.KeyV2 {
 }
 
 func ExposedNewMaster(t *testing.T, ds sqlutil.DataSource) *master {
-	return newMaster(ds, utils.FastScryptParams, logger.TestLogger(t))
+	return newMaster(ds, utils.FastScryptParams, nil, logger.TestLogger(t))
 }
 
 func (m *master) ExportedSave(ctx context.Context) error {
@@ -1,6 +1,8 @@
 package csakey
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys"
@@ -22,6 +24,9 @@ func FromEncryptedJSON(keyJSON []byte, password string) (KeyV2, error) {
 }
 
 func (k KeyV2) ToEncryptedJSON(password string, scryptParams utils.ScryptParams) (export []byte, err error) {
+	if k.signerKeyID != "" {
+		return nil, fmt.Errorf("private key of CSA key %s is held by the remote signer", k.PublicKeyString())
+	}
 	return keys.ToEncryptedJSON(
 		keyTypeIdentifier,
 		k.Raw(),
@@ -38,6 +38,8 @@ type KeyV2 struct {
 	privateKey *ed25519.PrivateKey
 	PublicKey  ed25519.PublicKey
 	Version    int
+	// signerKeyID is set instead of privateKey for keys held by a remote signer
+	signerKeyID string
 }
 
 func (k KeyV2) StaticSizedPublicKey() (sspk credentials.StaticSizedPublicKey) {
@@ -60,6 +62,20 @@ func NewV2() (KeyV2, error) {
 	}, nil
 }
 
+// FromRemoteSigner returns a key whose private key is held by a remote signer under signerKeyID.
+func FromRemoteSigner(publicKey ed25519.PublicKey, signerKeyID string) KeyV2 {
+	return KeyV2{
+		PublicKey:   publicKey,
+		Version:     2,
+		signerKeyID: signerKeyID,
+	}
+}
+
+// SignerKeyID returns the ID of the key in the remote signer, if the key is held by one.
+func (k KeyV2) SignerKeyID() (string, bool) {
+	return k.signerKeyID, k.signerKeyID != ""
+}
+
 func MustNewV2XXXTestingOnly(k *big.Int) KeyV2 {
 	seed := make([]byte, ed25519.SeedSize)
 	copy(seed, k.Bytes())
@@ -79,10 +95,22 @@ func (k KeyV2) PublicKeyString() string {
 	return hex.EncodeToString(k.PublicKey)
 }
 
+// Raw returns the private key. It panics for keys held by a remote signer, check SignerKeyID first.
 func (k KeyV2) Raw() Raw {
+	if k.signerKeyID != "" {
+		panic(fmt.Sprintf("private key of CSA key %s is held by the remote signer", k.PublicKeyString()))
+	}
 	return Raw(*k.privateKey)
 }
 
+// Sign signs msg with the private key. Keys held by a remote signer must be signed with through the keystore.
+func (k KeyV2) Sign(msg []byte) ([]byte, error) {
+	if k.signerKeyID != "" {
+		return nil, fmt.Errorf("private key of CSA key %s is held by the remote signer", k.PublicKeyString())
+	}
+	return ed25519.Sign(*k.privateKey, msg), nil
+}
+
 func (k KeyV2) String() string {
 	return fmt.Sprintf("CSAKeyV2{PrivateKey: <redacted>, PublicKey: %s}", k.PublicKey)
 }
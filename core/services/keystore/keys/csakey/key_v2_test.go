@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestCSAKeyV2_RawPrivateKey(t *testing.T) {
@@ -41,3 +43,31 @@ func TestCSAKeyV2_NewV2(t *testing.T) {
 	assert.NotNil(t, keyV2.PublicKey)
 	assert.NotNil(t, keyV2.privateKey)
 }
+
+func TestCSAKeyV2_FromRemoteSigner(t *testing.T) {
+	pubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	keyV2 := FromRemoteSigner(pubKey, "signer-key-1")
+
+	id, remote := keyV2.SignerKeyID()
+	assert.True(t, remote)
+	assert.Equal(t, "signer-key-1", id)
+	assert.Equal(t, hex.EncodeToString(pubKey), keyV2.ID())
+	assert.Panics(t, func() { keyV2.Raw() })
+	_, err = keyV2.Sign([]byte("hello"))
+	require.ErrorContains(t, err, "held by the remote signer")
+	_, err = keyV2.ToEncryptedJSON("password", utils.FastScryptParams)
+	require.ErrorContains(t, err, "held by the remote signer")
+}
+
+func TestCSAKeyV2_Sign(t *testing.T) {
+	keyV2, err := NewV2()
+	require.NoError(t, err)
+
+	_, remote := keyV2.SignerKeyID()
+	assert.False(t, remote)
+	sig, err := keyV2.Sign([]byte("hello"))
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(keyV2.PublicKey, []byte("hello"), sig))
+}
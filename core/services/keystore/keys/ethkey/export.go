@@ -16,6 +16,9 @@ type EncryptedEthKeyExport struct {
 }
 
 func (key KeyV2) ToEncryptedJSON(password string, scryptParams utils.ScryptParams) (export []byte, err error) {
+	if key.signerKeyID != "" {
+		return nil, errors.Errorf("private key of %s is held by the remote signer", key.Address)
+	}
 	// DEV: uuid is derived directly from the address, since it is not stored internally
 	id, err := uuid.FromBytes(key.Address.Bytes()[:16])
 	if err != nil {
@@ -46,6 +46,8 @@ type KeyV2 struct {
 	Address      common.Address
 	EIP55Address types.EIP55Address
 	privateKey   *ecdsa.PrivateKey
+	// signerKeyID is set instead of privateKey for keys held by a remote signer
+	signerKeyID string
 }
 
 func NewV2() (KeyV2, error) {
@@ -66,14 +68,33 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromRemoteSigner returns a key whose private key is held by a remote signer under signerKeyID.
+func FromRemoteSigner(address common.Address, signerKeyID string) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: types.EIP55AddressFromAddress(address),
+		signerKeyID:  signerKeyID,
+	}
+}
+
+// SignerKeyID returns the ID of the key in the remote signer, if the key is held by one.
+func (key KeyV2) SignerKeyID() (string, bool) {
+	return key.signerKeyID, key.signerKeyID != ""
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
 
+// Raw returns the private key. It panics for keys held by a remote signer, check SignerKeyID first.
 func (key KeyV2) Raw() Raw {
+	if key.signerKeyID != "" {
+		panic(fmt.Sprintf("private key of %s is held by the remote signer", key.Address))
+	}
 	return key.privateKey.D.Bytes()
 }
 
+// ToEcdsaPrivKey returns the private key, or nil for keys held by a remote signer.
 func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 	return key.privateKey
 }
@@ -2,6 +2,7 @@ package keys
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/pkg/errors"
@@ -79,3 +80,115 @@ func ToEncryptedJSON[E Encrypted, K any](
 
 	return json.Marshal(encryptedKeyExport)
 }
+
+// SecretShare represents one of the Shamir shares of a secret protecting an export, encrypted with the password of
+// its holder
+type SecretShare struct {
+	KeyType   string              `json:"keyType"`
+	ID        string              `json:"id"`
+	Threshold int                 `json:"threshold"`
+	Total     int                 `json:"total"`
+	Crypto    keystore.CryptoJSON `json:"crypto"`
+}
+
+func (x SecretShare) GetCrypto() keystore.CryptoJSON {
+	return x.Crypto
+}
+
+// ToSecretSharesJSON splits secret into one JSON [SecretShare] of keyType per password, each encrypted with its
+// password, any threshold of which recover it. id identifies the secret, so that shares of different secrets are not
+// combined.
+func ToSecretSharesJSON(keyType string, id string, secret []byte, passwords []string, threshold int, scryptParams utils.ScryptParams) ([][]byte, error) {
+	for i, password := range passwords {
+		if password == "" {
+			return nil, errors.Errorf("password of %s secret share %d cannot be empty", keyType, i+1)
+		}
+	}
+	shares, err := SplitSecret(secret, len(passwords), threshold)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not split %s secret", keyType)
+	}
+
+	sharesJSON := make([][]byte, len(shares))
+	for i, share := range shares {
+		sharesJSON[i], err = ToEncryptedJSON(
+			fmt.Sprintf("%s secret share %d", keyType, i+1),
+			share,
+			share,
+			passwords[i],
+			scryptParams,
+			secretSharePassword,
+			func(_ string, _ []byte, cryptoJSON keystore.CryptoJSON) SecretShare {
+				return SecretShare{
+					KeyType:   keyType,
+					ID:        id,
+					Threshold: threshold,
+					Total:     len(shares),
+					Crypto:    cryptoJSON,
+				}
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sharesJSON, nil
+}
+
+// FromSecretSharesJSON recovers the secret of keyType identified by id, split into total shares any threshold of which
+// recover it, from a quorum of its JSON [SecretShare]s, each decrypted with the password at the same index of passwords.
+// threshold and total must come from a trusted source, such as the export the secret protects, not from the shares.
+func FromSecretSharesJSON(keyType string, id string, threshold int, total int, sharesJSON [][]byte, passwords []string) ([]byte, error) {
+	if threshold < 2 || threshold > total {
+		return nil, errors.Errorf("invalid %s secret shares threshold %d of %d", keyType, threshold, total)
+	}
+	if len(sharesJSON) < threshold {
+		return nil, errors.Errorf("%d of %d %s secret shares required, %d provided", threshold, total, keyType, len(sharesJSON))
+	}
+	if len(passwords) != len(sharesJSON) {
+		return nil, errors.Errorf("%d passwords provided for %d %s secret shares", len(passwords), len(sharesJSON), keyType)
+	}
+
+	for i, shareJSON := range sharesJSON {
+		var share SecretShare
+		if err := json.Unmarshal(shareJSON, &share); err != nil {
+			return nil, errors.Wrapf(err, "invalid secret share %d", i+1)
+		}
+		if share.KeyType != keyType {
+			return nil, errors.Errorf("secret share %d is a %s share, expected %s", i+1, share.KeyType, keyType)
+		}
+		if share.ID != id {
+			return nil, errors.Errorf("secret share %d belongs to %s, expected %s", i+1, share.ID, id)
+		}
+		if share.Threshold != threshold || share.Total != total {
+			return nil, errors.Errorf("secret share %d is one of %d shares with threshold %d, expected %d with threshold %d", i+1, share.Total, share.Threshold, total, threshold)
+		}
+	}
+
+	shares := make([][]byte, len(sharesJSON))
+	for i, shareJSON := range sharesJSON {
+		share, err := FromEncryptedJSON(
+			fmt.Sprintf("%s secret share %d", keyType, i+1),
+			shareJSON,
+			passwords[i],
+			secretSharePassword,
+			func(_ SecretShare, rawShare []byte) ([]byte, error) {
+				return rawShare, nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = share
+	}
+
+	secret, err := CombineShares(shares)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not combine %s secret shares", keyType)
+	}
+	return secret, nil
+}
+
+func secretSharePassword(password string) string {
+	return "secretshare" + password
+}
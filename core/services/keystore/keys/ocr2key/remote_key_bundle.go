@@ -0,0 +1,244 @@
+package ocr2key
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/salsa20/salsa"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+)
+
+// RemoteKeys references the keys of an OCR2 key bundle that are held by a remote signer. The public keys are kept
+// alongside, so that the bundle can be loaded without contacting the signer.
+type RemoteKeys struct {
+	ID        models.Sha256Hash
+	ChainType chaintype.ChainType
+
+	OnchainSigningKeyID   string
+	OffchainSigningKeyID  string
+	ConfigEncryptionKeyID string
+
+	OnchainAddress            common.Address
+	OffchainPublicKey         ocrtypes.OffchainPublicKey
+	ConfigEncryptionPublicKey ocrtypes.ConfigEncryptionPublicKey
+}
+
+var _ KeyBundle = &remoteKeyBundle{}
+
+// remoteKeyBundle is an EVM KeyBundle that signs with a remote signer.
+type remoteKeyBundle struct {
+	keys   RemoteKeys
+	signer signer.Signer
+	// evm is only used for its stateless hashing and verification, it holds no key.
+	evm evmKeyring
+}
+
+// NewRemoteEVMKeyBundle returns an EVM key bundle whose private keys are held by s: a secp256k1 onchain signing key,
+// an ed25519 offchain signing key and an X25519 config encryption key.
+func NewRemoteEVMKeyBundle(ctx context.Context, s signer.Signer, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID string) (KeyBundle, error) {
+	keys := RemoteKeys{
+		ChainType:             chaintype.EVM,
+		OnchainSigningKeyID:   onchainSigningKeyID,
+		OffchainSigningKeyID:  offchainSigningKeyID,
+		ConfigEncryptionKeyID: configEncryptionKeyID,
+	}
+
+	onchain, err := remotePublicKey(ctx, s, onchainSigningKeyID, signer.Secp256k1)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := crypto.UnmarshalPubkey(onchain)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid onchain signing public key")
+	}
+	keys.OnchainAddress = crypto.PubkeyToAddress(*pub)
+
+	offchain, err := remotePublicKey(ctx, s, offchainSigningKeyID, signer.Ed25519)
+	if err != nil {
+		return nil, err
+	}
+	copy(keys.OffchainPublicKey[:], offchain)
+
+	encryption, err := remotePublicKey(ctx, s, configEncryptionKeyID, signer.X25519)
+	if err != nil {
+		return nil, err
+	}
+	copy(keys.ConfigEncryptionPublicKey[:], encryption)
+
+	b, err := json.Marshal(keys)
+	if err != nil {
+		return nil, err
+	}
+	keys.ID = sha256.Sum256(b)
+	return FromRemoteKeys(s, keys), nil
+}
+
+func remotePublicKey(ctx context.Context, s signer.Signer, keyID string, keyType signer.KeyType) ([]byte, error) {
+	pk, err := s.PublicKey(ctx, keyID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get public key of %s", keyID)
+	}
+	if err = signer.CheckPublicKey(pk, keyType); err != nil {
+		return nil, errors.Wrapf(err, "invalid public key for %s", keyID)
+	}
+	return pk.Bytes, nil
+}
+
+// FromRemoteKeys loads a bundle created with NewRemoteEVMKeyBundle, without contacting s. If s is nil, the bundle
+// returns signer.ErrNotConfigured when asked to sign.
+func FromRemoteKeys(s signer.Signer, keys RemoteKeys) KeyBundle {
+	return &remoteKeyBundle{keys: keys, signer: s}
+}
+
+// RemoteKeysFor returns the RemoteKeys of kb, if it is held by a remote signer.
+func RemoteKeysFor(kb KeyBundle) (RemoteKeys, bool) {
+	rkb, ok := kb.(*remoteKeyBundle)
+	if !ok {
+		return RemoteKeys{}, false
+	}
+	return rkb.keys, true
+}
+
+func (kb *remoteKeyBundle) ID() string {
+	return hex.EncodeToString(kb.keys.ID[:])
+}
+
+func (kb *remoteKeyBundle) ChainType() chaintype.ChainType {
+	return kb.keys.ChainType
+}
+
+// String reduces the risk of accidentally logging the private key
+func (kb *remoteKeyBundle) String() string {
+	return fmt.Sprintf("RemoteKeyBundle{chainType: %s, id: %s}", kb.ChainType(), kb.ID())
+}
+
+// GoString reduces the risk of accidentally logging the private key
+func (kb *remoteKeyBundle) GoString() string {
+	return kb.String()
+}
+
+func (kb *remoteKeyBundle) sign(keyID string, payload []byte) ([]byte, error) {
+	if kb.signer == nil {
+		return nil, signer.ErrNotConfigured
+	}
+	return kb.signer.Sign(context.Background(), keyID, payload)
+}
+
+func (kb *remoteKeyBundle) signOnchain(digest []byte) ([]byte, error) {
+	sig, err := kb.sign(kb.keys.OnchainSigningKeyID, digest)
+	if err != nil {
+		return nil, err
+	}
+	if len(sig) != kb.MaxSignatureLength() {
+		return nil, errors.Errorf("remote signer returned a %d byte signature", len(sig))
+	}
+	return sig, nil
+}
+
+func (kb *remoteKeyBundle) PublicKey() ocrtypes.OnchainPublicKey {
+	return kb.keys.OnchainAddress.Bytes()
+}
+
+func (kb *remoteKeyBundle) OnChainPublicKey() string {
+	return hex.EncodeToString(kb.PublicKey())
+}
+
+func (kb *remoteKeyBundle) Sign(reportCtx ocrtypes.ReportContext, report ocrtypes.Report) ([]byte, error) {
+	return kb.signOnchain(kb.evm.reportToSigData(reportCtx, report))
+}
+
+func (kb *remoteKeyBundle) Sign3(digest ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report) ([]byte, error) {
+	return kb.signOnchain(kb.evm.reportToSigData3(digest, seqNr, r))
+}
+
+func (kb *remoteKeyBundle) Verify(publicKey ocrtypes.OnchainPublicKey, reportCtx ocrtypes.ReportContext, report ocrtypes.Report, signature []byte) bool {
+	return kb.evm.Verify(publicKey, reportCtx, report, signature)
+}
+
+func (kb *remoteKeyBundle) Verify3(publicKey ocrtypes.OnchainPublicKey, cd ocrtypes.ConfigDigest, seqNr uint64, r ocrtypes.Report, signature []byte) bool {
+	return kb.evm.Verify3(publicKey, cd, seqNr, r, signature)
+}
+
+func (kb *remoteKeyBundle) MaxSignatureLength() int {
+	return kb.evm.MaxSignatureLength()
+}
+
+func (kb *remoteKeyBundle) OffchainSign(msg []byte) ([]byte, error) {
+	return kb.sign(kb.keys.OffchainSigningKeyID, msg)
+}
+
+func (kb *remoteKeyBundle) ConfigDiffieHellman(point [curve25519.PointSize]byte) ([curve25519.PointSize]byte, error) {
+	if kb.signer == nil {
+		return [curve25519.PointSize]byte{}, signer.ErrNotConfigured
+	}
+	return kb.signer.DiffieHellman(context.Background(), kb.keys.ConfigEncryptionKeyID, point)
+}
+
+func (kb *remoteKeyBundle) OffchainPublicKey() ocrtypes.OffchainPublicKey {
+	return kb.keys.OffchainPublicKey
+}
+
+func (kb *remoteKeyBundle) ConfigEncryptionPublicKey() ocrtypes.ConfigEncryptionPublicKey {
+	return kb.keys.ConfigEncryptionPublicKey
+}
+
+// NaclBoxOpenAnonymous is equivalent to box.OpenAnonymous, with the Diffie-Hellman exchange done by the remote signer.
+func (kb *remoteKeyBundle) NaclBoxOpenAnonymous(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < box.AnonymousOverhead {
+		return nil, errors.New("ciphertext too short")
+	}
+	var ephemeralPublicKey [curve25519.PointSize]byte
+	copy(ephemeralPublicKey[:], ciphertext[:curve25519.PointSize])
+
+	sharedKey, err := kb.ConfigDiffieHellman(ephemeralPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	salsa.HSalsa20(&sharedKey, &[16]byte{}, &sharedKey, &salsa.Sigma)
+
+	h, err := blake2b.New(24, nil)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(ephemeralPublicKey[:])
+	h.Write(kb.keys.ConfigEncryptionPublicKey[:])
+	var nonce [24]byte
+	h.Sum(nonce[:0])
+
+	decrypted, ok := box.OpenAfterPrecomputation(nil, ciphertext[curve25519.PointSize:], &nonce, &sharedKey)
+	if !ok {
+		return nil, errors.New("decryption failed")
+	}
+	return decrypted, nil
+}
+
+// Marshal returns the RemoteKeys of the bundle, which only contain public material.
+func (kb *remoteKeyBundle) Marshal() ([]byte, error) {
+	return json.Marshal(kb.keys)
+}
+
+func (kb *remoteKeyBundle) Unmarshal(b []byte) error {
+	return json.Unmarshal(b, &kb.keys)
+}
+
+// Raw returns the RemoteKeys of the bundle. It cannot be turned back into a key without the remote signer.
+func (kb *remoteKeyBundle) Raw() Raw {
+	b, err := kb.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
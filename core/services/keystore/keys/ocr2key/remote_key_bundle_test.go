@@ -0,0 +1,104 @@
+package ocr2key
+
+import (
+	"crypto/ed25519"
+	cryptorand "crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+)
+
+func TestRemoteKeyBundle(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := signer.NewLocal()
+	onchainKeyID, offchainKeyID, encryptionKeyID := s.MustCreate(signer.Secp256k1), s.MustCreate(signer.Ed25519), s.MustCreate(signer.X25519)
+
+	kb, err := NewRemoteEVMKeyBundle(ctx, s, onchainKeyID, offchainKeyID, encryptionKeyID)
+	require.NoError(t, err)
+	assert.Equal(t, chaintype.EVM, kb.ChainType())
+	assert.Len(t, kb.ID(), 64)
+	keys, ok := RemoteKeysFor(kb)
+	require.True(t, ok)
+	assert.Equal(t, onchainKeyID, keys.OnchainSigningKeyID)
+	_, ok = RemoteKeysFor(MustNewInsecure(cryptorand.Reader, chaintype.EVM))
+	assert.False(t, ok)
+
+	t.Run("signs reports", func(t *testing.T) {
+		reportCtx := ocrtypes.ReportContext{}
+		report := ocrtypes.Report("report")
+		sig, err := kb.Sign(reportCtx, report)
+		require.NoError(t, err)
+		assert.True(t, kb.Verify(kb.PublicKey(), reportCtx, report, sig))
+
+		local := MustNewInsecure(cryptorand.Reader, chaintype.EVM)
+		assert.True(t, local.Verify(kb.PublicKey(), reportCtx, report, sig))
+		assert.False(t, local.Verify(local.PublicKey(), reportCtx, report, sig))
+
+		sig3, err := kb.Sign3(ocrtypes.ConfigDigest{1}, 7, report)
+		require.NoError(t, err)
+		assert.True(t, local.Verify3(kb.PublicKey(), ocrtypes.ConfigDigest{1}, 7, report, sig3))
+	})
+
+	t.Run("signs offchain", func(t *testing.T) {
+		sig, err := kb.OffchainSign([]byte("observation"))
+		require.NoError(t, err)
+		pub := kb.OffchainPublicKey()
+		assert.True(t, ed25519.Verify(pub[:], []byte("observation"), sig))
+	})
+
+	t.Run("computes shared secrets and opens boxes", func(t *testing.T) {
+		var scalar [curve25519.ScalarSize]byte
+		_, err := cryptorand.Read(scalar[:])
+		require.NoError(t, err)
+		point, err := curve25519.X25519(scalar[:], curve25519.Basepoint)
+		require.NoError(t, err)
+		shared, err := kb.ConfigDiffieHellman([curve25519.PointSize]byte(point))
+		require.NoError(t, err)
+		encryptionPublicKey := kb.ConfigEncryptionPublicKey()
+		expected, err := curve25519.X25519(scalar[:], encryptionPublicKey[:])
+		require.NoError(t, err)
+		assert.Equal(t, expected, shared[:])
+
+		recipient := [curve25519.PointSize]byte(encryptionPublicKey)
+		ciphertext, err := box.SealAnonymous(nil, []byte("secret"), &recipient, cryptorand.Reader)
+		require.NoError(t, err)
+		plaintext, err := kb.NaclBoxOpenAnonymous(ciphertext)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("secret"), plaintext)
+
+		ciphertext[len(ciphertext)-1] ^= 1
+		_, err = kb.NaclBoxOpenAnonymous(ciphertext)
+		require.ErrorContains(t, err, "decryption failed")
+	})
+
+	t.Run("round trips public material only", func(t *testing.T) {
+		b, err := kb.Marshal()
+		require.NoError(t, err)
+		restored := FromRemoteKeys(nil, RemoteKeys{})
+		require.NoError(t, restored.Unmarshal(b))
+		assert.Equal(t, kb.ID(), restored.ID())
+		assert.Equal(t, kb.OnChainPublicKey(), restored.OnChainPublicKey())
+		assert.Equal(t, kb.OffchainPublicKey(), restored.OffchainPublicKey())
+
+		_, err = restored.Sign(ocrtypes.ReportContext{}, ocrtypes.Report("report"))
+		require.ErrorIs(t, err, signer.ErrNotConfigured)
+		_, err = restored.OffchainSign([]byte("observation"))
+		require.ErrorIs(t, err, signer.ErrNotConfigured)
+	})
+
+	t.Run("rejects keys of the wrong type", func(t *testing.T) {
+		_, err := NewRemoteEVMKeyBundle(ctx, s, offchainKeyID, offchainKeyID, encryptionKeyID)
+		require.ErrorContains(t, err, "expected a secp256k1 key but got ed25519")
+		_, err = NewRemoteEVMKeyBundle(ctx, s, onchainKeyID, offchainKeyID, "unknown")
+		require.ErrorIs(t, err, signer.ErrKeyNotFound)
+	})
+}
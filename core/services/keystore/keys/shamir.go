@@ -0,0 +1,119 @@
+package keys
+
+import (
+	"crypto/rand"
+
+	"github.com/pkg/errors"
+)
+
+// MaxShares is the maximum number of shares a secret can be split into, as each share is identified by a distinct
+// non-zero element of GF(2^8).
+const MaxShares = 255
+
+// SplitSecret splits secret into total Shamir shares, any threshold of which can be combined with CombineShares to
+// recover it. Fewer than threshold shares reveal nothing about the secret.
+//
+// Each byte of the secret is the constant term of a random polynomial of degree threshold-1 over GF(2^8). A share
+// holds the evaluation of each polynomial at its x coordinate, followed by that coordinate.
+func SplitSecret(secret []byte, total, threshold int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("cannot split an empty secret")
+	}
+	if total > MaxShares {
+		return nil, errors.Errorf("cannot split a secret into more than %d shares", MaxShares)
+	}
+	if threshold < 2 || threshold > total {
+		return nil, errors.Errorf("threshold must be between 2 and the number of shares (%d), got %d", total, threshold)
+	}
+
+	shares := make([][]byte, total)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		shares[i][len(secret)] = byte(i + 1)
+	}
+	coefficients := make([]byte, threshold)
+	for i, b := range secret {
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, errors.Wrap(err, "failed to generate polynomial")
+		}
+		coefficients[0] = b
+		for _, share := range shares {
+			share[i] = evaluatePolynomial(coefficients, share[len(secret)])
+		}
+	}
+	return shares, nil
+}
+
+// CombineShares recovers a secret split by SplitSecret from at least threshold of its shares. Combining fewer shares,
+// or shares of different secrets, returns a wrong secret rather than an error, so callers must be able to tell.
+func CombineShares(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, errors.New("at least 2 shares are required")
+	}
+	size := len(shares[0])
+	if size < 2 {
+		return nil, errors.New("invalid share")
+	}
+	xs := make([]byte, len(shares))
+	seen := make(map[byte]bool, len(shares))
+	for i, share := range shares {
+		if len(share) != size {
+			return nil, errors.New("all shares must have the same length")
+		}
+		x := share[size-1]
+		if x == 0 {
+			return nil, errors.New("invalid share")
+		}
+		if seen[x] {
+			return nil, errors.New("duplicate share")
+		}
+		seen[x] = true
+		xs[i] = x
+	}
+
+	// Lagrange interpolation at x = 0. Addition and subtraction are both XOR in GF(2^8).
+	secret := make([]byte, size-1)
+	for j, share := range shares {
+		basis := byte(1)
+		for m, x := range xs {
+			if m != j {
+				basis = gfMul(basis, gfDiv(x, x^xs[j]))
+			}
+		}
+		for i := range secret {
+			secret[i] ^= gfMul(share[i], basis)
+		}
+	}
+	return secret, nil
+}
+
+// evaluatePolynomial evaluates the polynomial with coefficients, lowest degree first, at x using Horner's method.
+func evaluatePolynomial(coefficients []byte, x byte) byte {
+	var y byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = gfMul(y, x) ^ coefficients[i]
+	}
+	return y
+}
+
+// gfMul multiplies a and b in GF(2^8), with the AES reduction polynomial x^8 + x^4 + x^3 + x + 1. It always runs 8
+// iterations, so its timing does not depend on b.
+func gfMul(a, b byte) byte {
+	var p byte
+	for i := 0; i < 8; i++ {
+		p ^= a & -(b & 1)
+		a = a<<1 ^ 0x1b&-(a>>7)
+		b >>= 1
+	}
+	return p
+}
+
+// gfDiv divides a by b, which must not be zero, in GF(2^8), using b^-1 = b^254.
+func gfDiv(a, b byte) byte {
+	inverse := byte(1)
+	for i := 0; i < 7; i++ {
+		b = gfMul(b, b)
+		inverse = gfMul(inverse, b)
+	}
+	return gfMul(a, inverse)
+}
@@ -0,0 +1,119 @@
+package keys
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestGF256(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, byte(0xc1), gfMul(0x57, 0x83))
+	for a := 1; a < 256; a++ {
+		assert.Equal(t, byte(1), gfMul(byte(a), gfDiv(1, byte(a))), a)
+	}
+}
+
+func TestSplitSecret(t *testing.T) {
+	t.Parallel()
+
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	shares, err := SplitSecret(secret, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	t.Run("any quorum recovers the secret", func(t *testing.T) {
+		for i := 0; i < len(shares); i++ {
+			for j := i + 1; j < len(shares); j++ {
+				for k := j + 1; k < len(shares); k++ {
+					combined, err := CombineShares([][]byte{shares[k], shares[i], shares[j]})
+					require.NoError(t, err)
+					assert.Equal(t, secret, combined)
+				}
+			}
+		}
+		combined, err := CombineShares(shares)
+		require.NoError(t, err)
+		assert.Equal(t, secret, combined)
+	})
+
+	t.Run("fewer shares do not", func(t *testing.T) {
+		combined, err := CombineShares(shares[:2])
+		require.NoError(t, err)
+		assert.NotEqual(t, secret, combined)
+	})
+
+	t.Run("invalid shares", func(t *testing.T) {
+		_, err := CombineShares(shares[:1])
+		require.ErrorContains(t, err, "at least 2 shares are required")
+		_, err = CombineShares([][]byte{shares[0], shares[1], shares[0]})
+		require.ErrorContains(t, err, "duplicate share")
+		_, err = CombineShares([][]byte{shares[0], shares[1][1:]})
+		require.ErrorContains(t, err, "all shares must have the same length")
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		_, err := SplitSecret(nil, 5, 3)
+		require.ErrorContains(t, err, "cannot split an empty secret")
+		_, err = SplitSecret(secret, 3, 1)
+		require.ErrorContains(t, err, "threshold must be between 2 and the number of shares (3), got 1")
+		_, err = SplitSecret(secret, 3, 4)
+		require.ErrorContains(t, err, "threshold must be between 2 and the number of shares (3), got 4")
+		_, err = SplitSecret(secret, MaxShares+1, 3)
+		require.ErrorContains(t, err, "cannot split a secret into more than 255 shares")
+	})
+}
+
+func TestSecretSharesJSON(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("secret")
+	passwords := []string{"alice", "bob", "carol"}
+	sharesJSON, err := ToSecretSharesJSON("test", "id", secret, passwords, 2, utils.FastScryptParams)
+	require.NoError(t, err)
+	require.Len(t, sharesJSON, 3)
+
+	var share SecretShare
+	require.NoError(t, json.Unmarshal(sharesJSON[0], &share))
+	assert.Equal(t, SecretShare{KeyType: "test", ID: "id", Threshold: 2, Total: 3, Crypto: share.Crypto}, share)
+	assert.NotEmpty(t, share.Crypto.CipherText)
+
+	combined, err := FromSecretSharesJSON("test", "id", 2, 3, sharesJSON[1:], passwords[1:])
+	require.NoError(t, err)
+	assert.Equal(t, secret, combined)
+
+	_, err = FromSecretSharesJSON("test", "id", 2, 3, sharesJSON[1:], []string{"carol", "bob"})
+	require.ErrorContains(t, err, "failed to decrypt test secret share 1")
+	_, err = FromSecretSharesJSON("test", "id", 2, 3, sharesJSON[1:], passwords[1:2])
+	require.ErrorContains(t, err, "1 passwords provided for 2 test secret shares")
+	_, err = FromSecretSharesJSON("test", "id", 2, 3, sharesJSON[:1], passwords[:1])
+	require.ErrorContains(t, err, "2 of 3 test secret shares required, 1 provided")
+	_, err = FromSecretSharesJSON("test", "id", 3, 3, sharesJSON[1:], passwords[1:])
+	require.ErrorContains(t, err, "3 of 3 test secret shares required, 2 provided")
+	_, err = FromSecretSharesJSON("test", "id", 1, 3, sharesJSON[:1], passwords[:1])
+	require.ErrorContains(t, err, "invalid test secret shares threshold 1 of 3")
+	_, err = FromSecretSharesJSON("test", "other", 2, 3, sharesJSON, passwords)
+	require.ErrorContains(t, err, "secret share 1 belongs to id, expected other")
+	_, err = FromSecretSharesJSON("other", "id", 2, 3, sharesJSON, passwords)
+	require.ErrorContains(t, err, "secret share 1 is a test share, expected other")
+
+	var forged SecretShare
+	require.NoError(t, json.Unmarshal(sharesJSON[0], &forged))
+	forged.Threshold = 1
+	forgedJSON, err := json.Marshal(forged)
+	require.NoError(t, err)
+	_, err = FromSecretSharesJSON("test", "id", 2, 3, [][]byte{forgedJSON, sharesJSON[1]}, passwords[:2])
+	require.ErrorContains(t, err, "secret share 1 is one of 3 shares with threshold 1, expected 3 with threshold 2")
+
+	_, err = ToSecretSharesJSON("test", "id", secret, []string{"alice", ""}, 2, utils.FastScryptParams)
+	require.ErrorContains(t, err, "password of test secret share 2 cannot be empty")
+}
@@ -45,6 +45,20 @@ func (o *memoryORM) getEncryptedKeyRing(ctx context.Context) (encryptedKeyRing,
 	return *o.keyRing, nil
 }
 
+func (o *memoryORM) rotateEncryptedKeyRing(ctx context.Context, rotate func(encryptedKeyRing) (encryptedKeyRing, error)) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.keyRing == nil {
+		return errors.New("no encrypted key ring found")
+	}
+	rotated, err := rotate(*o.keyRing)
+	if err != nil {
+		return err
+	}
+	o.keyRing = &rotated
+	return nil
+}
+
 func newInMemoryORM(ds sqlutil.DataSource) *memoryORM {
 	return &memoryORM{ds: ds}
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/solkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/starkkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -28,6 +29,8 @@ var (
 	ErrLocked      = errors.New("Keystore is locked")
 	ErrKeyNotFound = errors.New("Key not found")
 	ErrKeyExists   = errors.New("Key already exists")
+	// ErrRemoteKey is returned when the private key is needed, but the key is held by the remote signer.
+	ErrRemoteKey = errors.New("Key is held by the remote signer")
 )
 
 // DefaultEVMChainIDFunc is a func for getting a default evm chain ID -
@@ -46,6 +49,18 @@ type Master interface {
 	Aptos() Aptos
 	VRF() VRF
 	Unlock(ctx context.Context, password string) error
+	// RotatePassword re-encrypts the key ring, which must currently be encrypted with oldPassword, with newPassword
+	// and scryptParams. The key ring is decrypted, re-encrypted and verified within a single DB transaction.
+	RotatePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams) error
+	// ExportBackup returns all keys and Eth key states as a Backup encrypted with password.
+	ExportBackup(ctx context.Context, password string) ([]byte, error)
+	// RestoreBackup restores a Backup into the keystore, which must be empty, in a single DB transaction.
+	RestoreBackup(ctx context.Context, backup []byte, password string) (BackupSummary, error)
+	// ExportBackupShares is like ExportBackup, but splits the secret encrypting the Backup into one share per
+	// password, each encrypted with its password, any threshold of which can restore it.
+	ExportBackupShares(ctx context.Context, passwords []string, threshold int) (backup []byte, shares [][]byte, err error)
+	// RestoreBackupFromShares is like RestoreBackup, for a Backup exported with ExportBackupShares.
+	RestoreBackupFromShares(ctx context.Context, backup []byte, shares [][]byte, passwords []string) (BackupSummary, error)
 	IsEmpty(ctx context.Context) (bool, error)
 }
 
@@ -64,15 +79,22 @@ type master struct {
 }
 
 func New(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger) Master {
-	return newMaster(ds, scryptParams, lggr)
+	return newMaster(ds, scryptParams, nil, lggr)
 }
 
-func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logger.Logger) *master {
+// NewWithRemoteSigner is like New, but Eth and OCR2 keys can also be held by the remote signer s, in which case the
+// keystore only stores their public keys and signer key IDs.
+func NewWithRemoteSigner(ds sqlutil.DataSource, scryptParams utils.ScryptParams, s signer.Signer, lggr logger.Logger) Master {
+	return newMaster(ds, scryptParams, s, lggr)
+}
+
+func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, s signer.Signer, lggr logger.Logger) *master {
 	orm := NewORM(ds, lggr)
 	km := &keyManager{
 		orm:          orm,
 		keystateORM:  orm,
 		scryptParams: scryptParams,
+		signer:       s,
 		lock:         &sync.RWMutex{},
 		logger:       lggr.Named("KeyStore"),
 	}
@@ -92,6 +114,27 @@ func newMaster(ds sqlutil.DataSource, scryptParams utils.ScryptParams, lggr logg
 	}
 }
 
+// RestoreBackup restores backup with keyManager.RestoreBackup, then notifies the subscribers to Eth key changes.
+func (ks *master) RestoreBackup(ctx context.Context, backup []byte, password string) (BackupSummary, error) {
+	summary, err := ks.keyManager.RestoreBackup(ctx, backup, password)
+	if err != nil {
+		return summary, err
+	}
+	ks.eth.notify()
+	return summary, nil
+}
+
+// RestoreBackupFromShares restores backup with keyManager.RestoreBackupFromShares, then notifies the subscribers to
+// Eth key changes.
+func (ks *master) RestoreBackupFromShares(ctx context.Context, backup []byte, shares [][]byte, passwords []string) (BackupSummary, error) {
+	summary, err := ks.keyManager.RestoreBackupFromShares(ctx, backup, shares, passwords)
+	if err != nil {
+		return summary, err
+	}
+	ks.eth.notify()
+	return summary, nil
+}
+
 func (ks master) CSA() CSA {
 	return ks.csa
 }
@@ -136,6 +179,7 @@ type ORM interface {
 	isEmpty(context.Context) (bool, error)
 	saveEncryptedKeyRing(context.Context, *encryptedKeyRing, ...func(sqlutil.DataSource) error) error
 	getEncryptedKeyRing(context.Context) (encryptedKeyRing, error)
+	rotateEncryptedKeyRing(context.Context, func(encryptedKeyRing) (encryptedKeyRing, error)) error
 }
 
 type keystateORM interface {
@@ -146,6 +190,7 @@ type keyManager struct {
 	orm          ORM
 	keystateORM  keystateORM
 	scryptParams utils.ScryptParams
+	signer       signer.Signer
 	keyRing      *keyRing
 	keyStates    *keyStates
 	lock         *sync.RWMutex
@@ -176,6 +221,7 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 		return errors.Wrap(err, "unable to decrypt encrypted key ring")
 	}
 	kr.logPubKeys(km.logger)
+	kr.setRemoteSigner(km.signer)
 	km.keyRing = kr
 
 	ks, err := km.keystateORM.loadKeyStates(ctx)
@@ -188,6 +234,28 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	return nil
 }
 
+func (km *keyManager) RotatePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if !km.isLocked() && oldPassword != km.password {
+		return errors.New("current password does not match the password the keystore was unlocked with")
+	}
+	if newPassword == "" {
+		return errors.New("new password cannot be empty")
+	}
+	err := km.orm.rotateEncryptedKeyRing(ctx, func(ekr encryptedKeyRing) (encryptedKeyRing, error) {
+		return ekr.rotate(oldPassword, newPassword, scryptParams)
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to rotate keystore password")
+	}
+	if !km.isLocked() {
+		km.password = newPassword
+		km.scryptParams = scryptParams
+	}
+	return nil
+}
+
 // caller must hold lock!
 func (km *keyManager) save(ctx context.Context, callbacks ...func(sqlutil.DataSource) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -64,3 +65,36 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
 	})
 }
+
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	const newPassword = "16charlengthp4SsW0rD2"
+	db := pgtest.NewSqlxDB(t)
+	ctx := testutils.Context(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	key, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	require.Error(t, keyStore.RotatePassword(ctx, "wrong password", newPassword, utils.FastScryptParams))
+
+	require.NoError(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword, utils.FastScryptParams))
+	// keys added after the rotation are saved with the new password
+	key2, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+	keyStore = keystore.ExposedNewMaster(t, db)
+	require.Error(t, keyStore.Unlock(ctx, cltest.Password))
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(ctx, newPassword))
+	_, err := keyStore.Eth().Get(ctx, key.ID())
+	require.NoError(t, err)
+	_, err = keyStore.Eth().Get(ctx, key2.ID())
+	require.NoError(t, err)
+
+	t.Run("rotates a locked keystore", func(t *testing.T) {
+		locked := keystore.ExposedNewMaster(t, db)
+		require.NoError(t, locked.RotatePassword(ctx, newPassword, cltest.Password, utils.FastScryptParams))
+		require.NoError(t, locked.Unlock(ctx, cltest.Password))
+	})
+}
@@ -70,6 +70,63 @@ func (_c *CSA_Add_Call) RunAndReturn(run func(context.Context, csakey.KeyV2) err
 	return _c
 }
 
+// AddRemote provides a mock function with given fields: ctx, signerKeyID
+func (_m *CSA) AddRemote(ctx context.Context, signerKeyID string) (csakey.KeyV2, error) {
+	ret := _m.Called(ctx, signerKeyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRemote")
+	}
+
+	var r0 csakey.KeyV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (csakey.KeyV2, error)); ok {
+		return rf(ctx, signerKeyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) csakey.KeyV2); ok {
+		r0 = rf(ctx, signerKeyID)
+	} else {
+		r0 = ret.Get(0).(csakey.KeyV2)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, signerKeyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CSA_AddRemote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRemote'
+type CSA_AddRemote_Call struct {
+	*mock.Call
+}
+
+// AddRemote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - signerKeyID string
+func (_e *CSA_Expecter) AddRemote(ctx interface{}, signerKeyID interface{}) *CSA_AddRemote_Call {
+	return &CSA_AddRemote_Call{Call: _e.mock.On("AddRemote", ctx, signerKeyID)}
+}
+
+func (_c *CSA_AddRemote_Call) Run(run func(ctx context.Context, signerKeyID string)) *CSA_AddRemote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CSA_AddRemote_Call) Return(_a0 csakey.KeyV2, _a1 error) *CSA_AddRemote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CSA_AddRemote_Call) RunAndReturn(run func(context.Context, string) (csakey.KeyV2, error)) *CSA_AddRemote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx
 func (_m *CSA) Create(ctx context.Context) (csakey.KeyV2, error) {
 	ret := _m.Called(ctx)
@@ -459,6 +516,66 @@ func (_c *CSA_Import_Call) RunAndReturn(run func(context.Context, []byte, string
 	return _c
 }
 
+// Sign provides a mock function with given fields: ctx, id, msg
+func (_m *CSA) Sign(ctx context.Context, id string, msg []byte) ([]byte, error) {
+	ret := _m.Called(ctx, id, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sign")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) ([]byte, error)); ok {
+		return rf(ctx, id, msg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) []byte); ok {
+		r0 = rf(ctx, id, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, id, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CSA_Sign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sign'
+type CSA_Sign_Call struct {
+	*mock.Call
+}
+
+// Sign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - msg []byte
+func (_e *CSA_Expecter) Sign(ctx interface{}, id interface{}, msg interface{}) *CSA_Sign_Call {
+	return &CSA_Sign_Call{Call: _e.mock.On("Sign", ctx, id, msg)}
+}
+
+func (_c *CSA_Sign_Call) Run(run func(ctx context.Context, id string, msg []byte)) *CSA_Sign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *CSA_Sign_Call) Return(_a0 []byte, _a1 error) *CSA_Sign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CSA_Sign_Call) RunAndReturn(run func(context.Context, string, []byte) ([]byte, error)) *CSA_Sign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewCSA creates a new instance of CSA. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCSA(t interface {
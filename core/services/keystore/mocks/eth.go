@@ -76,6 +76,78 @@ func (_c *Eth_Add_Call) RunAndReturn(run func(context.Context, common.Address, *
 	return _c
 }
 
+// AddRemote provides a mock function with given fields: ctx, signerKeyID, chainIDs
+func (_m *Eth) AddRemote(ctx context.Context, signerKeyID string, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, signerKeyID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRemote")
+	}
+
+	var r0 ethkey.KeyV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...*big.Int) (ethkey.KeyV2, error)); ok {
+		return rf(ctx, signerKeyID, chainIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...*big.Int) ethkey.KeyV2); ok {
+		r0 = rf(ctx, signerKeyID, chainIDs...)
+	} else {
+		r0 = ret.Get(0).(ethkey.KeyV2)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...*big.Int) error); ok {
+		r1 = rf(ctx, signerKeyID, chainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Eth_AddRemote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRemote'
+type Eth_AddRemote_Call struct {
+	*mock.Call
+}
+
+// AddRemote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - signerKeyID string
+//   - chainIDs ...*big.Int
+func (_e *Eth_Expecter) AddRemote(ctx interface{}, signerKeyID interface{}, chainIDs ...interface{}) *Eth_AddRemote_Call {
+	return &Eth_AddRemote_Call{Call: _e.mock.On("AddRemote",
+		append([]interface{}{ctx, signerKeyID}, chainIDs...)...)}
+}
+
+func (_c *Eth_AddRemote_Call) Run(run func(ctx context.Context, signerKeyID string, chainIDs ...*big.Int)) *Eth_AddRemote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*big.Int, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(*big.Int)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Eth_AddRemote_Call) Return(_a0 ethkey.KeyV2, _a1 error) *Eth_AddRemote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Eth_AddRemote_Call) RunAndReturn(run func(context.Context, string, ...*big.Int) (ethkey.KeyV2, error)) *Eth_AddRemote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckEnabled provides a mock function with given fields: ctx, address, chainID
 func (_m *Eth) CheckEnabled(ctx context.Context, address common.Address, chainID *big.Int) error {
 	ret := _m.Called(ctx, address, chainID)
@@ -1082,6 +1154,66 @@ func (_c *Eth_Import_Call) RunAndReturn(run func(context.Context, []byte, string
 	return _c
 }
 
+// SignMessage provides a mock function with given fields: ctx, address, data
+func (_m *Eth) SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error) {
+	ret := _m.Called(ctx, address, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignMessage")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) ([]byte, error)); ok {
+		return rf(ctx, address, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []byte) []byte); ok {
+		r0 = rf(ctx, address, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, []byte) error); ok {
+		r1 = rf(ctx, address, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Eth_SignMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignMessage'
+type Eth_SignMessage_Call struct {
+	*mock.Call
+}
+
+// SignMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - data []byte
+func (_e *Eth_Expecter) SignMessage(ctx interface{}, address interface{}, data interface{}) *Eth_SignMessage_Call {
+	return &Eth_SignMessage_Call{Call: _e.mock.On("SignMessage", ctx, address, data)}
+}
+
+func (_c *Eth_SignMessage_Call) Run(run func(ctx context.Context, address common.Address, data []byte)) *Eth_SignMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *Eth_SignMessage_Call) Return(_a0 []byte, _a1 error) *Eth_SignMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Eth_SignMessage_Call) RunAndReturn(run func(context.Context, common.Address, []byte) ([]byte, error)) *Eth_SignMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SignTx provides a mock function with given fields: ctx, fromAddress, tx, chainID
 func (_m *Eth) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(ctx, fromAddress, tx, chainID)
@@ -7,6 +7,8 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -210,6 +212,134 @@ func (_c *Master_Eth_Call) RunAndReturn(run func() keystore.Eth) *Master_Eth_Cal
 	return _c
 }
 
+// ExportBackup provides a mock function with given fields: ctx, password
+func (_m *Master) ExportBackup(ctx context.Context, password string) ([]byte, error) {
+	ret := _m.Called(ctx, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportBackup")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Master_ExportBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportBackup'
+type Master_ExportBackup_Call struct {
+	*mock.Call
+}
+
+// ExportBackup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - password string
+func (_e *Master_Expecter) ExportBackup(ctx interface{}, password interface{}) *Master_ExportBackup_Call {
+	return &Master_ExportBackup_Call{Call: _e.mock.On("ExportBackup", ctx, password)}
+}
+
+func (_c *Master_ExportBackup_Call) Run(run func(ctx context.Context, password string)) *Master_ExportBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Master_ExportBackup_Call) Return(_a0 []byte, _a1 error) *Master_ExportBackup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Master_ExportBackup_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *Master_ExportBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportBackupShares provides a mock function with given fields: ctx, passwords, threshold
+func (_m *Master) ExportBackupShares(ctx context.Context, passwords []string, threshold int) ([]byte, [][]byte, error) {
+	ret := _m.Called(ctx, passwords, threshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportBackupShares")
+	}
+
+	var r0 []byte
+	var r1 [][]byte
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) ([]byte, [][]byte, error)); ok {
+		return rf(ctx, passwords, threshold)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int) []byte); ok {
+		r0 = rf(ctx, passwords, threshold)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int) [][]byte); ok {
+		r1 = rf(ctx, passwords, threshold)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([][]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []string, int) error); ok {
+		r2 = rf(ctx, passwords, threshold)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Master_ExportBackupShares_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportBackupShares'
+type Master_ExportBackupShares_Call struct {
+	*mock.Call
+}
+
+// ExportBackupShares is a helper method to define mock.On call
+//   - ctx context.Context
+//   - passwords []string
+//   - threshold int
+func (_e *Master_Expecter) ExportBackupShares(ctx interface{}, passwords interface{}, threshold interface{}) *Master_ExportBackupShares_Call {
+	return &Master_ExportBackupShares_Call{Call: _e.mock.On("ExportBackupShares", ctx, passwords, threshold)}
+}
+
+func (_c *Master_ExportBackupShares_Call) Run(run func(ctx context.Context, passwords []string, threshold int)) *Master_ExportBackupShares_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *Master_ExportBackupShares_Call) Return(backup []byte, shares [][]byte, err error) *Master_ExportBackupShares_Call {
+	_c.Call.Return(backup, shares, err)
+	return _c
+}
+
+func (_c *Master_ExportBackupShares_Call) RunAndReturn(run func(context.Context, []string, int) ([]byte, [][]byte, error)) *Master_ExportBackupShares_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsEmpty provides a mock function with given fields: ctx
 func (_m *Master) IsEmpty(ctx context.Context) (bool, error) {
 	ret := _m.Called(ctx)
@@ -407,6 +537,172 @@ func (_c *Master_P2P_Call) RunAndReturn(run func() keystore.P2P) *Master_P2P_Cal
 	return _c
 }
 
+// RestoreBackup provides a mock function with given fields: ctx, backup, password
+func (_m *Master) RestoreBackup(ctx context.Context, backup []byte, password string) (keystore.BackupSummary, error) {
+	ret := _m.Called(ctx, backup, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreBackup")
+	}
+
+	var r0 keystore.BackupSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string) (keystore.BackupSummary, error)); ok {
+		return rf(ctx, backup, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string) keystore.BackupSummary); ok {
+		r0 = rf(ctx, backup, password)
+	} else {
+		r0 = ret.Get(0).(keystore.BackupSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, string) error); ok {
+		r1 = rf(ctx, backup, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Master_RestoreBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBackup'
+type Master_RestoreBackup_Call struct {
+	*mock.Call
+}
+
+// RestoreBackup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - backup []byte
+//   - password string
+func (_e *Master_Expecter) RestoreBackup(ctx interface{}, backup interface{}, password interface{}) *Master_RestoreBackup_Call {
+	return &Master_RestoreBackup_Call{Call: _e.mock.On("RestoreBackup", ctx, backup, password)}
+}
+
+func (_c *Master_RestoreBackup_Call) Run(run func(ctx context.Context, backup []byte, password string)) *Master_RestoreBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Master_RestoreBackup_Call) Return(_a0 keystore.BackupSummary, _a1 error) *Master_RestoreBackup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Master_RestoreBackup_Call) RunAndReturn(run func(context.Context, []byte, string) (keystore.BackupSummary, error)) *Master_RestoreBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreBackupFromShares provides a mock function with given fields: ctx, backup, shares, passwords
+func (_m *Master) RestoreBackupFromShares(ctx context.Context, backup []byte, shares [][]byte, passwords []string) (keystore.BackupSummary, error) {
+	ret := _m.Called(ctx, backup, shares, passwords)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreBackupFromShares")
+	}
+
+	var r0 keystore.BackupSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, []string) (keystore.BackupSummary, error)); ok {
+		return rf(ctx, backup, shares, passwords)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, []string) keystore.BackupSummary); ok {
+		r0 = rf(ctx, backup, shares, passwords)
+	} else {
+		r0 = ret.Get(0).(keystore.BackupSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, [][]byte, []string) error); ok {
+		r1 = rf(ctx, backup, shares, passwords)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Master_RestoreBackupFromShares_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBackupFromShares'
+type Master_RestoreBackupFromShares_Call struct {
+	*mock.Call
+}
+
+// RestoreBackupFromShares is a helper method to define mock.On call
+//   - ctx context.Context
+//   - backup []byte
+//   - shares [][]byte
+//   - passwords []string
+func (_e *Master_Expecter) RestoreBackupFromShares(ctx interface{}, backup interface{}, shares interface{}, passwords interface{}) *Master_RestoreBackupFromShares_Call {
+	return &Master_RestoreBackupFromShares_Call{Call: _e.mock.On("RestoreBackupFromShares", ctx, backup, shares, passwords)}
+}
+
+func (_c *Master_RestoreBackupFromShares_Call) Run(run func(ctx context.Context, backup []byte, shares [][]byte, passwords []string)) *Master_RestoreBackupFromShares_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].([][]byte), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *Master_RestoreBackupFromShares_Call) Return(_a0 keystore.BackupSummary, _a1 error) *Master_RestoreBackupFromShares_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Master_RestoreBackupFromShares_Call) RunAndReturn(run func(context.Context, []byte, [][]byte, []string) (keystore.BackupSummary, error)) *Master_RestoreBackupFromShares_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotatePassword provides a mock function with given fields: ctx, oldPassword, newPassword, scryptParams
+func (_m *Master) RotatePassword(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams) error {
+	ret := _m.Called(ctx, oldPassword, newPassword, scryptParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, utils.ScryptParams) error); ok {
+		r0 = rf(ctx, oldPassword, newPassword, scryptParams)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Master_RotatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotatePassword'
+type Master_RotatePassword_Call struct {
+	*mock.Call
+}
+
+// RotatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldPassword string
+//   - newPassword string
+//   - scryptParams utils.ScryptParams
+func (_e *Master_Expecter) RotatePassword(ctx interface{}, oldPassword interface{}, newPassword interface{}, scryptParams interface{}) *Master_RotatePassword_Call {
+	return &Master_RotatePassword_Call{Call: _e.mock.On("RotatePassword", ctx, oldPassword, newPassword, scryptParams)}
+}
+
+func (_c *Master_RotatePassword_Call) Run(run func(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams)) *Master_RotatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(utils.ScryptParams))
+	})
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) Return(_a0 error) *Master_RotatePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_RotatePassword_Call) RunAndReturn(run func(context.Context, string, string, utils.ScryptParams) error) *Master_RotatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -72,6 +72,67 @@ func (_c *OCR2_Add_Call) RunAndReturn(run func(context.Context, ocr2key.KeyBundl
 	return _c
 }
 
+// AddRemote provides a mock function with given fields: ctx, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID
+func (_m *OCR2) AddRemote(ctx context.Context, onchainSigningKeyID string, offchainSigningKeyID string, configEncryptionKeyID string) (ocr2key.KeyBundle, error) {
+	ret := _m.Called(ctx, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRemote")
+	}
+
+	var r0 ocr2key.KeyBundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (ocr2key.KeyBundle, error)); ok {
+		return rf(ctx, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ocr2key.KeyBundle); ok {
+		r0 = rf(ctx, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ocr2key.KeyBundle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OCR2_AddRemote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRemote'
+type OCR2_AddRemote_Call struct {
+	*mock.Call
+}
+
+// AddRemote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - onchainSigningKeyID string
+//   - offchainSigningKeyID string
+//   - configEncryptionKeyID string
+func (_e *OCR2_Expecter) AddRemote(ctx interface{}, onchainSigningKeyID interface{}, offchainSigningKeyID interface{}, configEncryptionKeyID interface{}) *OCR2_AddRemote_Call {
+	return &OCR2_AddRemote_Call{Call: _e.mock.On("AddRemote", ctx, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID)}
+}
+
+func (_c *OCR2_AddRemote_Call) Run(run func(ctx context.Context, onchainSigningKeyID string, offchainSigningKeyID string, configEncryptionKeyID string)) *OCR2_AddRemote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *OCR2_AddRemote_Call) Return(_a0 ocr2key.KeyBundle, _a1 error) *OCR2_AddRemote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OCR2_AddRemote_Call) RunAndReturn(run func(context.Context, string, string, string) (ocr2key.KeyBundle, error)) *OCR2_AddRemote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: _a0, _a1
 func (_m *OCR2) Create(_a0 context.Context, _a1 chaintype.ChainType) (ocr2key.KeyBundle, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -21,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/solkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/starkkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -33,17 +35,17 @@ func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
 	if len(ekr.EncryptedKeys) == 0 {
 		return newKeyRing(), nil
 	}
-	var cryptoJSON gethkeystore.CryptoJSON
-	err := json.Unmarshal(ekr.EncryptedKeys, &cryptoJSON)
-	if err != nil {
-		return nil, err
-	}
-	marshalledRawKeyRingJson, err := gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(password))
+	marshalledRawKeyRingJson, err := ekr.decryptData(password)
 	if err != nil {
 		return nil, err
 	}
+	return unmarshalKeyRing(marshalledRawKeyRingJson)
+}
+
+// unmarshalKeyRing is the inverse of keyRing.marshal.
+func unmarshalKeyRing(marshalledRawKeyRingJson []byte) (*keyRing, error) {
 	var rawKeys rawKeyRing
-	err = json.Unmarshal(marshalledRawKeyRingJson, &rawKeys)
+	err := json.Unmarshal(marshalledRawKeyRingJson, &rawKeys)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +63,48 @@ func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
 	return ring, nil
 }
 
+func (ekr encryptedKeyRing) decryptData(password string) ([]byte, error) {
+	var cryptoJSON gethkeystore.CryptoJSON
+	err := json.Unmarshal(ekr.EncryptedKeys, &cryptoJSON)
+	if err != nil {
+		return nil, err
+	}
+	return gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(password))
+}
+
+// rotate re-encrypts the key ring with newPassword and scryptParams. The data is re-encrypted as is, so that keys
+// which are not supported by this version are kept, and the result is verified to decrypt to the same data.
+func (ekr encryptedKeyRing) rotate(oldPassword, newPassword string, scryptParams utils.ScryptParams) (encryptedKeyRing, error) {
+	if len(ekr.EncryptedKeys) == 0 {
+		return ekr, errors.New("key ring is empty, there is nothing to rotate")
+	}
+	data, err := ekr.decryptData(oldPassword)
+	if err != nil {
+		return ekr, errors.Wrap(err, "could not decrypt key ring with the current password")
+	}
+	cryptoJSON, err := gethkeystore.EncryptDataV3(data, []byte(adulteratedPassword(newPassword)), scryptParams.N, scryptParams.P)
+	if err != nil {
+		return ekr, errors.Wrap(err, "could not encrypt key ring")
+	}
+	encryptedKeys, err := json.Marshal(&cryptoJSON)
+	if err != nil {
+		return ekr, errors.Wrap(err, "could not encode cryptoJSON")
+	}
+	rotated := encryptedKeyRing{UpdatedAt: ekr.UpdatedAt, EncryptedKeys: encryptedKeys}
+
+	verified, err := rotated.decryptData(newPassword)
+	if err != nil {
+		return ekr, errors.Wrap(err, "could not verify re-encrypted key ring")
+	}
+	if !bytes.Equal(verified, data) {
+		return ekr, errors.New("could not verify re-encrypted key ring: decrypted data does not match")
+	}
+	if _, err = rotated.Decrypt(newPassword); err != nil {
+		return ekr, errors.Wrap(err, "could not verify re-encrypted key ring")
+	}
+	return rotated, nil
+}
+
 type keyStates struct {
 	// Key ID => chain ID => state
 	KeyIDChainID map[string]map[string]*ethkey.State
@@ -176,15 +220,19 @@ func newKeyRing() *keyRing {
 	}
 }
 
-func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ekr encryptedKeyRing, err error) {
+// marshal returns the plaintext JSON of the key ring, including the keys stored in the legacy system.
+func (kr *keyRing) marshal() ([]byte, error) {
 	marshalledRawKeyRingJson, err := json.Marshal(kr.raw())
 	if err != nil {
-		return ekr, err
+		return nil, err
 	}
+	return kr.LegacyKeys.UnloadUnsupported(marshalledRawKeyRingJson)
+}
 
-	marshalledRawKeyRingJson, err = kr.LegacyKeys.UnloadUnsupported(marshalledRawKeyRingJson)
+func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ekr encryptedKeyRing, err error) {
+	marshalledRawKeyRingJson, err := kr.marshal()
 	if err != nil {
-		return encryptedKeyRing{}, err
+		return ekr, err
 	}
 
 	cryptoJSON, err := gethkeystore.EncryptDataV3(
@@ -207,16 +255,28 @@ func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ek
 
 func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, csaKey := range kr.CSA {
+		if signerKeyID, ok := csaKey.SignerKeyID(); ok {
+			rawKeys.RemoteCSA = append(rawKeys.RemoteCSA, mustMarshalRemoteKey(remoteCSAKey{PublicKey: csaKey.PublicKey, SignerKeyID: signerKeyID}))
+			continue
+		}
 		rawKeys.CSA = append(rawKeys.CSA, csaKey.Raw())
 	}
 	for _, ethKey := range kr.Eth {
+		if signerKeyID, ok := ethKey.SignerKeyID(); ok {
+			rawKeys.RemoteEth = append(rawKeys.RemoteEth, mustMarshalRemoteKey(remoteEthKey{Address: ethKey.Address, SignerKeyID: signerKeyID}))
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, ethKey.Raw())
 	}
 	for _, ocrKey := range kr.OCR {
 		rawKeys.OCR = append(rawKeys.OCR, ocrKey.Raw())
 	}
-	for _, ocr2key := range kr.OCR2 {
-		rawKeys.OCR2 = append(rawKeys.OCR2, ocr2key.Raw())
+	for _, bundle := range kr.OCR2 {
+		if remoteKeys, ok := ocr2key.RemoteKeysFor(bundle); ok {
+			rawKeys.RemoteOCR2 = append(rawKeys.RemoteOCR2, mustMarshalRemoteKey(remoteKeys))
+			continue
+		}
+		rawKeys.OCR2 = append(rawKeys.OCR2, bundle.Raw())
 	}
 	for _, p2pKey := range kr.P2P {
 		rawKeys.P2P = append(rawKeys.P2P, p2pKey.Raw())
@@ -239,6 +299,15 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	return rawKeys
 }
 
+// setRemoteSigner binds the keys held by the remote signer to s.
+func (kr *keyRing) setRemoteSigner(s signer.Signer) {
+	for id, bundle := range kr.OCR2 {
+		if remoteKeys, ok := ocr2key.RemoteKeysFor(bundle); ok {
+			kr.OCR2[id] = ocr2key.FromRemoteKeys(s, remoteKeys)
+		}
+	}
+}
+
 func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	lggr = lggr.Named("KeyRing")
 	var csaIDs []string
@@ -320,19 +389,46 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 // it holds only the essential key information to avoid adding unnecessary data
 // (like public keys) to the database
 type rawKeyRing struct {
-	Eth        []ethkey.Raw
-	CSA        []csakey.Raw
-	OCR        []ocrkey.Raw
-	OCR2       []ocr2key.Raw
-	P2P        []p2pkey.Raw
-	Cosmos     []cosmoskey.Raw
-	Solana     []solkey.Raw
-	StarkNet   []starkkey.Raw
-	Aptos      []aptoskey.Raw
-	VRF        []vrfkey.Raw
+	Eth      []ethkey.Raw
+	CSA      []csakey.Raw
+	OCR      []ocrkey.Raw
+	OCR2     []ocr2key.Raw
+	P2P      []p2pkey.Raw
+	Cosmos   []cosmoskey.Raw
+	Solana   []solkey.Raw
+	StarkNet []starkkey.Raw
+	Aptos    []aptoskey.Raw
+	VRF      []vrfkey.Raw
+	// RemoteCSA, RemoteEth and RemoteOCR2 only hold the public keys and signer key IDs of the keys held by the remote
+	// signer
+	RemoteCSA  []rawRemoteKey   `json:",omitempty"`
+	RemoteEth  []rawRemoteKey   `json:",omitempty"`
+	RemoteOCR2 []rawRemoteKey   `json:",omitempty"`
 	LegacyKeys LegacyKeyStorage `json:"-"`
 }
 
+// rawRemoteKey is the JSON encoded metadata of a key held by the remote signer. It is stored as a byte string, like
+// the raw private keys, so that versions of the node that do not know about remote keys keep it as a legacy key.
+type rawRemoteKey []byte
+
+type remoteCSAKey struct {
+	PublicKey   []byte
+	SignerKeyID string
+}
+
+type remoteEthKey struct {
+	Address     common.Address
+	SignerKeyID string
+}
+
+func mustMarshalRemoteKey(v any) rawRemoteKey {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
 func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 	keyRing := newKeyRing()
 	for _, rawCSAKey := range rawKeys.CSA {
@@ -377,6 +473,31 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		keyRing.VRF[vrfKey.ID()] = vrfKey
 	}
 
+	for _, rawRemoteKey := range rawKeys.RemoteCSA {
+		var remoteKey remoteCSAKey
+		if err := json.Unmarshal(rawRemoteKey, &remoteKey); err != nil {
+			return nil, errors.Wrap(err, "invalid remote CSA key")
+		}
+		csaKey := csakey.FromRemoteSigner(remoteKey.PublicKey, remoteKey.SignerKeyID)
+		keyRing.CSA[csaKey.ID()] = csaKey
+	}
+	for _, rawRemoteKey := range rawKeys.RemoteEth {
+		var remoteKey remoteEthKey
+		if err := json.Unmarshal(rawRemoteKey, &remoteKey); err != nil {
+			return nil, errors.Wrap(err, "invalid remote eth key")
+		}
+		ethKey := ethkey.FromRemoteSigner(remoteKey.Address, remoteKey.SignerKeyID)
+		keyRing.Eth[ethKey.ID()] = ethKey
+	}
+	for _, rawRemoteKey := range rawKeys.RemoteOCR2 {
+		var remoteKeys ocr2key.RemoteKeys
+		if err := json.Unmarshal(rawRemoteKey, &remoteKeys); err != nil {
+			return nil, errors.Wrap(err, "invalid remote OCR2 key")
+		}
+		bundle := ocr2key.FromRemoteKeys(nil, remoteKeys)
+		keyRing.OCR2[bundle.ID()] = bundle
+	}
+
 	keyRing.LegacyKeys = rawKeys.LegacyKeys
 	return keyRing, nil
 }
@@ -6,8 +6,10 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/cosmoskey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
@@ -17,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/solkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
@@ -154,3 +157,100 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestEncryptedKeyRing_Rotate(t *testing.T) {
+	const newPassword = "new-password"
+	csa := csakey.MustNewV2XXXTestingOnly(big.NewInt(1))
+	eth := mustNewEthKey(t)
+	kr, err := rawKeyRing{
+		CSA: []csakey.Raw{csa.Raw()},
+		Eth: []ethkey.Raw{eth.Raw()},
+	}.keys()
+	require.NoError(t, err)
+	ekr, err := kr.Encrypt(password, utils.FastScryptParams)
+	require.NoError(t, err)
+
+	t.Run("re-encrypts the key ring with the new password", func(t *testing.T) {
+		rotated, err := ekr.rotate(password, newPassword, utils.ScryptParams{N: 4, P: 2})
+		require.NoError(t, err)
+
+		_, err = rotated.Decrypt(password)
+		require.Error(t, err)
+		decrypted, err := rotated.Decrypt(newPassword)
+		require.NoError(t, err)
+		require.Len(t, decrypted.CSA, 1)
+		require.Equal(t, csa.PublicKey, decrypted.CSA[csa.ID()].PublicKey)
+		require.Len(t, decrypted.Eth, 1)
+		require.Equal(t, eth.Address, decrypted.Eth[eth.ID()].Address)
+
+		var cryptoJSON struct {
+			KDFParams map[string]any `json:"kdfparams"`
+		}
+		require.NoError(t, json.Unmarshal(rotated.EncryptedKeys, &cryptoJSON))
+		require.EqualValues(t, 4, cryptoJSON.KDFParams["n"])
+		require.EqualValues(t, 2, cryptoJSON.KDFParams["p"])
+	})
+
+	t.Run("fails with the wrong current password", func(t *testing.T) {
+		_, err := ekr.rotate("wrong password", newPassword, utils.FastScryptParams)
+		require.ErrorContains(t, err, "could not decrypt key ring with the current password")
+	})
+
+	t.Run("fails with invalid scrypt params", func(t *testing.T) {
+		_, err := ekr.rotate(password, newPassword, utils.ScryptParams{N: 3, P: 1})
+		require.ErrorContains(t, err, "could not encrypt key ring")
+	})
+
+	t.Run("fails on an empty key ring", func(t *testing.T) {
+		_, err := encryptedKeyRing{}.rotate(password, newPassword, utils.FastScryptParams)
+		require.ErrorContains(t, err, "key ring is empty")
+	})
+}
+
+func TestKeyRing_RemoteKeys(t *testing.T) {
+	ctx := testutils.Context(t)
+	s := signer.NewLocal()
+	ethKeyID := s.MustCreate(signer.Secp256k1)
+	pk, err := s.PublicKey(ctx, ethKeyID)
+	require.NoError(t, err)
+	pub, err := crypto.UnmarshalPubkey(pk.Bytes)
+	require.NoError(t, err)
+	remoteEth := ethkey.FromRemoteSigner(crypto.PubkeyToAddress(*pub), ethKeyID)
+	remoteOCR2, err := ocr2key.NewRemoteEVMKeyBundle(ctx, s, s.MustCreate(signer.Secp256k1), s.MustCreate(signer.Ed25519), s.MustCreate(signer.X25519))
+	require.NoError(t, err)
+	localEth := mustNewEthKey(t)
+
+	kr := newKeyRing()
+	kr.Eth[remoteEth.ID()] = remoteEth
+	kr.Eth[localEth.ID()] = *localEth
+	kr.OCR2[remoteOCR2.ID()] = remoteOCR2
+
+	raw := kr.raw()
+	require.Len(t, raw.Eth, 1)
+	require.Len(t, raw.RemoteEth, 1)
+	require.Empty(t, raw.OCR2)
+	require.Len(t, raw.RemoteOCR2, 1)
+
+	ekr, err := kr.Encrypt(password, utils.FastScryptParams)
+	require.NoError(t, err)
+	decrypted, err := ekr.Decrypt(password)
+	require.NoError(t, err)
+	require.Empty(t, decrypted.LegacyKeys.legacyRawKeys)
+
+	require.Len(t, decrypted.Eth, 2)
+	signerKeyID, remote := decrypted.Eth[remoteEth.ID()].SignerKeyID()
+	require.True(t, remote)
+	require.Equal(t, ethKeyID, signerKeyID)
+	_, remote = decrypted.Eth[localEth.ID()].SignerKeyID()
+	require.False(t, remote)
+
+	require.Len(t, decrypted.OCR2, 1)
+	bundle := decrypted.OCR2[remoteOCR2.ID()]
+	require.Equal(t, remoteOCR2.PublicKey(), bundle.PublicKey())
+	_, err = bundle.OffchainSign([]byte("msg"))
+	require.ErrorIs(t, err, signer.ErrNotConfigured)
+
+	decrypted.setRemoteSigner(s)
+	_, err = decrypted.OCR2[remoteOCR2.ID()].OffchainSign([]byte("msg"))
+	require.NoError(t, err)
+}
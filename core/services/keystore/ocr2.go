@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 )
 
 type OCR2 interface {
@@ -15,6 +16,7 @@ type OCR2 interface {
 	GetAll() ([]ocr2key.KeyBundle, error)
 	GetAllOfType(chaintype.ChainType) ([]ocr2key.KeyBundle, error)
 	Create(context.Context, chaintype.ChainType) (ocr2key.KeyBundle, error)
+	AddRemote(ctx context.Context, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID string) (ocr2key.KeyBundle, error)
 	Add(ctx context.Context, key ocr2key.KeyBundle) error
 	Delete(ctx context.Context, id string) error
 	Import(ctx context.Context, keyJSON []byte, password string) (ocr2key.KeyBundle, error)
@@ -75,6 +77,27 @@ func (ks ocr2) Create(ctx context.Context, chainType chaintype.ChainType) (ocr2k
 	return ks.create(ctx, chainType)
 }
 
+// AddRemote adds an EVM key bundle made of keys held by the remote signer. Only their public keys are stored in the
+// keystore.
+func (ks ocr2) AddRemote(ctx context.Context, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID string) (ocr2key.KeyBundle, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	if ks.signer == nil {
+		return nil, signer.ErrNotConfigured
+	}
+	key, err := ocr2key.NewRemoteEVMKeyBundle(ctx, ks.signer, onchainSigningKeyID, offchainSigningKeyID, configEncryptionKeyID)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := ks.keyRing.OCR2[key.ID()]; found {
+		return nil, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	return key, ks.safeAddKey(ctx, key)
+}
+
 func (ks ocr2) Add(ctx context.Context, key ocr2key.KeyBundle) error {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -127,6 +150,9 @@ func (ks ocr2) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if _, remote := ocr2key.RemoteKeysFor(key); remote {
+		return nil, ErrRemoteKey
+	}
 	return ocr2key.ToEncryptedJSON(key, password, ks.scryptParams)
 }
 
@@ -10,9 +10,12 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func Test_OCR2KeyStore_E2E(t *testing.T) {
@@ -198,3 +201,31 @@ func Test_OCR2KeyStore_E2E(t *testing.T) {
 		require.Equal(t, straknetKeys[0].ChainType(), chaintype.StarkNet)
 	})
 }
+
+func Test_OCR2KeyStore_AddRemote(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	s := signer.NewLocal()
+	keyStore := keystore.NewWithRemoteSigner(db, utils.FastScryptParams, s, logger.TestLogger(t))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ks := keyStore.OCR2()
+
+	onchainID, offchainID, encryptionID := s.MustCreate(signer.Secp256k1), s.MustCreate(signer.Ed25519), s.MustCreate(signer.X25519)
+	key, err := ks.AddRemote(ctx, onchainID, offchainID, encryptionID)
+	require.NoError(t, err)
+	assert.Equal(t, chaintype.EVM, key.ChainType())
+	_, err = ks.AddRemote(ctx, onchainID, offchainID, encryptionID)
+	require.ErrorContains(t, err, "already exists")
+
+	_, err = ks.Export(key.ID(), cltest.Password)
+	require.ErrorIs(t, err, keystore.ErrRemoteKey)
+
+	reloaded := keystore.NewWithRemoteSigner(db, utils.FastScryptParams, s, logger.TestLogger(t))
+	require.NoError(t, reloaded.Unlock(ctx, cltest.Password))
+	restored, err := reloaded.OCR2().Get(key.ID())
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey(), restored.PublicKey())
+	sig, err := restored.OffchainSign([]byte("msg"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, sig)
+}
@@ -67,6 +67,28 @@ func (orm ksORM) getEncryptedKeyRing(ctx context.Context) (kr encryptedKeyRing,
 	return kr, nil
 }
 
+// rotateEncryptedKeyRing locks the encrypted key ring and replaces it with the result of rotate, in a single transaction.
+func (orm ksORM) rotateEncryptedKeyRing(ctx context.Context, rotate func(encryptedKeyRing) (encryptedKeyRing, error)) error {
+	return sqlutil.TransactDataSource(ctx, orm.ds, nil, func(tx sqlutil.DataSource) error {
+		var kr encryptedKeyRing
+		err := tx.GetContext(ctx, &kr, `SELECT * FROM encrypted_key_rings LIMIT 1 FOR UPDATE`)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("no encrypted key ring found")
+		} else if err != nil {
+			return errors.Wrap(err, "while loading keyring")
+		}
+		rotated, err := rotate(kr)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+		UPDATE encrypted_key_rings
+		SET encrypted_keys = $1, updated_at = NOW()
+	`, rotated.EncryptedKeys)
+		return errors.Wrap(err, "while saving keyring")
+	})
+}
+
 func (orm ksORM) loadKeyStates(ctx context.Context) (*keyStates, error) {
 	ks := newKeyStates()
 	var ethkeystates []*ethkey.State
@@ -0,0 +1,144 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"golang.org/x/crypto/curve25519"
+)
+
+// The HTTP API of a remote signer. Byte strings are 0x-prefixed hex, and errors are returned with a non-2xx status
+// and an errorResponse body.
+//
+//	GET  /keys/{keyID}       -> publicKeyResponse
+//	POST /keys/{keyID}/sign  signRequest -> signResponse
+//	POST /keys/{keyID}/dh    diffieHellmanRequest -> diffieHellmanResponse
+type (
+	publicKeyResponse struct {
+		Type      KeyType       `json:"type"`
+		PublicKey hexutil.Bytes `json:"publicKey"`
+	}
+	signRequest struct {
+		Payload hexutil.Bytes `json:"payload"`
+	}
+	signResponse struct {
+		Signature hexutil.Bytes `json:"signature"`
+	}
+	diffieHellmanRequest struct {
+		Point hexutil.Bytes `json:"point"`
+	}
+	diffieHellmanResponse struct {
+		Shared hexutil.Bytes `json:"shared"`
+	}
+	errorResponse struct {
+		Error string `json:"error"`
+	}
+)
+
+// maxResponseSize bounds the responses read from the remote signer, which are all small.
+const maxResponseSize = 1 << 16
+
+var _ Signer = &HTTPSigner{}
+
+// HTTPSigner is a Signer that calls a remote signer over HTTP(S).
+type HTTPSigner struct {
+	baseURL   string
+	authToken string
+	client    *http.Client
+}
+
+// NewHTTPSigner returns a Signer for the remote signer at baseURL. If authToken is set, it is sent as a bearer token.
+func NewHTTPSigner(baseURL, authToken string, timeout time.Duration) (*HTTPSigner, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid remote signer URL: unsupported scheme %q", u.Scheme)
+	}
+	return &HTTPSigner{
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		authToken: authToken,
+		client:    &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (s *HTTPSigner) PublicKey(ctx context.Context, keyID string) (pk PublicKey, err error) {
+	var resp publicKeyResponse
+	if err = s.do(ctx, http.MethodGet, keyID, "", nil, &resp); err != nil {
+		return pk, err
+	}
+	return PublicKey{Type: resp.Type, Bytes: resp.PublicKey}, nil
+}
+
+func (s *HTTPSigner) Sign(ctx context.Context, keyID string, payload []byte) ([]byte, error) {
+	var resp signResponse
+	if err := s.do(ctx, http.MethodPost, keyID, "/sign", signRequest{Payload: payload}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Signature, nil
+}
+
+func (s *HTTPSigner) DiffieHellman(ctx context.Context, keyID string, point [curve25519.PointSize]byte) (shared [curve25519.PointSize]byte, err error) {
+	var resp diffieHellmanResponse
+	if err = s.do(ctx, http.MethodPost, keyID, "/dh", diffieHellmanRequest{Point: point[:]}, &resp); err != nil {
+		return shared, err
+	}
+	if len(resp.Shared) != curve25519.PointSize {
+		return shared, fmt.Errorf("remote signer returned a %d byte shared point", len(resp.Shared))
+	}
+	copy(shared[:], resp.Shared)
+	return shared, nil
+}
+
+func (s *HTTPSigner) do(ctx context.Context, method, keyID, path string, reqBody, respBody any) error {
+	var body io.Reader
+	if reqBody != nil {
+		b, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+"/keys/"+url.PathEscape(keyID)+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.authToken)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("remote signer request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("failed to read remote signer response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, keyID)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp errorResponse
+		if json.Unmarshal(b, &errResp) == nil && errResp.Error != "" {
+			return fmt.Errorf("remote signer returned %s: %s", resp.Status, errResp.Error)
+		}
+		return fmt.Errorf("remote signer returned %s", resp.Status)
+	}
+	if err = json.Unmarshal(b, respBody); err != nil {
+		return fmt.Errorf("failed to decode remote signer response: %w", err)
+	}
+	return nil
+}
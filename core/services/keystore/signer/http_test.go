@@ -0,0 +1,103 @@
+package signer_test
+
+import (
+	"crypto/ed25519"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
+)
+
+func TestHTTPSigner(t *testing.T) {
+	t.Parallel()
+
+	const authToken = "secret-token"
+	local := signer.NewLocal()
+	srv := httptest.NewServer(signer.NewHTTPHandler(local, authToken))
+	t.Cleanup(srv.Close)
+
+	s, err := signer.NewHTTPSigner(srv.URL+"/", authToken, time.Minute)
+	require.NoError(t, err)
+
+	t.Run("secp256k1", func(t *testing.T) {
+		ctx := tests.Context(t)
+		keyID := local.MustCreate(signer.Secp256k1)
+		pk, err := s.PublicKey(ctx, keyID)
+		require.NoError(t, err)
+		require.NoError(t, signer.CheckPublicKey(pk, signer.Secp256k1))
+
+		digest := crypto.Keccak256([]byte("hello"))
+		sig, err := s.Sign(ctx, keyID, digest)
+		require.NoError(t, err)
+		recovered, err := crypto.Ecrecover(digest, sig)
+		require.NoError(t, err)
+		assert.Equal(t, pk.Bytes, recovered)
+	})
+
+	t.Run("ed25519", func(t *testing.T) {
+		ctx := tests.Context(t)
+		keyID := local.MustCreate(signer.Ed25519)
+		pk, err := s.PublicKey(ctx, keyID)
+		require.NoError(t, err)
+		require.NoError(t, signer.CheckPublicKey(pk, signer.Ed25519))
+
+		sig, err := s.Sign(ctx, keyID, []byte("hello"))
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(pk.Bytes, []byte("hello"), sig))
+	})
+
+	t.Run("x25519", func(t *testing.T) {
+		ctx := tests.Context(t)
+		keyID := local.MustCreate(signer.X25519)
+		pk, err := s.PublicKey(ctx, keyID)
+		require.NoError(t, err)
+		require.NoError(t, signer.CheckPublicKey(pk, signer.X25519))
+
+		var scalar [curve25519.ScalarSize]byte
+		scalar[0] = 42
+		point, err := curve25519.X25519(scalar[:], curve25519.Basepoint)
+		require.NoError(t, err)
+		shared, err := s.DiffieHellman(ctx, keyID, [curve25519.PointSize]byte(point))
+		require.NoError(t, err)
+		expected, err := curve25519.X25519(scalar[:], pk.Bytes)
+		require.NoError(t, err)
+		assert.Equal(t, expected, shared[:])
+
+		_, err = s.Sign(ctx, keyID, []byte("hello"))
+		require.ErrorContains(t, err, "x25519 keys cannot sign")
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := s.PublicKey(tests.Context(t), "unknown")
+		require.ErrorIs(t, err, signer.ErrKeyNotFound)
+	})
+
+	t.Run("wrong auth token", func(t *testing.T) {
+		unauthorized, err := signer.NewHTTPSigner(srv.URL, "wrong", time.Minute)
+		require.NoError(t, err)
+		_, err = unauthorized.PublicKey(tests.Context(t), local.MustCreate(signer.Ed25519))
+		require.ErrorContains(t, err, "401 Unauthorized: unauthorized")
+	})
+
+	t.Run("invalid URL", func(t *testing.T) {
+		_, err := signer.NewHTTPSigner("ftp://signer", "", time.Minute)
+		require.ErrorContains(t, err, `unsupported scheme "ftp"`)
+	})
+}
+
+func TestCheckPublicKey(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, signer.CheckPublicKey(signer.PublicKey{Type: signer.Ed25519, Bytes: make([]byte, 32)}, signer.Ed25519))
+	require.ErrorContains(t, signer.CheckPublicKey(signer.PublicKey{Type: signer.Ed25519, Bytes: make([]byte, 32)}, signer.Secp256k1), "expected a secp256k1 key but got ed25519")
+	require.ErrorContains(t, signer.CheckPublicKey(signer.PublicKey{Type: signer.Secp256k1, Bytes: make([]byte, 33)}, signer.Secp256k1), "expected a 65 byte secp256k1 public key but got 33 bytes")
+	require.ErrorContains(t, signer.CheckPublicKey(signer.PublicKey{Type: "rsa", Bytes: nil}, "rsa"), `unknown key type "rsa"`)
+}
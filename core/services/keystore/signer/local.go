@@ -0,0 +1,203 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/curve25519"
+)
+
+var _ Signer = &Local{}
+
+// Local is an in-memory Signer. It stands in for a remote signer in tests and local development, either used
+// directly or served over HTTP with NewHTTPHandler. Do not use it in production: keys are lost on restart.
+type Local struct {
+	mu   sync.RWMutex
+	keys map[string]localKey
+}
+
+type localKey struct {
+	keyType KeyType
+	ecdsa   *ecdsa.PrivateKey
+	ed25519 ed25519.PrivateKey
+	x25519  [curve25519.ScalarSize]byte
+}
+
+// NewLocal returns an empty Local signer.
+func NewLocal() *Local {
+	return &Local{keys: make(map[string]localKey)}
+}
+
+// Create generates a new key of keyType and returns its ID.
+func (l *Local) Create(keyType KeyType) (string, error) {
+	var k localKey
+	var err error
+	switch keyType {
+	case Secp256k1:
+		k.ecdsa, err = crypto.GenerateKey()
+	case Ed25519:
+		_, k.ed25519, err = ed25519.GenerateKey(rand.Reader)
+	case X25519:
+		_, err = rand.Read(k.x25519[:])
+	default:
+		return "", fmt.Errorf("unknown key type %q", keyType)
+	}
+	if err != nil {
+		return "", err
+	}
+	k.keyType = keyType
+
+	id := make([]byte, 16)
+	if _, err = rand.Read(id); err != nil {
+		return "", err
+	}
+	keyID := hex.EncodeToString(id)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.keys[keyID] = k
+	return keyID, nil
+}
+
+// MustCreate is like Create, but panics on error.
+func (l *Local) MustCreate(keyType KeyType) string {
+	keyID, err := l.Create(keyType)
+	if err != nil {
+		panic(err)
+	}
+	return keyID
+}
+
+func (l *Local) get(keyID string) (localKey, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	k, ok := l.keys[keyID]
+	if !ok {
+		return k, fmt.Errorf("%w: %s", ErrKeyNotFound, keyID)
+	}
+	return k, nil
+}
+
+func (l *Local) PublicKey(_ context.Context, keyID string) (PublicKey, error) {
+	k, err := l.get(keyID)
+	if err != nil {
+		return PublicKey{}, err
+	}
+	switch k.keyType {
+	case Secp256k1:
+		return PublicKey{Type: Secp256k1, Bytes: crypto.FromECDSAPub(&k.ecdsa.PublicKey)}, nil
+	case Ed25519:
+		return PublicKey{Type: Ed25519, Bytes: k.ed25519.Public().(ed25519.PublicKey)}, nil
+	default:
+		pub, err := curve25519.X25519(k.x25519[:], curve25519.Basepoint)
+		return PublicKey{Type: X25519, Bytes: pub}, err
+	}
+}
+
+func (l *Local) Sign(_ context.Context, keyID string, payload []byte) ([]byte, error) {
+	k, err := l.get(keyID)
+	if err != nil {
+		return nil, err
+	}
+	switch k.keyType {
+	case Secp256k1:
+		return crypto.Sign(payload, k.ecdsa)
+	case Ed25519:
+		return ed25519.Sign(k.ed25519, payload), nil
+	default:
+		return nil, fmt.Errorf("%s keys cannot sign", k.keyType)
+	}
+}
+
+func (l *Local) DiffieHellman(_ context.Context, keyID string, point [curve25519.PointSize]byte) (shared [curve25519.PointSize]byte, err error) {
+	k, err := l.get(keyID)
+	if err != nil {
+		return shared, err
+	}
+	if k.keyType != X25519 {
+		return shared, fmt.Errorf("%s keys cannot be used for Diffie-Hellman", k.keyType)
+	}
+	p, err := curve25519.X25519(k.x25519[:], point[:])
+	if err != nil {
+		return shared, err
+	}
+	copy(shared[:], p)
+	return shared, nil
+}
+
+// NewHTTPHandler serves s with the HTTP API expected by HTTPSigner. If authToken is set, requests must carry it as a
+// bearer token.
+func NewHTTPHandler(s Signer, authToken string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /keys/{keyID}", func(w http.ResponseWriter, r *http.Request) {
+		pk, err := s.PublicKey(r.Context(), r.PathValue("keyID"))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, publicKeyResponse{Type: pk.Type, PublicKey: pk.Bytes})
+	})
+	mux.HandleFunc("POST /keys/{keyID}/sign", func(w http.ResponseWriter, r *http.Request) {
+		var req signRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+			return
+		}
+		sig, err := s.Sign(r.Context(), r.PathValue("keyID"), req.Payload)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, signResponse{Signature: sig})
+	})
+	mux.HandleFunc("POST /keys/{keyID}/dh", func(w http.ResponseWriter, r *http.Request) {
+		var req diffieHellmanRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+			return
+		}
+		if len(req.Point) != curve25519.PointSize {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("point must be %d bytes", curve25519.PointSize)})
+			return
+		}
+		shared, err := s.DiffieHellman(r.Context(), r.PathValue("keyID"), [curve25519.PointSize]byte(req.Point))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, diffieHellmanResponse{Shared: shared[:]})
+	})
+	if authToken == "" {
+		return mux
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+authToken {
+			writeJSON(w, http.StatusUnauthorized, errorResponse{Error: "unauthorized"})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	if errors.Is(err, ErrKeyNotFound) {
+		status = http.StatusNotFound
+	}
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,72 @@
+// Package signer provides access to private keys that are held outside of the node, for example in an HSM or in a
+// Vault transit-style service. The node only keeps the IDs and the public keys of such keys, and asks the Signer to
+// sign with them.
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+// KeyType is the algorithm of a key held by a Signer.
+type KeyType string
+
+const (
+	// Secp256k1 keys sign 32 byte digests, for Ethereum transactions and OCR2 onchain signatures.
+	Secp256k1 KeyType = "secp256k1"
+	// Ed25519 keys sign messages, for OCR2 offchain signatures.
+	Ed25519 KeyType = "ed25519"
+	// X25519 keys are used for Diffie-Hellman key exchanges, for OCR2 config encryption.
+	X25519 KeyType = "x25519"
+)
+
+var (
+	// ErrNotConfigured is returned when a remote key is used but the node has no Signer configured.
+	ErrNotConfigured = errors.New("remote signer is not configured")
+	// ErrKeyNotFound is returned when the Signer does not hold a key with the requested ID.
+	ErrKeyNotFound = errors.New("key not found in remote signer")
+)
+
+// PublicKey is the public part of a key held by a Signer.
+//   - Secp256k1: the 65 byte uncompressed point
+//   - Ed25519: the 32 byte public key
+//   - X25519: the 32 byte public point
+type PublicKey struct {
+	Type  KeyType
+	Bytes []byte
+}
+
+// Signer signs with keys that are referenced by the ID the Signer knows them by.
+type Signer interface {
+	// PublicKey returns the type and the public key of keyID.
+	PublicKey(ctx context.Context, keyID string) (PublicKey, error)
+	// Sign signs payload with keyID.
+	//   - Secp256k1: payload is a 32 byte digest, and the signature is 65 bytes in the [R || S || V] format, where V is 0 or 1
+	//   - Ed25519: payload is the message, and the signature is 64 bytes
+	Sign(ctx context.Context, keyID string, payload []byte) (signature []byte, err error)
+	// DiffieHellman multiplies point by the private scalar of the X25519 key keyID, and returns the shared point.
+	DiffieHellman(ctx context.Context, keyID string, point [curve25519.PointSize]byte) (shared [curve25519.PointSize]byte, err error)
+}
+
+// CheckPublicKey returns an error if pk is not a well-formed key of type expected.
+func CheckPublicKey(pk PublicKey, expected KeyType) error {
+	if pk.Type != expected {
+		return fmt.Errorf("expected a %s key but got %s", expected, pk.Type)
+	}
+	var size int
+	switch pk.Type {
+	case Secp256k1:
+		size = 65
+	case Ed25519, X25519:
+		size = 32
+	default:
+		return fmt.Errorf("unknown key type %q", pk.Type)
+	}
+	if len(pk.Bytes) != size {
+		return fmt.Errorf("expected a %d byte %s public key but got %d bytes", size, pk.Type, len(pk.Bytes))
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -231,6 +232,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) (services []
 			effectiveTransmitterAddress,
 			strategy,
 			checker,
+			txmgrtypes.TxPriorityHigh,
 			chain.ID(),
 			d.keyStore.Eth(),
 		)
@@ -213,6 +213,9 @@ func NewConnector(ctx context.Context, pluginConfig *config.PluginConfig, ethKey
 	if idx == -1 {
 		return nil, nil, errors.New("key for configured node address not found")
 	}
+	if _, remote := enabledKeys[idx].SignerKeyID(); remote {
+		return nil, nil, errors.New("key for configured node address is held by the remote signer, the gateway connector requires a local key")
+	}
 	signerKey := enabledKeys[idx].ToEcdsaPrivKey()
 	if enabledKeys[idx].ID() != pluginConfig.GatewayConnectorConfig.NodeAddress {
 		return nil, nil, errors.New("node address mismatch")
@@ -8,9 +8,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
 	"github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/forwarders"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/userops"
 )
 
 type roundRobinKeystore interface {
@@ -33,11 +36,12 @@ type transmitter struct {
 	effectiveTransmitterAddress common.Address
 	strategy                    types.TxStrategy
 	checker                     txmgr.TransmitCheckerSpec
+	priority                    types.TxPriority
 	chainID                     *big.Int
 	keystore                    roundRobinKeystore
 }
 
-// NewTransmitter creates a new eth transmitter
+// NewTransmitter creates a new eth transmitter, which sends its transactions with the given priority
 func NewTransmitter(
 	txm txManager,
 	fromAddresses []common.Address,
@@ -45,6 +49,7 @@ func NewTransmitter(
 	effectiveTransmitterAddress common.Address,
 	strategy types.TxStrategy,
 	checker txmgr.TransmitCheckerSpec,
+	priority types.TxPriority,
 	chainID *big.Int,
 	keystore roundRobinKeystore,
 ) (Transmitter, error) {
@@ -60,6 +65,7 @@ func NewTransmitter(
 		effectiveTransmitterAddress: effectiveTransmitterAddress,
 		strategy:                    strategy,
 		checker:                     checker,
+		priority:                    priority,
 		chainID:                     chainID,
 		keystore:                    keystore,
 	}, nil
@@ -86,6 +92,7 @@ func NewOCR2FeedsTransmitter(
 	effectiveTransmitterAddress common.Address,
 	strategy types.TxStrategy,
 	checker txmgr.TransmitCheckerSpec,
+	priority types.TxPriority,
 	chainID *big.Int,
 	keystore roundRobinKeystore,
 ) (Transmitter, error) {
@@ -104,12 +111,51 @@ func NewOCR2FeedsTransmitter(
 			effectiveTransmitterAddress: effectiveTransmitterAddress,
 			strategy:                    strategy,
 			checker:                     checker,
+			priority:                    priority,
 			chainID:                     chainID,
 			keystore:                    keystore,
 		},
 	}, nil
 }
 
+type userOpManager interface {
+	services.Service
+	Account() common.Address
+	SendUserOperation(ctx context.Context, sessionKey, toAddress common.Address, payload []byte, gasLimit uint64) (userops.UserOp, error)
+}
+
+type userOpTransmitter struct {
+	manager       userOpManager
+	fromAddresses []common.Address
+	gasLimit      uint64
+	chainID       *big.Int
+	keystore      roundRobinKeystore
+}
+
+// NewUserOpTransmitter creates a transmitter that sends reports as ERC-4337 user operations of the smart account of
+// manager, instead of as transactions. fromAddresses are used round-robin as session keys of the account.
+// The transmitter must be started and closed, since it runs the manager.
+func NewUserOpTransmitter(
+	manager userOpManager,
+	fromAddresses []common.Address,
+	gasLimit uint64,
+	chainID *big.Int,
+	keystore roundRobinKeystore,
+) (Transmitter, error) {
+	// Ensure that a keystore is provided.
+	if keystore == nil {
+		return nil, errors.New("nil keystore provided to transmitter")
+	}
+
+	return &userOpTransmitter{
+		manager:       manager,
+		fromAddresses: fromAddresses,
+		gasLimit:      gasLimit,
+		chainID:       chainID,
+		keystore:      keystore,
+	}, nil
+}
+
 func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte, txMeta *txmgr.TxMeta) error {
 	roundRobinFromAddress, err := t.keystore.GetRoundRobinAddress(ctx, t.chainID, t.fromAddresses...)
 	if err != nil {
@@ -125,6 +171,7 @@ func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Priority:         t.priority,
 	})
 	return errors.Wrap(err, "skipped OCR transmission")
 }
@@ -162,6 +209,7 @@ func (t *ocr2FeedsTransmitter) CreateEthTransaction(ctx context.Context, toAddre
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Priority:         t.priority,
 	})
 
 	return errors.Wrap(err, "skipped OCR transmission")
@@ -203,3 +251,21 @@ func (t *ocr2FeedsTransmitter) forwarderAddress(ctx context.Context, eoa, ocr2Ag
 
 	return forwarderAddress, nil
 }
+
+func (t *userOpTransmitter) Start(ctx context.Context) error { return t.manager.Start(ctx) }
+func (t *userOpTransmitter) Close() error                    { return t.manager.Close() }
+
+func (t *userOpTransmitter) CreateEthTransaction(ctx context.Context, toAddress common.Address, payload []byte, _ *txmgr.TxMeta) error {
+	sessionKey, err := t.keystore.GetRoundRobinAddress(ctx, t.chainID, t.fromAddresses...)
+	if err != nil {
+		return errors.Wrap(err, "skipped OCR transmission, error getting round-robin address")
+	}
+
+	_, err = t.manager.SendUserOperation(ctx, sessionKey, toAddress, payload, t.gasLimit)
+	return errors.Wrap(err, "skipped OCR transmission")
+}
+
+// FromAddress for userOpTransmitter returns the smart account, which is the one calling the contract.
+func (t *userOpTransmitter) FromAddress(context.Context) common.Address {
+	return t.manager.Account()
+}
@@ -1,6 +1,7 @@
 package ocrcommon_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -8,9 +9,13 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/userops"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
@@ -44,6 +49,7 @@ func Test_DefaultTransmitter_CreateEthTransaction(t *testing.T) {
 		effectiveTransmitterAddress,
 		strategy,
 		txmgr.TransmitCheckerSpec{},
+		txmgrtypes.TxPriorityHigh,
 		chainID,
 		ethKeyStore,
 	)
@@ -57,6 +63,7 @@ func Test_DefaultTransmitter_CreateEthTransaction(t *testing.T) {
 		ForwarderAddress: common.Address{},
 		Meta:             nil,
 		Strategy:         strategy,
+		Priority:         txmgrtypes.TxPriorityHigh,
 	}).Return(txmgr.Tx{}, nil).Once()
 	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload, nil))
 }
@@ -85,6 +92,7 @@ func Test_DefaultTransmitter_Forwarding_Enabled_CreateEthTransaction(t *testing.
 		effectiveTransmitterAddress,
 		strategy,
 		txmgr.TransmitCheckerSpec{},
+		txmgrtypes.TxPriorityHigh,
 		chainID,
 		ethKeyStore,
 	)
@@ -98,6 +106,7 @@ func Test_DefaultTransmitter_Forwarding_Enabled_CreateEthTransaction(t *testing.
 		ForwarderAddress: common.Address{},
 		Meta:             nil,
 		Strategy:         strategy,
+		Priority:         txmgrtypes.TxPriorityHigh,
 	}).Return(txmgr.Tx{}, nil).Once()
 	txm.On("CreateTransaction", mock.Anything, txmgr.TxRequest{
 		FromAddress:      fromAddress2,
@@ -107,6 +116,7 @@ func Test_DefaultTransmitter_Forwarding_Enabled_CreateEthTransaction(t *testing.
 		ForwarderAddress: common.Address{},
 		Meta:             nil,
 		Strategy:         strategy,
+		Priority:         txmgrtypes.TxPriorityHigh,
 	}).Return(txmgr.Tx{}, nil).Once()
 	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload, nil))
 	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload, nil))
@@ -135,6 +145,7 @@ func Test_DefaultTransmitter_Forwarding_Enabled_CreateEthTransaction_Round_Robin
 		effectiveTransmitterAddress,
 		strategy,
 		txmgr.TransmitCheckerSpec{},
+		txmgrtypes.TxPriorityHigh,
 		chainID,
 		ethKeyStore,
 	)
@@ -164,8 +175,49 @@ func Test_DefaultTransmitter_Forwarding_Enabled_CreateEthTransaction_No_Keystore
 		effectiveTransmitterAddress,
 		strategy,
 		txmgr.TransmitCheckerSpec{},
+		txmgrtypes.TxPriorityHigh,
 		chainID,
 		nil,
 	)
 	require.Error(t, err)
 }
+
+type fakeUserOpManager struct {
+	services.Service
+	account common.Address
+	sent    []common.Address
+}
+
+func (m *fakeUserOpManager) Account() common.Address { return m.account }
+
+func (m *fakeUserOpManager) SendUserOperation(_ context.Context, sessionKey, toAddress common.Address, payload []byte, gasLimit uint64) (userops.UserOp, error) {
+	m.sent = append(m.sent, sessionKey)
+	return userops.UserOp{ToAddress: toAddress}, nil
+}
+
+func Test_UserOpTransmitter_CreateEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, fromAddress2 := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	manager := &fakeUserOpManager{account: testutils.NewAddress()}
+	transmitter, err := ocrcommon.NewUserOpTransmitter(
+		manager,
+		[]common.Address{fromAddress, fromAddress2},
+		uint64(1000),
+		big.NewInt(0),
+		ethKeyStore,
+	)
+	require.NoError(t, err)
+
+	ctx := testutils.Context(t)
+	require.Equal(t, manager.account, transmitter.FromAddress(ctx))
+
+	require.NoError(t, transmitter.CreateEthTransaction(ctx, testutils.NewAddress(), []byte{1, 2, 3}, nil))
+	require.NoError(t, transmitter.CreateEthTransaction(ctx, testutils.NewAddress(), []byte{1, 2, 3}, nil))
+	require.ElementsMatch(t, []common.Address{fromAddress, fromAddress2}, manager.sent)
+}
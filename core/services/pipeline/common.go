@@ -108,6 +108,9 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+
+	// ErrorClass is used by retry policies to decide whether a failed task should be retried
+	ErrorClass ErrorClass
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -206,6 +209,10 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
+
+	// AttemptErrors holds the error of each attempt, null for a successful attempt
+	AttemptErrors RunErrors
+
 	// runInfo is never persisted
 	runInfo RunInfo
 }
@@ -322,11 +329,15 @@ const (
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypeOutlierFilter    TaskType = "outlierfilter"
 	TaskTypeSum              TaskType = "sum"
+	TaskTypeTrimmedMean      TaskType = "trimmedmean"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
 	TaskTypeVRFV2            TaskType = "vrfv2"
 	TaskTypeVRFV2Plus        TaskType = "vrfv2plus"
+	TaskTypeWASM             TaskType = "wasm"
+	TaskTypeWeightedMedian   TaskType = "weightedmedian"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -367,6 +378,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTrimmedMean:
+		task = &TrimmedMeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWeightedMedian:
+		task = &WeightedMedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeOutlierFilter:
+		task = &OutlierFilterTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
 		task = &SumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeAny:
@@ -425,6 +442,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &Base64DecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeBase64Encode:
 		task = &Base64EncodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWASM:
+		task = &WASMTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, pkgerrors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -474,6 +493,11 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		}
 	}
 
+	task.Base().retryOn, err = parseRetryOn(task.Base().RetryOn)
+	if err != nil {
+		return nil, err
+	}
+
 	return task, nil
 }
 
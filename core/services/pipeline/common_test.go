@@ -114,6 +114,16 @@ func TestRetryUnmarshal(t *testing.T) {
 	}
 }
 
+func TestRetryOnUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`ds1 [type=http retries=3 retryOn="http5xx, timeout" backoffJitter=true retryDeadline="10s"];`)
+	require.NoError(t, err)
+
+	_, err = pipeline.Parse(`ds1 [type=http retries=3 retryOn="http5xx,oops"];`)
+	require.ErrorContains(t, err, `unknown error class "oops" in retryOn`)
+}
+
 func TestUnmarshalTaskFromMap(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,237 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	promPipelineRunsExported = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_runs_exported",
+		Help: "The total number of finished pipeline runs written to each export sink",
+	},
+		[]string{"sink"},
+	)
+	promPipelineRunExportErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_run_export_errors",
+		Help: "The total number of finished pipeline runs that could not be written to each export sink",
+	},
+		[]string{"sink"},
+	)
+	promPipelineRunExportsDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_run_exports_dropped",
+		Help: "The total number of finished pipeline runs dropped because the export queue was full",
+	})
+)
+
+const (
+	// exportTimeout bounds a single attempt at writing a batch to a sink
+	exportTimeout = 30 * time.Second
+	// exportMaxAttempts is the number of times a batch is written to a sink before it is dropped
+	exportMaxAttempts = 3
+)
+
+// ExportedRun is the record of a finished run, and the results of its tasks, written to export sinks.
+type ExportedRun struct {
+	ID             int64                             `json:"id"`
+	JobID          int32                             `json:"jobId"`
+	JobName        string                            `json:"jobName"`
+	PipelineSpecID int32                             `json:"pipelineSpecId"`
+	State          RunStatus                         `json:"state"`
+	Meta           jsonserializable.JSONSerializable `json:"meta"`
+	Inputs         jsonserializable.JSONSerializable `json:"inputs"`
+	Outputs        jsonserializable.JSONSerializable `json:"outputs"`
+	AllErrors      RunErrors                         `json:"allErrors"`
+	FatalErrors    RunErrors                         `json:"fatalErrors"`
+	CreatedAt      time.Time                         `json:"createdAt"`
+	FinishedAt     null.Time                         `json:"finishedAt"`
+	TaskRuns       []TaskRun                         `json:"taskRuns"`
+}
+
+// NewExportedRun returns the export record of a finished run. All task runs are included, even if they are not
+// saved to the database.
+func NewExportedRun(run *Run) ExportedRun {
+	taskRuns := make([]TaskRun, len(run.PipelineTaskRuns))
+	copy(taskRuns, run.PipelineTaskRuns)
+	return ExportedRun{
+		ID:             run.ID,
+		JobID:          run.JobID,
+		JobName:        run.PipelineSpec.JobName,
+		PipelineSpecID: run.PipelineSpecID,
+		State:          run.State,
+		Meta:           run.Meta,
+		Inputs:         run.Inputs,
+		Outputs:        run.Outputs,
+		AllErrors:      run.AllErrors,
+		FatalErrors:    run.FatalErrors,
+		CreatedAt:      run.CreatedAt,
+		FinishedAt:     run.FinishedAt,
+		TaskRuns:       taskRuns,
+	}
+}
+
+// RunSink writes finished runs to an external system, alongside the database.
+type RunSink interface {
+	// Name identifies the sink in logs and metrics.
+	Name() string
+	// Export writes a batch of finished runs. It is retried if it returns an error, so it should not partially write
+	// the batch, or the external system should tolerate duplicates. The batch must not be retained after returning.
+	Export(ctx context.Context, runs []ExportedRun) error
+	Close() error
+}
+
+type ExportConfig interface {
+	BatchSize() uint32
+	FlushInterval() time.Duration
+	QueueDepth() uint32
+}
+
+// RunExporter streams finished runs to a set of sinks. Runs are buffered and written in batches by a single worker,
+// and dropped when the queue is full, so that a slow sink never slows down the runner.
+//
+// Runs are exported once they have been handed to the ORM, which does not guarantee that the transaction they were
+// inserted in is committed.
+type RunExporter struct {
+	services.StateMachine
+	lggr          logger.Logger
+	sinks         []RunSink
+	batchSize     int
+	flushInterval time.Duration
+
+	queue  chan ExportedRun
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+var _ services.Service = (*RunExporter)(nil)
+
+func NewRunExporter(cfg ExportConfig, lggr logger.Logger, sinks ...RunSink) *RunExporter {
+	return &RunExporter{
+		lggr:          lggr.Named("RunExporter"),
+		sinks:         sinks,
+		batchSize:     int(cfg.BatchSize()),
+		flushInterval: cfg.FlushInterval(),
+		queue:         make(chan ExportedRun, cfg.QueueDepth()),
+		stopCh:        make(services.StopChan),
+	}
+}
+
+func (e *RunExporter) Start(context.Context) error {
+	return e.StartOnce("RunExporter", func() error {
+		for _, sink := range e.sinks {
+			e.lggr.Infow("Exporting finished pipeline runs", "sink", sink.Name())
+		}
+		e.wg.Add(1)
+		go e.run()
+		return nil
+	})
+}
+
+// Close flushes the buffered runs and closes the sinks.
+func (e *RunExporter) Close() error {
+	return e.StopOnce("RunExporter", func() (err error) {
+		close(e.stopCh)
+		e.wg.Wait()
+		for _, sink := range e.sinks {
+			err = multierr.Append(err, sink.Close())
+		}
+		return err
+	})
+}
+
+func (e *RunExporter) Name() string {
+	return e.lggr.Name()
+}
+
+func (e *RunExporter) HealthReport() map[string]error {
+	return map[string]error{e.Name(): e.Healthy()}
+}
+
+// Export queues a finished run for export, without blocking.
+func (e *RunExporter) Export(run *Run) {
+	select {
+	case e.queue <- NewExportedRun(run):
+	default:
+		promPipelineRunExportsDropped.Inc()
+		e.lggr.Warnw("Export queue is full, dropping run", "runID", run.ID, "jobID", run.JobID)
+	}
+}
+
+func (e *RunExporter) run() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]ExportedRun, 0, e.batchSize)
+	for {
+		select {
+		case <-e.stopCh:
+			// drain the runs which are already queued, the runner is stopped before the exporter
+			for {
+				select {
+				case run := <-e.queue:
+					batch = append(batch, run)
+					if len(batch) >= e.batchSize {
+						e.flush(batch)
+						batch = batch[:0]
+					}
+				default:
+					e.flush(batch)
+					return
+				}
+			}
+		case run := <-e.queue:
+			batch = append(batch, run)
+			if len(batch) >= e.batchSize {
+				e.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			e.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (e *RunExporter) flush(batch []ExportedRun) {
+	if len(batch) == 0 {
+		return
+	}
+	for _, sink := range e.sinks {
+		var err error
+		for attempt := 1; attempt <= exportMaxAttempts; attempt++ {
+			ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+			err = sink.Export(ctx, batch)
+			cancel()
+			if err == nil {
+				break
+			}
+			e.lggr.Warnw("Failed to export pipeline runs", "sink", sink.Name(), "runs", len(batch), "attempt", attempt, "err", err)
+			if attempt < exportMaxAttempts {
+				// don't delay shutdown with backoffs
+				select {
+				case <-e.stopCh:
+				case <-time.After(time.Duration(attempt) * time.Second):
+				}
+			}
+		}
+		if err != nil {
+			promPipelineRunExportErrors.WithLabelValues(sink.Name()).Add(float64(len(batch)))
+			e.lggr.Errorw("Dropping pipeline runs which could not be exported", "sink", sink.Name(), "runs", len(batch), "err", err)
+			continue
+		}
+		promPipelineRunsExported.WithLabelValues(sink.Name()).Add(float64(len(batch)))
+	}
+}
@@ -0,0 +1,118 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// maxExportErrorBody caps how much of an error response is included in the returned error
+const maxExportErrorBody = 512
+
+// NDJSONFileSink appends finished runs to a file as newline delimited JSON, one run per line.
+type NDJSONFileSink struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+var _ RunSink = (*NDJSONFileSink)(nil)
+
+// NewNDJSONFileSink returns a sink appending to the file at path, which is created if it does not exist.
+func NewNDJSONFileSink(path string) *NDJSONFileSink {
+	return &NDJSONFileSink{path: path}
+}
+
+func (s *NDJSONFileSink) Name() string {
+	return "ndjson:" + s.path
+}
+
+func (s *NDJSONFileSink) Export(_ context.Context, runs []ExportedRun) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, run := range runs {
+		// Encode terminates each value with a newline
+		if err := enc.Encode(run); err != nil {
+			return errors.Wrapf(err, "failed to encode run %d", run.ID)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return errors.Wrap(err, "failed to open export file")
+		}
+		s.file = f
+	}
+	// write the batch with a single call, so that it is not interleaved with other processes appending to the file
+	if _, err := s.file.Write(buf.Bytes()); err != nil {
+		return errors.Wrap(err, "failed to write export file")
+	}
+	return nil
+}
+
+func (s *NDJSONFileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// HTTPBatchSink POSTs batches of finished runs to an HTTP endpoint, as a JSON array. Any 2xx response is a success.
+type HTTPBatchSink struct {
+	url    string
+	client *http.Client
+}
+
+var _ RunSink = (*HTTPBatchSink)(nil)
+
+func NewHTTPBatchSink(url string, client *http.Client) *HTTPBatchSink {
+	return &HTTPBatchSink{url: url, client: client}
+}
+
+func (s *HTTPBatchSink) Name() string {
+	return "http"
+}
+
+func (s *HTTPBatchSink) Export(ctx context.Context, runs []ExportedRun) error {
+	body, err := json.Marshal(runs)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode runs")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post runs")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxExportErrorBody))
+		return fmt.Errorf("unexpected response status %s: %s", resp.Status, b)
+	}
+	// drain the body, so that the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func (s *HTTPBatchSink) Close() error {
+	return nil
+}
@@ -0,0 +1,234 @@
+package pipeline_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+type exportConfig struct {
+	batchSize     uint32
+	flushInterval time.Duration
+	queueDepth    uint32
+}
+
+func (c exportConfig) BatchSize() uint32            { return c.batchSize }
+func (c exportConfig) FlushInterval() time.Duration { return c.flushInterval }
+func (c exportConfig) QueueDepth() uint32           { return c.queueDepth }
+
+func finishedRun(id int64) *pipeline.Run {
+	now := time.Now()
+	return &pipeline.Run{
+		ID:           id,
+		JobID:        7,
+		PipelineSpec: pipeline.Spec{JobName: "my job"},
+		State:        pipeline.RunStatusCompleted,
+		Inputs:       jsonserializable.JSONSerializable{Val: map[string]interface{}{"foo": "bar"}, Valid: true},
+		Outputs:      jsonserializable.JSONSerializable{Val: []interface{}{"42"}, Valid: true},
+		AllErrors:    pipeline.RunErrors{null.String{}},
+		FatalErrors:  pipeline.RunErrors{null.String{}},
+		CreatedAt:    now,
+		FinishedAt:   null.TimeFrom(now),
+		PipelineTaskRuns: []pipeline.TaskRun{{
+			Type:       pipeline.TaskTypeMultiply,
+			DotID:      "answer",
+			Output:     jsonserializable.JSONSerializable{Val: "42", Valid: true},
+			CreatedAt:  now,
+			FinishedAt: null.TimeFrom(now),
+		}},
+	}
+}
+
+// recordingSink collects exported runs, failing the first failures exports
+type recordingSink struct {
+	mu       sync.Mutex
+	failures int
+	batches  [][]int64
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Export(_ context.Context, runs []pipeline.ExportedRun) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("unavailable")
+	}
+	var ids []int64
+	for _, run := range runs {
+		ids = append(ids, run.ID)
+	}
+	s.batches = append(s.batches, ids)
+	return nil
+}
+
+func (s *recordingSink) Close() error { return nil }
+
+func (s *recordingSink) ids() (ids []int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, batch := range s.batches {
+		ids = append(ids, batch...)
+	}
+	return
+}
+
+func TestNDJSONFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runs.ndjson")
+	sink := pipeline.NewNDJSONFileSink(path)
+
+	require.NoError(t, sink.Export(testutils.Context(t), []pipeline.ExportedRun{pipeline.NewExportedRun(finishedRun(1)), pipeline.NewExportedRun(finishedRun(2))}))
+	require.NoError(t, sink.Export(testutils.Context(t), []pipeline.ExportedRun{pipeline.NewExportedRun(finishedRun(3))}))
+	require.NoError(t, sink.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var ids []int64
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		assert.Equal(t, "my job", line["jobName"])
+		assert.Equal(t, map[string]interface{}{"foo": "bar"}, line["inputs"])
+		taskRuns := line["taskRuns"].([]interface{})
+		require.Len(t, taskRuns, 1)
+		assert.Equal(t, "answer", taskRuns[0].(map[string]interface{})["dotId"])
+		assert.Equal(t, "42", taskRuns[0].(map[string]interface{})["output"])
+		ids = append(ids, int64(line["id"].(float64)))
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+
+	// appends to the existing file after reopening
+	sink = pipeline.NewNDJSONFileSink(path)
+	require.NoError(t, sink.Export(testutils.Context(t), []pipeline.ExportedRun{pipeline.NewExportedRun(finishedRun(4))}))
+	require.NoError(t, sink.Close())
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 4, bytes.Count(b, []byte("\n")))
+}
+
+func TestHTTPBatchSink(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		batches [][]pipeline.ExportedRun
+		status  = http.StatusOK
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte("try again later"))
+			return
+		}
+		var runs []pipeline.ExportedRun
+		require.NoError(t, json.Unmarshal(b, &runs))
+		batches = append(batches, runs)
+	}))
+	defer server.Close()
+
+	sink := pipeline.NewHTTPBatchSink(server.URL, server.Client())
+
+	t.Run("posts a batch as a JSON array", func(t *testing.T) {
+		require.NoError(t, sink.Export(testutils.Context(t), []pipeline.ExportedRun{pipeline.NewExportedRun(finishedRun(1)), pipeline.NewExportedRun(finishedRun(2))}))
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, batches, 1)
+		require.Len(t, batches[0], 2)
+		assert.Equal(t, int64(2), batches[0][1].ID)
+		assert.Equal(t, pipeline.RunStatusCompleted, batches[0][1].State)
+		require.Len(t, batches[0][1].TaskRuns, 1)
+		assert.Equal(t, "answer", batches[0][1].TaskRuns[0].DotID)
+	})
+
+	t.Run("fails on non-2xx responses", func(t *testing.T) {
+		mu.Lock()
+		status = http.StatusServiceUnavailable
+		mu.Unlock()
+
+		err := sink.Export(testutils.Context(t), []pipeline.ExportedRun{pipeline.NewExportedRun(finishedRun(3))})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "503")
+		assert.Contains(t, err.Error(), "try again later")
+	})
+}
+
+func TestRunExporter(t *testing.T) {
+	t.Run("writes batches and flushes on close", func(t *testing.T) {
+		sink := &recordingSink{}
+		exporter := pipeline.NewRunExporter(exportConfig{batchSize: 2, flushInterval: time.Hour, queueDepth: 10}, logger.TestLogger(t), sink)
+		require.NoError(t, exporter.Start(testutils.Context(t)))
+
+		for i := int64(1); i <= 5; i++ {
+			exporter.Export(finishedRun(i))
+		}
+		require.Eventually(t, func() bool { return len(sink.ids()) == 4 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		require.NoError(t, exporter.Close())
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, sink.ids())
+		assert.Equal(t, [][]int64{{1, 2}, {3, 4}, {5}}, sink.batches)
+	})
+
+	t.Run("flushes partial batches periodically", func(t *testing.T) {
+		sink := &recordingSink{}
+		exporter := pipeline.NewRunExporter(exportConfig{batchSize: 100, flushInterval: 10 * time.Millisecond, queueDepth: 10}, logger.TestLogger(t), sink)
+		require.NoError(t, exporter.Start(testutils.Context(t)))
+		defer func() { require.NoError(t, exporter.Close()) }()
+
+		exporter.Export(finishedRun(1))
+		require.Eventually(t, func() bool { return len(sink.ids()) == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	})
+
+	t.Run("retries failed exports", func(t *testing.T) {
+		sink := &recordingSink{failures: 1}
+		exporter := pipeline.NewRunExporter(exportConfig{batchSize: 1, flushInterval: time.Hour, queueDepth: 10}, logger.TestLogger(t), sink)
+		require.NoError(t, exporter.Start(testutils.Context(t)))
+		defer func() { require.NoError(t, exporter.Close()) }()
+
+		exporter.Export(finishedRun(1))
+		require.Eventually(t, func() bool { return len(sink.ids()) == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	})
+
+	t.Run("drops runs when the queue is full", func(t *testing.T) {
+		sink := &recordingSink{}
+		exporter := pipeline.NewRunExporter(exportConfig{batchSize: 10, flushInterval: time.Hour, queueDepth: 2}, logger.TestLogger(t), sink)
+
+		// not started yet, so nothing is consumed from the queue
+		for i := int64(1); i <= 3; i++ {
+			exporter.Export(finishedRun(i))
+		}
+		require.NoError(t, exporter.Start(testutils.Context(t)))
+		require.NoError(t, exporter.Close())
+		assert.Equal(t, []int64{1, 2}, sink.ids())
+	})
+}
@@ -0,0 +1,352 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	promPipelineRunsLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_runs_limited",
+		Help: "The total number of pipeline runs rejected for exceeding a per-job limit",
+	},
+		[]string{"job_id", "job_name", "limit"},
+	)
+	promPipelineRunsWaiting = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_runs_waiting_for_limits",
+		Help: "The number of pipeline runs waiting for their job to be within its limits",
+	},
+		[]string{"job_id", "job_name"},
+	)
+)
+
+// ErrRunLimited is returned for runs rejected for exceeding a per-job limit.
+var ErrRunLimited = errors.New("pipeline run limited")
+
+const (
+	limitConcurrentRuns = "concurrent_runs"
+	limitRunsPerMinute  = "runs_per_minute"
+	limitTaskTime       = "task_time_per_minute"
+	limitQueuedRuns     = "queued_runs"
+
+	limitModeReject = "reject"
+
+	// limitWindow is the window that runs per minute and task time per minute are counted over
+	limitWindow = time.Minute
+)
+
+type LimitsConfig interface {
+	MaxConcurrentRuns() uint32
+	MaxRunsPerMinute() uint32
+	MaxTaskTimePerMinute() time.Duration
+	Mode() string
+	MaxQueuedRuns() uint32
+	ExemptJobTypes() []string
+}
+
+// RunLimits are the run limits of a single job. Unset limits default to the node's LimitsConfig, and a limit of 0
+// disables it.
+type RunLimits struct {
+	MaxConcurrentRuns    *uint32
+	MaxRunsPerMinute     *uint32
+	MaxTaskTimePerMinute *time.Duration
+}
+
+// RecordJobErrorFn records an error against a job, such as job.ORM.TryRecordError.
+type RecordJobErrorFn func(ctx context.Context, jobID int32, description string)
+
+// RunLimiter enforces per-job limits on the runs started by the runner, so that a single noisy job cannot starve the
+// others of database connections and workers. Each job has its own budget, set by the job's RunLimits or else by the
+// node's LimitsConfig, which does not apply to exempt job types. Runs exceeding a limit either wait until the job is
+// within its limits, or are rejected with ErrRunLimited.
+type RunLimiter struct {
+	lggr              logger.Logger
+	maxConcurrentRuns uint32
+	maxRunsPerMinute  uint32
+	maxTaskTime       time.Duration
+	maxQueuedRuns     uint32
+	reject            bool
+	exempt            map[string]struct{}
+	recordError       RecordJobErrorFn
+
+	mu   sync.Mutex
+	jobs map[int32]*jobRunLimits
+}
+
+type jobRunLimits struct {
+	maxConcurrentRuns uint32
+	maxRunsPerMinute  uint32
+	maxTaskTime       time.Duration
+
+	slots chan struct{}
+	runs  *rate.Limiter
+
+	// guarded by RunLimiter.mu
+	queued        uint32
+	windowStart   time.Time
+	taskTime      time.Duration
+	errorRecorded time.Time
+}
+
+func NewRunLimiter(cfg LimitsConfig, lggr logger.Logger, recordError RecordJobErrorFn) *RunLimiter {
+	exempt := make(map[string]struct{})
+	for _, t := range cfg.ExemptJobTypes() {
+		exempt[t] = struct{}{}
+	}
+	return &RunLimiter{
+		lggr:              lggr.Named("RunLimiter"),
+		maxConcurrentRuns: cfg.MaxConcurrentRuns(),
+		maxRunsPerMinute:  cfg.MaxRunsPerMinute(),
+		maxTaskTime:       cfg.MaxTaskTimePerMinute(),
+		maxQueuedRuns:     cfg.MaxQueuedRuns(),
+		reject:            cfg.Mode() == limitModeReject,
+		exempt:            exempt,
+		recordError:       recordError,
+		jobs:              make(map[int32]*jobRunLimits),
+	}
+}
+
+// acquire admits a run of spec's job, waiting for the job to be within its limits unless runs are rejected. The
+// returned release func must be called with the results of the run once it has finished.
+func (l *RunLimiter) acquire(ctx context.Context, spec Spec) (release func(TaskRunResults), err error) {
+	release = func(TaskRunResults) {}
+	if l == nil || spec.JobID == 0 {
+		return
+	}
+	jl := l.limitsFor(spec)
+	if !jl.enabled() {
+		return
+	}
+
+	var limit string
+	if l.reject {
+		limit = l.tryAcquire(jl)
+	} else {
+		limit, err = l.wait(ctx, spec, jl)
+	}
+	if limit != "" {
+		return release, l.limited(ctx, spec, jl, limit, err)
+	}
+
+	return func(trrs TaskRunResults) { l.release(jl, trrs) }, nil
+}
+
+// SetJobLimits sets the limits of spec's job, which are built once when the job starts. Runs of the job, including
+// resumed runs whose spec does not carry the job's limits, are looked up by job ID.
+func (l *RunLimiter) SetJobLimits(spec Spec) {
+	if l == nil || spec.JobID == 0 {
+		return
+	}
+	jl := l.newJobRunLimits(spec)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// keep the existing limits if they are unchanged, so that runs of a restarted job keep their slots
+	if old, ok := l.jobs[spec.JobID]; ok && old.sameLimits(jl) {
+		return
+	}
+	l.jobs[spec.JobID] = jl
+}
+
+// RemoveJob drops the limits of a stopped job.
+func (l *RunLimiter) RemoveJob(jobID int32) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.jobs, jobID)
+}
+
+// limitsFor returns the limits of spec's job, building them from spec only if the job has none yet.
+func (l *RunLimiter) limitsFor(spec Spec) *jobRunLimits {
+	l.mu.Lock()
+	jl, ok := l.jobs[spec.JobID]
+	l.mu.Unlock()
+	if ok {
+		return jl
+	}
+
+	jl = l.newJobRunLimits(spec)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if existing, ok := l.jobs[spec.JobID]; ok {
+		return existing
+	}
+	l.jobs[spec.JobID] = jl
+	return jl
+}
+
+func (l *RunLimiter) newJobRunLimits(spec Spec) *jobRunLimits {
+	var maxConcurrentRuns, maxRunsPerMinute uint32
+	var maxTaskTime time.Duration
+	if _, ok := l.exempt[spec.JobType]; !ok {
+		maxConcurrentRuns, maxRunsPerMinute, maxTaskTime = l.maxConcurrentRuns, l.maxRunsPerMinute, l.maxTaskTime
+	}
+	if v := spec.Limits.MaxConcurrentRuns; v != nil {
+		maxConcurrentRuns = *v
+	}
+	if v := spec.Limits.MaxRunsPerMinute; v != nil {
+		maxRunsPerMinute = *v
+	}
+	if v := spec.Limits.MaxTaskTimePerMinute; v != nil {
+		maxTaskTime = *v
+	}
+
+	jl := &jobRunLimits{
+		maxConcurrentRuns: maxConcurrentRuns,
+		maxRunsPerMinute:  maxRunsPerMinute,
+		maxTaskTime:       maxTaskTime,
+	}
+	if maxConcurrentRuns > 0 {
+		jl.slots = make(chan struct{}, maxConcurrentRuns)
+	}
+	if maxRunsPerMinute > 0 {
+		jl.runs = rate.NewLimiter(rate.Limit(float64(maxRunsPerMinute)/limitWindow.Seconds()), int(maxRunsPerMinute))
+	}
+	return jl
+}
+
+func (jl *jobRunLimits) sameLimits(other *jobRunLimits) bool {
+	return jl.maxConcurrentRuns == other.maxConcurrentRuns && jl.maxRunsPerMinute == other.maxRunsPerMinute && jl.maxTaskTime == other.maxTaskTime
+}
+
+func (jl *jobRunLimits) enabled() bool {
+	return jl.maxConcurrentRuns > 0 || jl.maxRunsPerMinute > 0 || jl.maxTaskTime > 0
+}
+
+// tryAcquire admits a run without waiting, or returns the limit that it exceeds.
+func (l *RunLimiter) tryAcquire(jl *jobRunLimits) string {
+	if _, ok := l.taskTimeAvailable(jl, time.Now()); !ok {
+		return limitTaskTime
+	}
+	if jl.slots != nil {
+		select {
+		case jl.slots <- struct{}{}:
+		default:
+			return limitConcurrentRuns
+		}
+	}
+	if jl.runs != nil && !jl.runs.Allow() {
+		if jl.slots != nil {
+			<-jl.slots
+		}
+		return limitRunsPerMinute
+	}
+	return ""
+}
+
+// wait blocks until a run is admitted, or returns the limit that it was waiting on when ctx expired.
+func (l *RunLimiter) wait(ctx context.Context, spec Spec, jl *jobRunLimits) (string, error) {
+	l.mu.Lock()
+	if l.maxQueuedRuns > 0 && jl.queued >= l.maxQueuedRuns {
+		l.mu.Unlock()
+		return limitQueuedRuns, nil
+	}
+	jl.queued++
+	l.mu.Unlock()
+	queued := promPipelineRunsWaiting.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName)
+	queued.Inc()
+	defer func() {
+		queued.Dec()
+		l.mu.Lock()
+		jl.queued--
+		l.mu.Unlock()
+	}()
+
+	for {
+		wait, ok := l.taskTimeAvailable(jl, time.Now())
+		if ok {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return limitTaskTime, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+	if jl.runs != nil {
+		if err := jl.runs.Wait(ctx); err != nil {
+			return limitRunsPerMinute, err
+		}
+	}
+	if jl.slots != nil {
+		select {
+		case <-ctx.Done():
+			return limitConcurrentRuns, ctx.Err()
+		case jl.slots <- struct{}{}:
+		}
+	}
+	return "", nil
+}
+
+// taskTimeAvailable returns whether the job has task time left in the current window, or else how long until the
+// next window starts.
+func (l *RunLimiter) taskTimeAvailable(jl *jobRunLimits, now time.Time) (time.Duration, bool) {
+	if jl.maxTaskTime <= 0 {
+		return 0, true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if end := jl.windowStart.Add(limitWindow); !now.Before(end) {
+		jl.windowStart = now
+		jl.taskTime = 0
+	}
+	if jl.taskTime < jl.maxTaskTime {
+		return 0, true
+	}
+	return jl.windowStart.Add(limitWindow).Sub(now), false
+}
+
+func (l *RunLimiter) release(jl *jobRunLimits, trrs TaskRunResults) {
+	if jl.slots != nil {
+		<-jl.slots
+	}
+	if jl.maxTaskTime <= 0 {
+		return
+	}
+	var taskTime time.Duration
+	for _, trr := range trrs {
+		if trr.FinishedAt.Valid {
+			taskTime += trr.FinishedAt.Time.Sub(trr.CreatedAt)
+		}
+	}
+	l.mu.Lock()
+	jl.taskTime += taskTime
+	l.mu.Unlock()
+}
+
+// limited counts and logs a rejected run, and records it as a job error at most once per window.
+func (l *RunLimiter) limited(ctx context.Context, spec Spec, jl *jobRunLimits, limit string, cause error) error {
+	promPipelineRunsLimited.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, limit).Inc()
+
+	err := fmt.Errorf("%w: job %d exceeded its %s limit", ErrRunLimited, spec.JobID, limit)
+	if cause != nil {
+		err = fmt.Errorf("%w while queued: %v", err, cause)
+	}
+	l.lggr.Warnw("Pipeline run limited", "jobID", spec.JobID, "jobName", spec.JobName, "limit", limit, "err", err)
+
+	now := time.Now()
+	l.mu.Lock()
+	record := now.Sub(jl.errorRecorded) >= limitWindow
+	if record {
+		jl.errorRecorded = now
+	}
+	l.mu.Unlock()
+	if record && l.recordError != nil {
+		// ctx may have expired while the run was queued
+		rctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		l.recordError(rctx, spec.JobID, fmt.Sprintf("Pipeline runs limited: exceeded the %s limit", limit))
+	}
+	return err
+}
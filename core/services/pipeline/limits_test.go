@@ -0,0 +1,262 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+type limitsConfig struct {
+	maxConcurrentRuns    uint32
+	maxRunsPerMinute     uint32
+	maxTaskTimePerMinute time.Duration
+	mode                 string
+	maxQueuedRuns        uint32
+	exemptJobTypes       []string
+}
+
+func (c limitsConfig) MaxConcurrentRuns() uint32           { return c.maxConcurrentRuns }
+func (c limitsConfig) MaxRunsPerMinute() uint32            { return c.maxRunsPerMinute }
+func (c limitsConfig) MaxTaskTimePerMinute() time.Duration { return c.maxTaskTimePerMinute }
+func (c limitsConfig) Mode() string                        { return c.mode }
+func (c limitsConfig) MaxQueuedRuns() uint32               { return c.maxQueuedRuns }
+func (c limitsConfig) ExemptJobTypes() []string            { return c.exemptJobTypes }
+
+type recordedErrors struct {
+	mu     sync.Mutex
+	errors map[int32][]string
+}
+
+func (r *recordedErrors) record(_ context.Context, jobID int32, description string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.errors == nil {
+		r.errors = make(map[int32][]string)
+	}
+	r.errors[jobID] = append(r.errors[jobID], description)
+}
+
+func (r *recordedErrors) get(jobID int32) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.errors[jobID]
+}
+
+func TestRunLimiter(t *testing.T) {
+	t.Parallel()
+
+	spec := Spec{JobID: 1, JobName: "noisy", JobType: "webhook"}
+
+	t.Run("disabled", func(t *testing.T) {
+		var nilLimiter *RunLimiter
+		_, err := nilLimiter.acquire(testutils.Context(t), spec)
+		require.NoError(t, err)
+
+		l := NewRunLimiter(limitsConfig{mode: "reject"}, logger.TestLogger(t), nil)
+		for i := 0; i < 10; i++ {
+			_, err = l.acquire(testutils.Context(t), spec)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("rejects concurrent runs and records a job error once", func(t *testing.T) {
+		var recorded recordedErrors
+		l := NewRunLimiter(limitsConfig{maxConcurrentRuns: 2, mode: "reject"}, logger.TestLogger(t), recorded.record)
+		ctx := testutils.Context(t)
+
+		release1, err := l.acquire(ctx, spec)
+		require.NoError(t, err)
+		_, err = l.acquire(ctx, spec)
+		require.NoError(t, err)
+
+		_, err = l.acquire(ctx, spec)
+		require.ErrorIs(t, err, ErrRunLimited)
+		assert.Contains(t, err.Error(), limitConcurrentRuns)
+		_, err = l.acquire(ctx, spec)
+		require.ErrorIs(t, err, ErrRunLimited)
+		assert.Len(t, recorded.get(spec.JobID), 1)
+
+		// other jobs have their own limits
+		_, err = l.acquire(ctx, Spec{JobID: 2, JobType: "webhook"})
+		require.NoError(t, err)
+
+		release1(nil)
+		_, err = l.acquire(ctx, spec)
+		require.NoError(t, err)
+	})
+
+	t.Run("exempt job types and ad-hoc runs are not limited", func(t *testing.T) {
+		l := NewRunLimiter(limitsConfig{maxConcurrentRuns: 1, mode: "reject", exemptJobTypes: []string{"offchainreporting2"}}, logger.TestLogger(t), nil)
+		ctx := testutils.Context(t)
+		for i := 0; i < 3; i++ {
+			_, err := l.acquire(ctx, Spec{JobID: 1, JobType: "offchainreporting2"})
+			require.NoError(t, err)
+			_, err = l.acquire(ctx, Spec{})
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("job limits override the defaults", func(t *testing.T) {
+		l := NewRunLimiter(limitsConfig{maxConcurrentRuns: 1, mode: "reject", exemptJobTypes: []string{"offchainreporting2"}}, logger.TestLogger(t), nil)
+		ctx := testutils.Context(t)
+
+		two, zero := uint32(2), uint32(0)
+		limited := Spec{JobID: 1, JobType: "webhook", Limits: RunLimits{MaxConcurrentRuns: &two}}
+		for i := 0; i < 2; i++ {
+			_, err := l.acquire(ctx, limited)
+			require.NoError(t, err)
+		}
+		_, err := l.acquire(ctx, limited)
+		require.ErrorIs(t, err, ErrRunLimited)
+
+		unlimited := Spec{JobID: 2, JobType: "webhook", Limits: RunLimits{MaxConcurrentRuns: &zero}}
+		for i := 0; i < 3; i++ {
+			_, err = l.acquire(ctx, unlimited)
+			require.NoError(t, err)
+		}
+
+		// limits set by an exempt job still apply
+		exempt := Spec{JobID: 3, JobType: "offchainreporting2", Limits: RunLimits{MaxConcurrentRuns: &two}}
+		for i := 0; i < 2; i++ {
+			_, err = l.acquire(ctx, exempt)
+			require.NoError(t, err)
+		}
+		_, err = l.acquire(ctx, exempt)
+		require.ErrorIs(t, err, ErrRunLimited)
+	})
+
+	t.Run("runs without the job's limits do not reset them", func(t *testing.T) {
+		l := NewRunLimiter(limitsConfig{mode: "reject"}, logger.TestLogger(t), nil)
+		ctx := testutils.Context(t)
+
+		two := uint32(2)
+		l.SetJobLimits(Spec{JobID: 1, JobType: "webhook", Limits: RunLimits{MaxConcurrentRuns: &two}})
+		// like a resumed run, whose spec is loaded without the job's limits
+		resumed := Spec{JobID: 1, JobType: "webhook"}
+		release, err := l.acquire(ctx, resumed)
+		require.NoError(t, err)
+		_, err = l.acquire(ctx, resumed)
+		require.NoError(t, err)
+		_, err = l.acquire(ctx, resumed)
+		require.ErrorIs(t, err, ErrRunLimited)
+
+		// restarting the job with the same limits keeps the slots in use
+		l.SetJobLimits(Spec{JobID: 1, JobType: "webhook", Limits: RunLimits{MaxConcurrentRuns: &two}})
+		_, err = l.acquire(ctx, resumed)
+		require.ErrorIs(t, err, ErrRunLimited)
+		release(nil)
+		_, err = l.acquire(ctx, resumed)
+		require.NoError(t, err)
+
+		l.RemoveJob(1)
+		_, err = l.acquire(ctx, resumed)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects runs over the rate", func(t *testing.T) {
+		l := NewRunLimiter(limitsConfig{maxRunsPerMinute: 3, mode: "reject"}, logger.TestLogger(t), nil)
+		ctx := testutils.Context(t)
+		for i := 0; i < 3; i++ {
+			_, err := l.acquire(ctx, spec)
+			require.NoError(t, err)
+		}
+		_, err := l.acquire(ctx, spec)
+		require.ErrorIs(t, err, ErrRunLimited)
+		assert.Contains(t, err.Error(), limitRunsPerMinute)
+	})
+
+	t.Run("rejects runs once the task time is used up", func(t *testing.T) {
+		l := NewRunLimiter(limitsConfig{maxTaskTimePerMinute: time.Second, mode: "reject"}, logger.TestLogger(t), nil)
+		ctx := testutils.Context(t)
+
+		release, err := l.acquire(ctx, spec)
+		require.NoError(t, err)
+		now := time.Now()
+		release(TaskRunResults{
+			{CreatedAt: now.Add(-700 * time.Millisecond), FinishedAt: null.TimeFrom(now)},
+			{CreatedAt: now.Add(-500 * time.Millisecond), FinishedAt: null.TimeFrom(now)},
+		})
+
+		_, err = l.acquire(ctx, spec)
+		require.ErrorIs(t, err, ErrRunLimited)
+		assert.Contains(t, err.Error(), limitTaskTime)
+	})
+
+	t.Run("queues runs until a slot is released", func(t *testing.T) {
+		l := NewRunLimiter(limitsConfig{maxConcurrentRuns: 1, mode: "queue", maxQueuedRuns: 1}, logger.TestLogger(t), nil)
+		ctx := testutils.Context(t)
+
+		release, err := l.acquire(ctx, spec)
+		require.NoError(t, err)
+
+		admitted := make(chan error)
+		go func() {
+			_, err := l.acquire(ctx, spec)
+			admitted <- err
+		}()
+		require.Eventually(t, func() bool {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			return l.jobs[spec.JobID].queued == 1
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		// the queue is full
+		_, err = l.acquire(ctx, spec)
+		require.ErrorIs(t, err, ErrRunLimited)
+		assert.Contains(t, err.Error(), limitQueuedRuns)
+
+		release(nil)
+		select {
+		case err = <-admitted:
+			require.NoError(t, err)
+		case <-ctx.Done():
+			t.Fatal("queued run was not admitted")
+		}
+	})
+
+	t.Run("queues any number of runs when MaxQueuedRuns is 0", func(t *testing.T) {
+		l := NewRunLimiter(limitsConfig{maxConcurrentRuns: 1, mode: "queue"}, logger.TestLogger(t), nil)
+		ctx := testutils.Context(t)
+
+		release, err := l.acquire(ctx, spec)
+		require.NoError(t, err)
+
+		admitted := make(chan error)
+		for i := 0; i < 3; i++ {
+			go func() {
+				release, err := l.acquire(ctx, spec)
+				release(nil)
+				admitted <- err
+			}()
+		}
+		release(nil)
+		for i := 0; i < 3; i++ {
+			select {
+			case err = <-admitted:
+				require.NoError(t, err)
+			case <-ctx.Done():
+				t.Fatal("queued run was not admitted")
+			}
+		}
+	})
+
+	t.Run("queued runs are rejected when their context expires", func(t *testing.T) {
+		l := NewRunLimiter(limitsConfig{maxConcurrentRuns: 1, mode: "queue", maxQueuedRuns: 10}, logger.TestLogger(t), nil)
+		_, err := l.acquire(testutils.Context(t), spec)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(testutils.Context(t), 50*time.Millisecond)
+		defer cancel()
+		_, err = l.acquire(ctx, spec)
+		require.ErrorIs(t, err, ErrRunLimited)
+		assert.Contains(t, err.Error(), limitConcurrentRuns)
+	})
+}
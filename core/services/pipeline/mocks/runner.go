@@ -534,6 +534,66 @@ func (_c *Runner_Ready_Call) RunAndReturn(run func() error) *Runner_Ready_Call {
 	return _c
 }
 
+// ReplayRun provides a mock function with given fields: ctx, runID, dotDagSource
+func (_m *Runner) ReplayRun(ctx context.Context, runID int64, dotDagSource string) (*pipeline.RunReplay, error) {
+	ret := _m.Called(ctx, runID, dotDagSource)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayRun")
+	}
+
+	var r0 *pipeline.RunReplay
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*pipeline.RunReplay, error)); ok {
+		return rf(ctx, runID, dotDagSource)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *pipeline.RunReplay); ok {
+		r0 = rf(ctx, runID, dotDagSource)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.RunReplay)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, runID, dotDagSource)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Runner_ReplayRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayRun'
+type Runner_ReplayRun_Call struct {
+	*mock.Call
+}
+
+// ReplayRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int64
+//   - dotDagSource string
+func (_e *Runner_Expecter) ReplayRun(ctx interface{}, runID interface{}, dotDagSource interface{}) *Runner_ReplayRun_Call {
+	return &Runner_ReplayRun_Call{Call: _e.mock.On("ReplayRun", ctx, runID, dotDagSource)}
+}
+
+func (_c *Runner_ReplayRun_Call) Run(run func(ctx context.Context, runID int64, dotDagSource string)) *Runner_ReplayRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) Return(_a0 *pipeline.RunReplay, _a1 error) *Runner_ReplayRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Runner_ReplayRun_Call) RunAndReturn(run func(context.Context, int64, string) (*pipeline.RunReplay, error)) *Runner_ReplayRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResumeRun provides a mock function with given fields: ctx, taskID, value, err
 func (_m *Runner) ResumeRun(ctx context.Context, taskID uuid.UUID, value interface{}, err error) error {
 	ret := _m.Called(ctx, taskID, value, err)
@@ -642,6 +702,76 @@ func (_c *Runner_Run_Call) RunAndReturn(run func(context.Context, *pipeline.Run,
 	return _c
 }
 
+// SimulateRun provides a mock function with given fields: ctx, spec, vars, stubs
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs pipeline.SimulationStubs) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, stubs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateRun")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulationStubs) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, spec, vars, stubs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulationStubs) *pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, stubs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulationStubs) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, stubs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulationStubs) error); ok {
+		r2 = rf(ctx, spec, vars, stubs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Runner_SimulateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateRun'
+type Runner_SimulateRun_Call struct {
+	*mock.Call
+}
+
+// SimulateRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spec pipeline.Spec
+//   - vars pipeline.Vars
+//   - stubs pipeline.SimulationStubs
+func (_e *Runner_Expecter) SimulateRun(ctx interface{}, spec interface{}, vars interface{}, stubs interface{}) *Runner_SimulateRun_Call {
+	return &Runner_SimulateRun_Call{Call: _e.mock.On("SimulateRun", ctx, spec, vars, stubs)}
+}
+
+func (_c *Runner_SimulateRun_Call) Run(run func(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, stubs pipeline.SimulationStubs)) *Runner_SimulateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pipeline.Spec), args[2].(pipeline.Vars), args[3].(pipeline.SimulationStubs))
+	})
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) Return(run *pipeline.Run, trrs pipeline.TaskRunResults, err error) *Runner_SimulateRun_Call {
+	_c.Call.Return(run, trrs, err)
+	return _c
+}
+
+func (_c *Runner_SimulateRun_Call) RunAndReturn(run func(context.Context, pipeline.Spec, pipeline.Vars, pipeline.SimulationStubs) (*pipeline.Run, pipeline.TaskRunResults, error)) *Runner_SimulateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
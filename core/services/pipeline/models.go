@@ -31,6 +31,9 @@ type Spec struct {
 	JobName string `json:"-"`
 	JobType string `json:"-"`
 
+	// Limits are the run limits set by the job, which override the node's JobPipeline.Limits.
+	Limits RunLimits `json:"-" db:"-"`
+
 	Pipeline *Pipeline `json:"-" db:"-"` // This may be nil, or may be populated manually as a cache. There is no locking on this, so be careful
 }
 
@@ -275,6 +278,8 @@ type TaskRun struct {
 	FinishedAt    null.Time                         `json:"finishedAt"`
 	Index         int32                             `json:"index"`
 	DotID         string                            `json:"dotId"`
+	// AttemptErrors is only set for tasks that were retried, with one entry per attempt
+	AttemptErrors RunErrors `json:"attemptErrors"`
 
 	// Used internally for sorting completed results
 	task Task
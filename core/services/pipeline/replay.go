@@ -0,0 +1,241 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+// TaskReplayStatus describes how a task was handled when replaying a run.
+type TaskReplayStatus string
+
+const (
+	// TaskReplayCached tasks are unchanged from the original run, their stored output was reused.
+	TaskReplayCached TaskReplayStatus = "cached"
+	// TaskReplayRecomputed tasks were changed, or depend on a changed task, and were executed again.
+	TaskReplayRecomputed TaskReplayStatus = "recomputed"
+	// TaskReplayAdded tasks did not exist in the original run.
+	TaskReplayAdded TaskReplayStatus = "added"
+	// TaskReplayRemoved tasks existed in the original run, but not in the replayed spec.
+	TaskReplayRemoved TaskReplayStatus = "removed"
+)
+
+// TaskRunDiff compares the result of a task in a replayed run to the original run.
+type TaskRunDiff struct {
+	DotID          string           `json:"dotId"`
+	Status         TaskReplayStatus `json:"status"`
+	OriginalOutput interface{}      `json:"originalOutput"`
+	OriginalError  null.String      `json:"originalError"`
+	ReplayOutput   interface{}      `json:"replayOutput"`
+	ReplayError    null.String      `json:"replayError"`
+	// Changed is true if the output or error of the task differs from the original run.
+	Changed bool `json:"changed"`
+}
+
+// RunReplay is the result of replaying a finished run, compared to the original run.
+type RunReplay struct {
+	Original *Run          `json:"-"`
+	Replay   *Run          `json:"-"`
+	Tasks    []TaskRunDiff `json:"tasks"`
+	// OutputsChanged is true if the final outputs or fatal errors differ from the original run.
+	OutputsChanged bool `json:"outputsChanged"`
+}
+
+// ReplayRun reruns a finished run in-memory with the inputs of the original run, optionally using an edited DAG.
+// An empty dotDagSource replays the original spec.
+//
+// Tasks that are unchanged from the original run, including all of their inputs, are not executed: their stored
+// output is reused. All other tasks are recomputed, except for tasks performing I/O (http, bridge, ethcall,
+// estimategaslimit and ethtx), which cannot reproduce historical responses and fail with ErrMissingSimulationStub.
+// Note that successful task runs are only stored for some job types, see InsertFinishedRun.
+//
+// Nothing is persisted and no metrics are recorded.
+func (r *runner) ReplayRun(ctx context.Context, runID int64, dotDagSource string) (*RunReplay, error) {
+	original, err := r.orm.FindRun(ctx, runID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load run %v", runID)
+	}
+	if original.State == RunStatusRunning || original.State == RunStatusSuspended {
+		return nil, errors.Errorf("cannot replay run %v in state %s", runID, original.State)
+	}
+
+	originalPipeline, err := Parse(original.PipelineSpec.DotDagSource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse original pipeline")
+	}
+
+	spec := original.PipelineSpec
+	spec.Pipeline = nil
+	if dotDagSource != "" {
+		spec.DotDagSource = dotDagSource
+	}
+	p, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	taskRuns := make(map[string]TaskRun, len(original.PipelineTaskRuns))
+	for _, tr := range original.PipelineTaskRuns {
+		taskRuns[tr.DotID] = tr
+	}
+
+	// stubs hold the stored outputs of tasks that can be reused, and must be built in topological order
+	stubs := SimulationStubs{}
+	originalDefs, defs := taskDefinitions(originalPipeline), taskDefinitions(p)
+	for _, task := range p.Tasks {
+		tr, ok := taskRuns[task.DotID()]
+		if !ok || !tr.FinishedAt.Valid || originalDefs[task.DotID()] != defs[task.DotID()] {
+			continue
+		}
+		if !inputsCached(task, stubs) {
+			continue
+		}
+		stub, err := taskRunStub(tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid output of task %q", task.DotID())
+		}
+		stubs[task.DotID()] = stub
+	}
+
+	// stored inputs include the outputs of the tasks, which are recomputed or stubbed
+	inputs := make(map[string]interface{})
+	if m, ok := original.Inputs.Val.(map[string]interface{}); ok {
+		for k, v := range m {
+			if originalPipeline.ByDotID(k) == nil && p.ByDotID(k) == nil {
+				inputs[k] = v
+			}
+		}
+	}
+
+	replay := NewRun(spec, NewVarsFrom(inputs))
+	trrs := r.run(ctx, p, replay, NewVarsFrom(inputs), stubs)
+	if replay.Pending {
+		return nil, fmt.Errorf("unexpected async run for spec ID %v, tried executing via ReplayRun", spec.ID)
+	}
+
+	result := &RunReplay{Original: &original, Replay: replay}
+	for _, trr := range trrs {
+		diff := TaskRunDiff{DotID: trr.Task.DotID(), Status: TaskReplayRecomputed}
+		if _, ok := stubs[diff.DotID]; ok {
+			diff.Status = TaskReplayCached
+		}
+		if trr.Result.Error != nil {
+			diff.ReplayError = null.StringFrom(trr.Result.Error.Error())
+		} else if diff.ReplayOutput, err = normalizeOutput(trr.Result.OutputDB()); err != nil {
+			return nil, errors.Wrapf(err, "invalid output of task %q", diff.DotID)
+		}
+
+		tr, ok := taskRuns[diff.DotID]
+		if !ok {
+			if originalPipeline.ByDotID(diff.DotID) == nil {
+				diff.Status = TaskReplayAdded
+			}
+			diff.Changed = true
+			result.Tasks = append(result.Tasks, diff)
+			continue
+		}
+		delete(taskRuns, diff.DotID)
+		diff.OriginalError = tr.Error
+		if diff.OriginalOutput, err = normalizeOutput(tr.Output); err != nil {
+			return nil, errors.Wrapf(err, "invalid original output of task %q", diff.DotID)
+		}
+		diff.Changed = diff.ReplayError != diff.OriginalError || !reflect.DeepEqual(diff.ReplayOutput, diff.OriginalOutput)
+		result.Tasks = append(result.Tasks, diff)
+	}
+
+	var removed []TaskRunDiff
+	for dotID, tr := range taskRuns {
+		if p.ByDotID(dotID) != nil {
+			continue
+		}
+		diff := TaskRunDiff{DotID: dotID, Status: TaskReplayRemoved, OriginalError: tr.Error, Changed: true}
+		if diff.OriginalOutput, err = normalizeOutput(tr.Output); err != nil {
+			return nil, errors.Wrapf(err, "invalid original output of task %q", dotID)
+		}
+		removed = append(removed, diff)
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i].DotID < removed[j].DotID })
+	result.Tasks = append(result.Tasks, removed...)
+
+	originalOutputs, err := normalizeOutput(original.Outputs)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid original outputs")
+	}
+	replayOutputs, err := normalizeOutput(replay.Outputs)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid outputs")
+	}
+	result.OutputsChanged = !reflect.DeepEqual(originalOutputs, replayOutputs) ||
+		!reflect.DeepEqual(original.FatalErrors, replay.FatalErrors)
+
+	return result, nil
+}
+
+// taskDefinitions returns a canonical definition of each task, made of its attributes and inputs, so that tasks can
+// be compared across pipelines.
+func taskDefinitions(p *Pipeline) map[string]string {
+	defs := make(map[string]string, len(p.Tasks))
+	for nodes := p.tree.Nodes(); nodes.Next(); {
+		node, is := nodes.Node().(*GraphNode)
+		if !is {
+			panic("unreachable")
+		}
+		attrs := make([]string, 0, len(node.attrs))
+		for k, v := range node.attrs {
+			attrs = append(attrs, k+"="+strconv.Quote(v))
+		}
+		sort.Strings(attrs)
+		var inputs []string
+		for _, input := range p.ByDotID(node.dotID).Inputs() {
+			inputs = append(inputs, fmt.Sprintf("%s:%t", input.InputTask.DotID(), input.PropagateResult))
+		}
+		defs[node.dotID] = strings.Join(attrs, " ") + " <- " + strings.Join(inputs, " ")
+	}
+	return defs
+}
+
+func inputsCached(task Task, stubs SimulationStubs) bool {
+	for _, input := range task.Inputs() {
+		if _, ok := stubs[input.InputTask.DotID()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// taskRunStub returns a stub replaying the stored result of a task run.
+func taskRunStub(tr TaskRun) (TaskStub, error) {
+	if tr.Error.Valid {
+		return TaskStub{Error: tr.Error}, nil
+	}
+	value, err := tr.Output.MarshalJSON()
+	if err != nil {
+		return TaskStub{}, err
+	}
+	return TaskStub{Value: value}, nil
+}
+
+// normalizeOutput round trips the output through JSON, so that in-memory results can be compared to stored ones.
+func normalizeOutput(output jsonserializable.JSONSerializable) (interface{}, error) {
+	if !output.Valid {
+		return nil, nil
+	}
+	bs, err := output.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err = json.Unmarshal(bs, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
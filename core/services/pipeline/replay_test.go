@@ -0,0 +1,159 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func Test_PipelineRunner_ReplayRun(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	orm := mocks.NewORM(t)
+	r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	source := `
+ds1          [type=bridge name="example-bridge"];
+ds1_parse    [type=jsonparse path="data,result"];
+ds1_multiply [type=multiply times=100];
+
+ds2          [type=http method=GET url="https://example.com/price"];
+ds2_parse    [type=jsonparse path="price"];
+
+ds3          [type=http method=GET url="https://example.com/other"];
+ds3_parse    [type=jsonparse path="price"];
+
+answer       [type=median];
+
+ds1 -> ds1_parse -> ds1_multiply -> answer;
+ds2 -> ds2_parse -> answer;
+ds3 -> ds3_parse -> answer;
+`
+	original, _, err := r.SimulateRun(testutils.Context(t), pipeline.Spec{DotDagSource: source}, pipeline.NewVarsFrom(nil), pipeline.SimulationStubs{
+		"ds1": {Value: json.RawMessage(`"{\"data\":{\"result\":1.5}}"`)},
+		"ds2": {Value: json.RawMessage(`"{\"price\":160}"`)},
+		"ds3": {Value: json.RawMessage(`"{\"price\":200}"`)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, pipeline.RunStatusCompleted, original.State)
+
+	// round trip the run through JSON, like it would be stored and loaded from the database
+	b, err := json.Marshal(original)
+	require.NoError(t, err)
+	var stored pipeline.Run
+	require.NoError(t, json.Unmarshal(b, &stored))
+	stored.ID = 42
+	stored.State = original.State
+	for i := range stored.PipelineTaskRuns {
+		stored.PipelineTaskRuns[i].FinishedAt = original.PipelineTaskRuns[i].FinishedAt
+	}
+	orm.On("FindRun", mock.Anything, int64(42)).Return(stored, nil)
+
+	statuses := func(replay *pipeline.RunReplay) map[string]pipeline.TaskReplayStatus {
+		m := make(map[string]pipeline.TaskReplayStatus)
+		for _, diff := range replay.Tasks {
+			m[diff.DotID] = diff.Status
+		}
+		return m
+	}
+	diffByID := func(replay *pipeline.RunReplay, dotID string) pipeline.TaskRunDiff {
+		for _, diff := range replay.Tasks {
+			if diff.DotID == dotID {
+				return diff
+			}
+		}
+		t.Fatalf("no diff for task %s", dotID)
+		return pipeline.TaskRunDiff{}
+	}
+
+	t.Run("original spec reuses all outputs", func(t *testing.T) {
+		replay, err := r.ReplayRun(testutils.Context(t), 42, "")
+		require.NoError(t, err)
+		require.Len(t, replay.Tasks, 8)
+		for _, diff := range replay.Tasks {
+			assert.Equal(t, pipeline.TaskReplayCached, diff.Status, diff.DotID)
+			assert.False(t, diff.Changed, diff.DotID)
+		}
+		assert.False(t, replay.OutputsChanged)
+	})
+
+	t.Run("changed aggregation is recomputed", func(t *testing.T) {
+		replay, err := r.ReplayRun(testutils.Context(t), 42, source+`answer [type=mean];`)
+		require.NoError(t, err)
+
+		s := statuses(replay)
+		assert.Equal(t, pipeline.TaskReplayCached, s["ds2"])
+		assert.Equal(t, pipeline.TaskReplayCached, s["ds3_parse"])
+		assert.Equal(t, pipeline.TaskReplayRecomputed, s["answer"])
+
+		answer := diffByID(replay, "answer")
+		assert.True(t, answer.Changed)
+		assert.Equal(t, "160", answer.OriginalOutput)
+		assert.Equal(t, "170", answer.ReplayOutput)
+		assert.True(t, replay.OutputsChanged)
+	})
+
+	t.Run("tasks depending on a changed task are recomputed", func(t *testing.T) {
+		replay, err := r.ReplayRun(testutils.Context(t), 42, source+`ds1_multiply [type=multiply times=50];`)
+		require.NoError(t, err)
+
+		s := statuses(replay)
+		assert.Equal(t, pipeline.TaskReplayCached, s["ds1"])
+		assert.Equal(t, pipeline.TaskReplayCached, s["ds1_parse"])
+		assert.Equal(t, pipeline.TaskReplayRecomputed, s["ds1_multiply"])
+		assert.Equal(t, pipeline.TaskReplayRecomputed, s["answer"])
+		assert.True(t, diffByID(replay, "ds1_multiply").Changed)
+		assert.False(t, diffByID(replay, "answer").Changed)
+		assert.False(t, replay.OutputsChanged)
+	})
+
+	t.Run("changed I/O tasks cannot be recomputed", func(t *testing.T) {
+		replay, err := r.ReplayRun(testutils.Context(t), 42, source+`ds3 [type=http method=GET url="https://example.com/changed"];`)
+		require.NoError(t, err)
+
+		ds3 := diffByID(replay, "ds3")
+		assert.Equal(t, pipeline.TaskReplayRecomputed, ds3.Status)
+		assert.Contains(t, ds3.ReplayError.String, pipeline.ErrMissingSimulationStub.Error())
+		assert.True(t, ds3.Changed)
+	})
+
+	t.Run("added and removed tasks", func(t *testing.T) {
+		replay, err := r.ReplayRun(testutils.Context(t), 42, `
+ds1          [type=bridge name="example-bridge"];
+ds1_parse    [type=jsonparse path="data,result"];
+ds1_multiply [type=multiply times=100];
+
+ds2          [type=http method=GET url="https://example.com/price"];
+ds2_parse    [type=jsonparse path="price"];
+
+answer       [type=median];
+answer_x10   [type=multiply times=10];
+
+ds1 -> ds1_parse -> ds1_multiply -> answer;
+ds2 -> ds2_parse -> answer -> answer_x10;
+`)
+		require.NoError(t, err)
+
+		s := statuses(replay)
+		assert.Equal(t, pipeline.TaskReplayAdded, s["answer_x10"])
+		assert.Equal(t, pipeline.TaskReplayRemoved, s["ds3"])
+		assert.Equal(t, pipeline.TaskReplayRemoved, s["ds3_parse"])
+		assert.Equal(t, pipeline.TaskReplayRecomputed, s["answer"])
+		assert.Equal(t, "155", diffByID(replay, "answer").ReplayOutput)
+		assert.Equal(t, "1550", diffByID(replay, "answer_x10").ReplayOutput)
+		assert.True(t, replay.OutputsChanged)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		_, err := r.ReplayRun(testutils.Context(t), 42, `ds1 [type=nope];`)
+		require.Error(t, err)
+	})
+}
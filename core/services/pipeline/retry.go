@@ -0,0 +1,146 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/pkg/errors"
+)
+
+// ErrorClass classifies a task error, so that retry policies can tell transient failures apart from
+// failures that will not succeed on a retry.
+type ErrorClass string
+
+const (
+	// ErrorClassUnknown is used for errors that the task did not classify, e.g. invalid params or inputs.
+	ErrorClassUnknown ErrorClass = ""
+	ErrorClassHTTP4xx ErrorClass = "http4xx"
+	ErrorClassHTTP429 ErrorClass = "http429"
+	ErrorClassHTTP5xx ErrorClass = "http5xx"
+	ErrorClassTimeout ErrorClass = "timeout"
+	ErrorClassNetwork ErrorClass = "network"
+	ErrorClassRPC     ErrorClass = "rpc"
+
+	// errorClassTransient is shorthand for all error classes that might succeed when retried.
+	errorClassTransient = "transient"
+)
+
+var errorClasses = map[ErrorClass]bool{
+	ErrorClassHTTP4xx: true,
+	ErrorClassHTTP429: true,
+	ErrorClassHTTP5xx: true,
+	ErrorClassTimeout: true,
+	ErrorClassNetwork: true,
+	ErrorClassRPC:     true,
+}
+
+var transientErrorClasses = []ErrorClass{ErrorClassHTTP429, ErrorClassHTTP5xx, ErrorClassTimeout, ErrorClassNetwork, ErrorClassRPC}
+
+// defaultRetryOn is the retry policy of tasks without retryOn: only transient errors are retried, so that 4xx
+// responses and unclassified errors, e.g. invalid params or inputs, fail fast.
+var defaultRetryOn = map[ErrorClass]bool{
+	ErrorClassHTTP429: true,
+	ErrorClassHTTP5xx: true,
+	ErrorClassTimeout: true,
+	ErrorClassNetwork: true,
+	ErrorClassRPC:     true,
+}
+
+// classifyHTTPError classifies the error returned by makeHTTPRequest.
+func classifyHTTPError(ctx context.Context, statusCode int, err error) ErrorClass {
+	switch {
+	case err == nil:
+		return ErrorClassUnknown
+	case statusCode == 429:
+		return ErrorClassHTTP429
+	case statusCode >= 500:
+		return ErrorClassHTTP5xx
+	case statusCode >= 400:
+		return ErrorClassHTTP4xx
+	case ctx.Err() != nil:
+		return ErrorClassTimeout
+	default:
+		return ErrorClassNetwork
+	}
+}
+
+func httpRunInfo(ctx context.Context, statusCode int, err error) RunInfo {
+	return RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err), ErrorClass: classifyHTTPError(ctx, statusCode, err)}
+}
+
+// classifyRPCError classifies the error returned by an RPC call.
+func classifyRPCError(ctx context.Context, err error) ErrorClass {
+	switch {
+	case err == nil:
+		return ErrorClassUnknown
+	case ctx.Err() != nil || errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	default:
+		return ErrorClassRPC
+	}
+}
+
+// parseRetryOn parses a comma separated list of error classes.
+func parseRetryOn(s string) (map[ErrorClass]bool, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	classes := make(map[ErrorClass]bool)
+	for _, c := range strings.Split(s, ",") {
+		c = strings.ToLower(strings.TrimSpace(c))
+		if c == errorClassTransient {
+			for _, tc := range transientErrorClasses {
+				classes[tc] = true
+			}
+			continue
+		}
+		if !errorClasses[ErrorClass(c)] {
+			return nil, errors.Errorf("unknown error class %q in retryOn, expected one of: transient, http4xx, http429, http5xx, timeout, network, rpc", c)
+		}
+		classes[ErrorClass(c)] = true
+	}
+	return classes, nil
+}
+
+// retryBackoff returns how long to wait before the next attempt of a failed task run, or false if the task should
+// not be retried:
+//   - the task has no attempts left
+//   - the task's retryOn policy, or defaultRetryOn if it has none, does not include the class of the error
+//   - the next attempt could not finish (taking the task timeout into account) before the task's retryDeadline,
+//     counted from the start of the first attempt
+func retryBackoff(result TaskRunResult, firstAttemptAt time.Time, now time.Time) (time.Duration, bool) {
+	if result.Result.Error == nil || result.Attempts >= uint(result.Task.TaskRetries()) {
+		return 0, false
+	}
+
+	base := result.Task.Base()
+	retryOn := base.retryOn
+	if retryOn == nil {
+		retryOn = defaultRetryOn
+	}
+	if !retryOn[result.runInfo.ErrorClass] {
+		return 0, false
+	}
+
+	b := backoff.Backoff{
+		Factor: 2,
+		Jitter: base.BackoffJitter,
+		Min:    result.Task.TaskMinBackoff(),
+		Max:    result.Task.TaskMaxBackoff(),
+	}
+	delay := b.ForAttempt(float64(result.Attempts - 1)) // we subtract 1 because backoff 0-indexes
+
+	if base.RetryDeadline > 0 {
+		finishBy := now.Add(delay)
+		if timeout, isSet := result.Task.TaskTimeout(); isSet {
+			finishBy = finishBy.Add(timeout)
+		}
+		if finishBy.After(firstAttemptAt.Add(base.RetryDeadline)) {
+			return 0, false
+		}
+	}
+
+	return delay, true
+}
@@ -40,6 +40,12 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars) (run *Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, but tasks performing I/O return the given stubs
+	// instead. Nothing is persisted and no metrics are recorded.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, stubs SimulationStubs) (run *Run, trrs TaskRunResults, err error)
+	// ReplayRun reruns a finished run in-memory with its original inputs, reusing the stored outputs of unchanged
+	// tasks, and compares the results to the original run. An empty dotDagSource replays the original spec.
+	ReplayRun(ctx context.Context, runID int64, dotDagSource string) (*RunReplay, error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error
@@ -68,6 +74,9 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	exporter               *RunExporter
+	limiter                *RunLimiter
+	wasmModules            *wasmModuleCache
 
 	// test helper
 	runFinished func(*Run)
@@ -133,6 +142,7 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		wasmModules:            newWASMModuleCache(),
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -166,6 +176,7 @@ func (r *runner) Close() error {
 	return r.StopOnce("PipelineRunner", func() error {
 		close(r.chStop)
 		r.wgDone.Wait()
+		r.wasmModules.Close()
 
 		// the btORM can be a cache service or a static ORM if the constructor changes
 		if closer, isCloser := r.btORM.(io.Closer); isCloser {
@@ -253,6 +264,25 @@ func (r *runner) OnRunFinished(fn func(*Run)) {
 	r.runFinished = fn
 }
 
+// SetExporter streams the runs finished by this runner to exporter, once they are saved.
+func (r *runner) SetExporter(exporter *RunExporter) {
+	r.exporter = exporter
+}
+
+// SetLimiter enforces per-job limits on the runs started by this runner. Resumed runs are not limited.
+func (r *runner) SetLimiter(limiter *RunLimiter) {
+	r.limiter = limiter
+}
+
+func (r *runner) export(runs ...*Run) {
+	if r.exporter == nil {
+		return
+	}
+	for _, run := range runs {
+		r.exporter.Export(run)
+	}
+}
+
 var (
 	// github.com/smartcontractkit/libocr/offchainreporting2plus/internal/protocol.ReportingPluginTimeoutWarningGracePeriod
 	overtime           = 100 * time.Millisecond
@@ -312,8 +342,13 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 		}
 	}
 
+	release, err := r.limiter.acquire(ctx, spec)
+	if err != nil {
+		return nil, nil, err
+	}
 	run := NewRun(spec, vars)
-	taskRunResults := r.run(ctx, pipeline, run, vars)
+	taskRunResults := r.run(ctx, pipeline, run, vars, nil)
+	release(taskRunResults)
 
 	if run.Pending {
 		return run, nil, fmt.Errorf("unexpected async run for spec ID %v, tried executing via ExecuteRun", spec.ID)
@@ -368,6 +403,8 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeWASM:
+			task.(*WASMTask).modules = r.wasmModules
 		default:
 		}
 	}
@@ -375,7 +412,10 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	return pipeline, nil
 }
 
-func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
+// run executes the pipeline. If stubs is non-nil, the run is a simulation: stubbed tasks are not executed and no
+// metrics are recorded.
+func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, stubs SimulationStubs) TaskRunResults {
+	simulated := stubs != nil
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
@@ -396,9 +436,11 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		taskRun := taskRun
 		// execute
 		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, stubs, l)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+			if !simulated {
+				logTaskRunToPrometheus(result, run.PipelineSpec)
+			}
 
 			scheduler.report(reportCtx, result)
 		}, func(err interface{}) {
@@ -425,13 +467,19 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime = run.FinishedAt.Time.Sub(run.CreatedAt)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		if !simulated {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
 		output := result.Result.OutputDB()
+		var attemptErrors RunErrors
+		if len(result.AttemptErrors) > 1 {
+			attemptErrors = result.AttemptErrors
+		}
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 			ID:            result.ID,
 			PipelineRunID: run.ID,
@@ -442,6 +490,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			AttemptErrors: attemptErrors,
 			task:          result.Task,
 		})
 
@@ -475,7 +524,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			if !simulated {
+				PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			}
 		} else {
 			run.State = RunStatusCompleted
 		}
@@ -525,12 +576,25 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
-func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
+func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, stubs SimulationStubs, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
 		"taskType", taskRun.task.Type(),
 		"attempt", taskRun.attempts)
 
+	if stubs != nil {
+		if result, ok := stubs.result(taskRun.task); ok {
+			l.Debugw("Returning stubbed result for simulated pipeline task", "resultValue", result.Value, "resultError", result.Error)
+			return TaskRunResult{
+				ID:         taskRun.task.Base().uuid,
+				Task:       taskRun.task,
+				Result:     result,
+				CreatedAt:  start,
+				FinishedAt: null.TimeFrom(time.Now()),
+			}
+		}
+	}
+
 	// Task timeout will be whichever of the following timesout/cancels first:
 	// - Pipeline-level timeout
 	// - Specific task timeout (task.TaskTimeout)
@@ -622,6 +686,7 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 	if err != nil {
 		return 0, trrs, pkgerrors.Wrapf(err, "error inserting finished results for spec ID %v", run.PipelineSpecID)
 	}
+	r.export(run)
 	return run.ID, trrs, nil
 }
 
@@ -640,6 +705,16 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 		task.Base().uuid = taskRun.ID
 	}
 
+	// resumed runs were already admitted when they started
+	var trrs TaskRunResults
+	if run.ID == 0 {
+		var release func(TaskRunResults)
+		if release, err = r.limiter.acquire(ctx, run.PipelineSpec); err != nil {
+			return false, err
+		}
+		defer func() { release(trrs) }()
+	}
+
 	preinsert := pipeline.RequiresPreInsert()
 
 	err = r.orm.Transact(ctx, func(tx ORM) error {
@@ -676,7 +751,7 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 	}
 
 	for {
-		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})))
+		trrs = r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), nil)
 
 		if preinsert {
 			// FailSilently = run failed and task was marked failEarly. skip StoreRun and instead delete all trace of it
@@ -712,6 +787,9 @@ func (r *runner) Run(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool,
 			}
 		}
 
+		if !run.Pending {
+			r.export(run)
+		}
 		r.runFinished(run)
 
 		return run.Pending, err
@@ -748,7 +826,11 @@ func (r *runner) InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, r
 	if ds != nil {
 		orm = orm.WithDataSource(ds)
 	}
-	return orm.InsertFinishedRun(ctx, run, saveSuccessfulTaskRuns)
+	if err := orm.InsertFinishedRun(ctx, run, saveSuccessfulTaskRuns); err != nil {
+		return err
+	}
+	r.export(run)
+	return nil
 }
 
 func (r *runner) InsertFinishedRuns(ctx context.Context, ds sqlutil.DataSource, runs []*Run, saveSuccessfulTaskRuns bool) error {
@@ -756,7 +838,11 @@ func (r *runner) InsertFinishedRuns(ctx context.Context, ds sqlutil.DataSource,
 	if ds != nil {
 		orm = orm.WithDataSource(ds)
 	}
-	return orm.InsertFinishedRuns(ctx, runs, saveSuccessfulTaskRuns)
+	if err := orm.InsertFinishedRuns(ctx, runs, saveSuccessfulTaskRuns); err != nil {
+		return err
+	}
+	r.export(runs...)
+	return nil
 }
 
 func (r *runner) runReaper() {
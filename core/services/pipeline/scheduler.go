@@ -5,7 +5,6 @@ import (
 	"sort"
 	"time"
 
-	"github.com/jpillora/backoff"
 	"github.com/pkg/errors"
 	"gopkg.in/guregu/null.v4"
 
@@ -57,6 +56,9 @@ type scheduler struct {
 	vars         Vars
 	logger       logger.Logger
 
+	// start of the first attempt of each task, for retry deadlines
+	firstAttemptAt map[int]time.Time
+
 	pending bool
 	exiting bool
 
@@ -80,6 +82,8 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 		vars:         vars,
 		logger:       lggr,
 
+		firstAttemptAt: make(map[int]time.Time, len(p.Tasks)),
+
 		// taskCh should never block
 		taskCh:   make(chan *memoryTaskRun, len(dependencies)),
 		resultCh: make(chan TaskRunResult),
@@ -135,10 +139,11 @@ func (s *scheduler) reconstructResults() {
 		}
 
 		s.results[task.ID()] = TaskRunResult{
-			Task:       task,
-			Result:     result,
-			CreatedAt:  r.CreatedAt,
-			FinishedAt: r.FinishedAt,
+			Task:          task,
+			Result:        result,
+			AttemptErrors: r.AttemptErrors,
+			CreatedAt:     r.CreatedAt,
+			FinishedAt:    r.FinishedAt,
 		}
 
 		// store the result in vars
@@ -174,12 +179,17 @@ func (s *scheduler) Run() {
 
 		s.waiting--
 
-		// retrieve previous attempt count
+		// retrieve previous attempt count and errors
 		result.Attempts = s.results[result.Task.ID()].Attempts
+		result.AttemptErrors = s.results[result.Task.ID()].AttemptErrors
 
 		// only count as an attempt if the job actually ran. If we're exiting then it got cancelled
 		if !s.exiting {
 			result.Attempts++
+			result.AttemptErrors = append(result.AttemptErrors, result.Result.ErrorDB())
+			if result.Attempts == 1 {
+				s.firstAttemptAt[result.Task.ID()] = result.CreatedAt
+			}
 		}
 
 		// store task run
@@ -219,18 +229,12 @@ func (s *scheduler) Run() {
 			continue
 		}
 
-		// if task hasn't reached it's max retry count yet, we schedule it again
-		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil {
+		// if task hasn't reached it's max retry count yet and its retry policy allows it, we schedule it again
+		if delay, retry := retryBackoff(result, s.firstAttemptAt[result.Task.ID()], time.Now()); retry {
 			// we immediately increase the in-flight counter so the pipeline doesn't terminate
 			// while we wait for the next retry
 			s.waiting++
 
-			backoff := backoff.Backoff{
-				Factor: 2,
-				Min:    result.Task.TaskMinBackoff(),
-				Max:    result.Task.TaskMaxBackoff(),
-			}
-
 			go func(vars Vars) {
 				select {
 				case <-ctx.Done():
@@ -242,7 +246,7 @@ func (s *scheduler) Run() {
 						CreatedAt:  now, // TODO: more accurate start time
 						FinishedAt: null.TimeFrom(now),
 					})
-				case <-time.After(backoff.ForAttempt(float64(result.Attempts - 1))): // we subtract 1 because backoff 0-indexes
+				case <-time.After(delay):
 					// schedule a new attempt
 					run := s.newMemoryTaskRun(result.Task, vars)
 					run.attempts = result.Attempts
@@ -15,6 +15,7 @@ import (
 type event struct {
 	expected string
 	result   Result
+	runInfo  RunInfo
 }
 
 func TestScheduler(t *testing.T) {
@@ -55,14 +56,17 @@ func TestScheduler(t *testing.T) {
 				{
 					expected: "a",
 					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{ErrorClass: ErrorClassHTTP5xx},
 				},
 				{
 					expected: "a",
 					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{ErrorClass: ErrorClassHTTP5xx},
 				},
 				{
 					expected: "a",
 					result:   Result{Error: ErrTimeout},
+					runInfo:  RunInfo{ErrorClass: ErrorClassTimeout},
 				},
 				{
 					expected: "b",
@@ -86,6 +90,7 @@ func TestScheduler(t *testing.T) {
 				{
 					expected: "a",
 					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{ErrorClass: ErrorClassHTTP5xx},
 				},
 				{
 					expected: "a",
@@ -114,6 +119,7 @@ func TestScheduler(t *testing.T) {
 				{
 					expected: "a",
 					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{ErrorClass: ErrorClassNetwork},
 				},
 				{
 					expected: "b",
@@ -128,6 +134,115 @@ func TestScheduler(t *testing.T) {
 				require.Equal(t, ErrCancelled, result.Result.Error)
 			},
 		},
+		{
+			name: "retry: fail fast on 4xx and unclassified errors without retryOn",
+			spec: `
+			a [type=median retries=3 minBackoff="1us" maxBackoff="1us" index=0]
+			b [type=median retries=3 minBackoff="1us" maxBackoff="1us" index=1]
+			`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{ErrorClass: ErrorClassHTTP4xx},
+				},
+				{
+					expected: "b",
+					result:   Result{Error: ErrTaskRunFailed},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				for _, dotID := range []string{"a", "b"} {
+					result := results[p.ByDotID(dotID).ID()]
+					require.Equal(t, uint(1), result.Attempts, dotID)
+					require.Equal(t, ErrTaskRunFailed, result.Result.Error, dotID)
+				}
+			},
+		},
+		{
+			name: "retryOn: fail fast on errors not in the retry policy",
+			spec: `
+			a [type=median retries=3 minBackoff="1us" maxBackoff="1us" retryOn="http5xx,timeout"]
+			b [type=median index=0]
+			a -> b`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{ErrorClass: ErrorClassHTTP4xx},
+				},
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				require.Equal(t, uint(1), result.Attempts)
+				require.Equal(t, ErrTaskRunFailed, result.Result.Error)
+				require.Equal(t, RunErrors{null.StringFrom(ErrTaskRunFailed.Error())}, result.AttemptErrors)
+			},
+		},
+		{
+			name: "retryOn: retry errors in the retry policy and keep each attempt's error",
+			spec: `
+			a [type=median retries=3 minBackoff="1us" maxBackoff="1us" retryOn="transient" backoffJitter=true]
+			b [type=median index=0]
+			a -> b`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{ErrorClass: ErrorClassHTTP5xx},
+				},
+				{
+					expected: "a",
+					result:   Result{Error: ErrTimeout},
+					runInfo:  RunInfo{ErrorClass: ErrorClassTimeout},
+				},
+				{
+					expected: "a",
+					result:   Result{Value: 1},
+				},
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				require.Equal(t, uint(3), result.Attempts)
+				require.NoError(t, result.Result.Error)
+				require.Equal(t, RunErrors{
+					null.StringFrom(ErrTaskRunFailed.Error()),
+					null.StringFrom(ErrTimeout.Error()),
+					{},
+				}, result.AttemptErrors)
+			},
+		},
+		{
+			name: "retryDeadline: stop retrying when the next attempt could not finish in time",
+			spec: `
+			a [type=median retries=3 minBackoff="1us" maxBackoff="1us" timeout="1h" retryDeadline="1m"]
+			b [type=median index=0]
+			a -> b`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+					runInfo:  RunInfo{ErrorClass: ErrorClassHTTP5xx},
+				},
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				require.Equal(t, uint(1), result.Attempts)
+				require.Equal(t, ErrTaskRunFailed, result.Result.Error)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -150,6 +265,7 @@ func TestScheduler(t *testing.T) {
 					Result:     event.result,
 					FinishedAt: null.TimeFrom(now),
 					CreatedAt:  now,
+					runInfo:    event.runInfo,
 				})
 			case <-time.After(time.Second):
 				t.Fatal("timed out waiting for task run")
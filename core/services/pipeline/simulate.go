@@ -0,0 +1,106 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+)
+
+// ErrMissingSimulationStub is returned by a simulated run for tasks that would perform I/O but have no stubbed response.
+var ErrMissingSimulationStub = errors.New("no stubbed response for task performing I/O in simulated run")
+
+// TaskStub is a recorded response returned in place of executing a task during a simulated run.
+// Exactly one of Value or Error must be set.
+type TaskStub struct {
+	Value json.RawMessage `json:"value"`
+	Error null.String     `json:"error"`
+}
+
+// ToResult converts the stub to the Result the task would have produced.
+// JSON values are decoded like stored task run outputs, so a stubbed JSON string is passed on as a plain string, like
+// an http or bridge response body.
+func (s TaskStub) ToResult() (Result, error) {
+	if s.Error.Valid && s.Value == nil {
+		return Result{Error: errors.New(s.Error.ValueOrZero())}, nil
+	}
+	if !s.Error.Valid && s.Value != nil {
+		var val jsonserializable.JSONSerializable
+		if err := json.Unmarshal(s.Value, &val); err != nil {
+			return Result{}, errors.Wrap(err, "failed to unmarshal stub value")
+		}
+		return Result{Value: val.Val}, nil
+	}
+	return Result{}, errors.New("must provide only one of either 'value' or 'error' key")
+}
+
+// SimulationStubs maps task dot IDs to the response that should be returned in place of running the task.
+type SimulationStubs map[string]TaskStub
+
+// Validate checks that every stub refers to a task in the pipeline and is well-formed.
+func (s SimulationStubs) Validate(p *Pipeline) error {
+	for dotID, stub := range s {
+		if p.ByDotID(dotID) == nil {
+			return errors.Errorf("stub for unknown task %q", dotID)
+		}
+		if _, err := stub.ToResult(); err != nil {
+			return errors.Wrapf(err, "invalid stub for task %q", dotID)
+		}
+	}
+	return nil
+}
+
+// result returns the stubbed result for the task, if any. Tasks that perform I/O are never executed in a simulated
+// run, so they fail with ErrMissingSimulationStub when no stub was recorded for them.
+func (s SimulationStubs) result(task Task) (Result, bool) {
+	if stub, ok := s[task.DotID()]; ok {
+		result, err := stub.ToResult()
+		if err != nil {
+			return Result{Error: err}, true
+		}
+		return result, true
+	}
+	if isSimulationSandboxed(task.Type()) {
+		return Result{Error: errors.Wrapf(ErrMissingSimulationStub, "task %q of type %s", task.DotID(), task.Type())}, true
+	}
+	return Result{}, false
+}
+
+func isSimulationSandboxed(taskType TaskType) bool {
+	switch taskType {
+	case TaskTypeHTTP, TaskTypeBridge, TaskTypeETHCall, TaskTypeETHTx, TaskTypeEstimateGasLimit:
+		return true
+	default:
+		return false
+	}
+}
+
+// SimulateRun executes a new run in-memory according to a spec, returning stubbed responses for tasks that
+// perform I/O (http, bridge, ethcall, estimategaslimit and ethtx). Nothing is persisted to the database.
+func (r *runner) SimulateRun(ctx context.Context, spec Spec, vars Vars, stubs SimulationStubs) (*Run, TaskRunResults, error) {
+	// always parse a fresh copy, a cached pipeline may be shared with live runs
+	spec.Pipeline = nil
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = stubs.Validate(pipeline); err != nil {
+		return nil, nil, err
+	}
+	if stubs == nil {
+		stubs = SimulationStubs{}
+	}
+
+	run := NewRun(spec, vars)
+	taskRunResults := r.run(ctx, pipeline, run, vars, stubs)
+
+	if run.Pending {
+		return run, nil, fmt.Errorf("unexpected async run for spec ID %v, tried executing via SimulateRun", spec.ID)
+	}
+
+	return run, taskRunResults, nil
+}
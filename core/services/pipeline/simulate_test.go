@@ -0,0 +1,91 @@
+package pipeline_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	orm := mocks.NewORM(t)
+	r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	spec := pipeline.Spec{DotDagSource: `
+ds1          [type=bridge name="example-bridge"];
+ds1_parse    [type=jsonparse path="data,result"];
+ds1_multiply [type=multiply times=100];
+
+ds2          [type=http method=GET url="https://example.com/price"];
+ds2_parse    [type=jsonparse path="price"];
+
+answer       [type=median];
+
+ds1 -> ds1_parse -> ds1_multiply -> answer;
+ds2 -> ds2_parse -> answer;
+`}
+
+	t.Run("returns stubbed responses for I/O tasks", func(t *testing.T) {
+		stubs := pipeline.SimulationStubs{
+			"ds1": {Value: json.RawMessage(`"{\"data\":{\"result\":1.5}}"`)},
+			"ds2": {Value: json.RawMessage(`"{\"price\":160}"`)},
+		}
+
+		run, trrs, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), stubs)
+		require.NoError(t, err)
+		require.Len(t, trrs, 6)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		assert.False(t, run.HasErrors())
+
+		final := trrs.FinalResult()
+		require.Len(t, final.Values, 1)
+		assert.Equal(t, "155", final.Values[0].(decimal.Decimal).String())
+	})
+
+	t.Run("fails I/O tasks without a stub", func(t *testing.T) {
+		stubs := pipeline.SimulationStubs{
+			"ds1": {Error: null.StringFrom("bridge unavailable")},
+		}
+
+		run, trrs, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), stubs)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "ds1":
+				require.EqualError(t, trr.Result.Error, "bridge unavailable")
+			case "ds2":
+				require.ErrorIs(t, trr.Result.Error, pipeline.ErrMissingSimulationStub)
+			}
+		}
+	})
+
+	t.Run("rejects stubs for unknown tasks", func(t *testing.T) {
+		stubs := pipeline.SimulationStubs{
+			"ds3": {Value: json.RawMessage(`1`)},
+		}
+
+		_, _, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), stubs)
+		require.EqualError(t, err, `stub for unknown task "ds3"`)
+	})
+
+	t.Run("rejects stubs with both value and error", func(t *testing.T) {
+		stubs := pipeline.SimulationStubs{
+			"ds1": {Value: json.RawMessage(`1`), Error: null.StringFrom("boom")},
+		}
+
+		_, _, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), stubs)
+		require.Error(t, err)
+	})
+}
@@ -21,10 +21,16 @@ type BaseTask struct {
 	Retries    null.Uint32   `mapstructure:"retries"`
 	MinBackoff time.Duration `mapstructure:"minBackoff"`
 	MaxBackoff time.Duration `mapstructure:"maxBackoff"`
+	// RetryOn is a comma separated list of error classes that are retried, e.g. "http5xx,http429,timeout".
+	// If empty, any error is retried.
+	RetryOn       string        `mapstructure:"retryOn"`
+	BackoffJitter bool          `mapstructure:"backoffJitter"`
+	RetryDeadline time.Duration `mapstructure:"retryDeadline"`
 
 	Tags string `mapstructure:"tags" json:"-"`
 
-	uuid uuid.UUID
+	uuid    uuid.UUID
+	retryOn map[ErrorClass]bool
 }
 
 func NewBaseTask(id int, dotID string, inputs []TaskDependency, outputs []Task, index int32) BaseTask {
@@ -157,7 +157,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 			}
 		}
 
-		return Result{Error: err}, retryableRunInfo()
+		return Result{Error: err}, RunInfo{IsRetryable: true, ErrorClass: classifyRPCError(ctx, err)}
 	}
 
 	promETHCallTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
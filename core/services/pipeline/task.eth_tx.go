@@ -17,6 +17,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -39,6 +40,9 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// Priority is the priority class of the transaction: low, normal, high or admin.
+	// It defaults to low for keeper jobs and to normal otherwise.
+	Priority string `json:"priority"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
@@ -95,6 +99,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		priority              StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -105,6 +110,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&priority, From(NonemptyString(t.Priority), defaultTxPriority(t.jobType).String())), "priority"),
 	)
 	if err != nil {
 		return Result{Error: err}, RunInfo{}
@@ -123,6 +129,11 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		return Result{Error: err}, RunInfo{}
 	}
 
+	txPriority, err := txmgrtypes.ParseTxPriority(string(priority))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "priority")}, RunInfo{}
+	}
+
 	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
@@ -152,6 +163,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		Strategy:         strategy,
 		Checker:          transmitChecker,
 		SignalCallback:   true,
+		Priority:         txPriority,
 	}
 
 	if !isMinConfirmationSet {
@@ -175,6 +187,15 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 	return Result{}, RunInfo{}
 }
 
+// defaultTxPriority returns the priority of transactions created by jobs of jobType which do not set one explicitly.
+// Keeper performs are queued behind the transactions of other jobs sending from the same keys.
+func defaultTxPriority(jobType string) txmgrtypes.TxPriority {
+	if jobType == KeeperJobType {
+		return txmgrtypes.TxPriorityLow
+	}
+	return txmgrtypes.TxPriorityNormal
+}
+
 func decodeMeta(metaMap MapParam) (*txmgr.TxMeta, error) {
 	var txMeta txmgr.TxMeta
 	metaDecoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
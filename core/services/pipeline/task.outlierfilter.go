@@ -0,0 +1,165 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	OutlierMethodMAD = "mad"
+	OutlierMethodIQR = "iqr"
+)
+
+var (
+	defaultMADThreshold = decimal.NewFromInt(3)
+	defaultIQRThreshold = decimal.RequireFromString("1.5")
+)
+
+// OutlierFilterTask drops outliers from its values, so that they can be aggregated by another task, e.g.
+// `median values="$(filter.values)"`. With method "mad" (the default), values further than Threshold times the
+// median absolute deviation from the median are dropped (default threshold 3). With method "iqr", values more than
+// Threshold times the interquartile range below the first or above the third quartile are dropped (default
+// threshold 1.5).
+//
+// Faulty values count towards AllowedFaults, dropped outliers do not.
+//
+// Return types:
+//
+//	map[string]interface{}{
+//	    "values": []interface{} // the remaining values as decimal.Decimal, in their original order
+//	    "dropped": []interface{} // the indexes of the dropped values in the task's values, as int
+//	}
+type OutlierFilterTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	AllowedFaults string `json:"allowedFaults"`
+	Method        string `json:"method"`
+	Threshold     string `json:"threshold"`
+}
+
+var _ Task = (*OutlierFilterTask)(nil)
+
+func (t *OutlierFilterTask) Type() TaskType {
+	return TaskTypeOutlierFilter
+}
+
+func (t *OutlierFilterTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		method             StringParam
+		valuesAndErrs      SliceParam
+		allowedFaults      int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), OutlierMethodMAD)), "method"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var threshold DecimalParam
+	method = StringParam(strings.ToLower(string(method)))
+	switch method {
+	case OutlierMethodMAD:
+		err = ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), defaultMADThreshold))
+	case OutlierMethodIQR:
+		err = ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), defaultIQRThreshold))
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "method: expected %q or %q, got %q", OutlierMethodMAD, OutlierMethodIQR, method)}, runInfo
+	}
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "threshold")}, runInfo
+	} else if threshold.Decimal().IsNegative() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "threshold: must not be negative, got %v", threshold.Decimal())}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	var (
+		decimalValues []decimal.Decimal
+		indexes       []int
+		faults        int
+	)
+	for i, val := range valuesAndErrs {
+		if _, is := val.(error); is {
+			faults++
+			continue
+		}
+		var d DecimalParam
+		if err = d.UnmarshalPipelineParam(val); err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
+		}
+		decimalValues = append(decimalValues, d.Decimal())
+		indexes = append(indexes, i)
+	}
+
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to outlier filter task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(decimalValues) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "values")}, runInfo
+	}
+
+	var low, high decimal.Decimal
+	sorted := sortedDecimals(decimalValues)
+	if method == OutlierMethodIQR {
+		q1, q3 := quantile(sorted, decimal.RequireFromString("0.25")), quantile(sorted, decimal.RequireFromString("0.75"))
+		margin := q3.Sub(q1).Mul(threshold.Decimal())
+		low, high = q1.Sub(margin), q3.Add(margin)
+	} else {
+		median := quantile(sorted, decimal.RequireFromString("0.5"))
+		deviations := make([]decimal.Decimal, len(sorted))
+		for i, val := range sorted {
+			deviations[i] = val.Sub(median).Abs()
+		}
+		margin := quantile(sortedDecimals(deviations), decimal.RequireFromString("0.5")).Mul(threshold.Decimal())
+		low, high = median.Sub(margin), median.Add(margin)
+	}
+
+	kept := []interface{}{}
+	dropped := []interface{}{}
+	for i, val := range decimalValues {
+		if val.LessThan(low) || val.GreaterThan(high) {
+			dropped = append(dropped, indexes[i])
+		} else {
+			kept = append(kept, val)
+		}
+	}
+
+	return Result{Value: map[string]interface{}{
+		"values":  kept,
+		"dropped": dropped,
+	}}, runInfo
+}
+
+func sortedDecimals(ds []decimal.Decimal) []decimal.Decimal {
+	sorted := make([]decimal.Decimal, len(ds))
+	copy(sorted, ds)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	return sorted
+}
+
+// quantile returns the p-quantile of sorted values, interpolating linearly between the closest ranks.
+func quantile(sorted []decimal.Decimal, p decimal.Decimal) decimal.Decimal {
+	h := p.Mul(decimal.NewFromInt(int64(len(sorted) - 1)))
+	i := h.IntPart()
+	if int(i) >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	frac := h.Sub(decimal.NewFromInt(i))
+	return sorted[i].Add(sorted[i+1].Sub(sorted[i]).Mul(frac))
+}
@@ -0,0 +1,161 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestOutlierFilterTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		values        []interface{}
+		method        string
+		threshold     string
+		allowedFaults string
+		wantValues    []string
+		wantDropped   []interface{}
+		wantErr       error
+	}{
+		{
+			"mad drops a single bad source of 7",
+			[]interface{}{100, 101, 99, 100, 102, 98, 500},
+			"", "", "",
+			[]string{"100", "101", "99", "100", "102", "98"},
+			[]interface{}{6},
+			nil,
+		},
+		{
+			"mad with threshold keeps values within range",
+			[]interface{}{100, 101, 99, 100, 102, 98, 110},
+			"mad", "10", "",
+			[]string{"100", "101", "99", "100", "102", "98", "110"},
+			[]interface{}{},
+			nil,
+		},
+		{
+			"mad with agreeing majority drops all other values",
+			[]interface{}{5, 5, 5, 4, 6},
+			"mad", "", "",
+			[]string{"5", "5", "5"},
+			[]interface{}{3, 4},
+			nil,
+		},
+		{
+			"iqr drops low and high outliers",
+			[]interface{}{1, 10, 11, 12, 13, 14, 50},
+			"IQR", "", "",
+			[]string{"10", "11", "12", "13", "14"},
+			[]interface{}{0, 6},
+			nil,
+		},
+		{
+			"dropped indexes include faulty values",
+			[]interface{}{errors.New("source failed"), 10, 11, 12, 13, 14, 50},
+			"iqr", "", "1",
+			[]string{"10", "11", "12", "13", "14"},
+			[]interface{}{6},
+			nil,
+		},
+		{
+			"more errors than threshold",
+			[]interface{}{errors.New(""), errors.New(""), 10, 11},
+			"mad", "", "1",
+			nil, nil,
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"zero inputs",
+			[]interface{}{},
+			"mad", "", "0",
+			nil, nil,
+			pipeline.ErrWrongInputCardinality,
+		},
+		{
+			"unknown method",
+			[]interface{}{1, 2, 3},
+			"zscore", "", "",
+			nil, nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"negative threshold",
+			[]interface{}{1, 2, 3},
+			"mad", "-1", "",
+			nil, nil,
+			pipeline.ErrBadInput,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var inputs []pipeline.Result
+			for _, v := range test.values {
+				if err, is := v.(error); is {
+					inputs = append(inputs, pipeline.Result{Error: err})
+				} else {
+					inputs = append(inputs, pipeline.Result{Value: v})
+				}
+			}
+
+			task := pipeline.OutlierFilterTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Method:        test.method,
+				Threshold:     test.threshold,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+
+			result := output.Value.(map[string]interface{})
+			var values []string
+			for _, v := range result["values"].([]interface{}) {
+				values = append(values, v.(decimal.Decimal).String())
+			}
+			assert.Equal(t, test.wantValues, values)
+			assert.Equal(t, test.wantDropped, result["dropped"])
+		})
+	}
+}
+
+func TestOutlierFilterTask_Pipeline(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+filter [type=outlierfilter method=mad];
+answer [type=median values="$(filter.values)"];
+filter -> answer;
+`)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 2)
+	require.IsType(t, &pipeline.OutlierFilterTask{}, p.ByDotID("filter"))
+
+	task := p.ByDotID("filter").(*pipeline.OutlierFilterTask)
+	output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "10"}, {Value: "11"}, {Value: "12"}, {Value: "1000"}})
+	require.NoError(t, output.Error)
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"filter": output.Value})
+	median := p.ByDotID("answer").(*pipeline.MedianTask)
+	output, _ = median.Run(testutils.Context(t), logger.TestLogger(t), vars, []pipeline.Result{output})
+	require.NoError(t, output.Error)
+	require.Equal(t, "11", output.Value.(decimal.Decimal).String())
+}
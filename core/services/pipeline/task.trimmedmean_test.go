@@ -0,0 +1,74 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestTrimmedMeanTask(t *testing.T) {
+	t.Parallel()
+
+	sources := func(vals ...string) []pipeline.Result {
+		var results []pipeline.Result
+		for _, v := range vals {
+			if v == "" {
+				results = append(results, pipeline.Result{Error: errors.New("source failed")})
+			} else {
+				results = append(results, pipeline.Result{Value: mustDecimal(t, v)})
+			}
+		}
+		return results
+	}
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		trim          string
+		allowedFaults string
+		precision     string
+		want          pipeline.Result
+	}{
+		{"default trim keeps all of fewer than 10 values", sources("1", "2", "3", "10"), "", "", "", pipeline.Result{Value: mustDecimal(t, "4")}},
+		{"default trim drops one value at each end of 10 values", sources("100", "2", "2", "2", "2", "2", "2", "2", "2", "-100"), "", "", "", pipeline.Result{Value: mustDecimal(t, "2")}},
+		{"trim resists a single bad source of 7", sources("10", "11", "12", "13", "14", "15", "1000"), "0.15", "", "", pipeline.Result{Value: mustDecimal(t, "13")}},
+		{"zero trim is the mean", sources("1", "2", "3", "10"), "0", "", "", pipeline.Result{Value: mustDecimal(t, "4")}},
+		{"precision", sources("1", "1", "2"), "0", "", "2", pipeline.Result{Value: mustDecimal(t, "1.33")}},
+		{"faulty values are excluded before trimming", sources("", "1", "2", "3", "100"), "0.25", "1", "", pipeline.Result{Value: mustDecimal(t, "2.5")}},
+		{"more errors than threshold", sources("", "", "3", "4"), "0.1", "1", "", pipeline.Result{Error: pipeline.ErrTooManyErrors}},
+		{"zero inputs", sources(), "0.1", "0", "", pipeline.Result{Error: pipeline.ErrWrongInputCardinality}},
+		{"negative trim", sources("1", "2"), "-0.1", "", "", pipeline.Result{Error: pipeline.ErrBadInput}},
+		{"trim of half", sources("1", "2"), "0.5", "", "", pipeline.Result{Error: pipeline.ErrBadInput}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.TrimmedMeanTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Trim:          test.trim,
+				AllowedFaults: test.allowedFaults,
+				Precision:     test.precision,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+			}
+		})
+	}
+}
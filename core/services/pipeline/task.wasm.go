@@ -0,0 +1,379 @@
+package pipeline
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	capabilitiespb "github.com/smartcontractkit/chainlink-common/pkg/capabilities/pb"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/host"
+	wasmpb "github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/pb"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	defaultWASMFunction    = "compute"
+	defaultWASMMaxMemoryMB = 256
+	defaultWASMTimeout     = 2 * time.Second
+	// defaultWASMMaxFuel is higher than host.DefaultInitialFuel, which is not enough for the Go runtime and SDK to
+	// decode a request.
+	defaultWASMMaxFuel = uint64(1_000_000_000)
+
+	// maxCachedWASMModules bounds the number of compiled modules kept in memory. Modules beyond this are compiled
+	// for every run.
+	maxCachedWASMModules = 16
+)
+
+// WASMTask runs a compute function of a WASM module built with the chainlink-common workflow SDK, like the
+// custom compute capability does for workflows. The function named by Function is called with a single
+// argument: the JSON encoded task input (or all task inputs as a JSON array when there is more than one).
+//
+// The module is read either from Module (base64) or from ModulePath, and may be brotli compressed.
+// Execution is bounded by MaxFuel, MaxMemoryMB and the task timeout (2s if unset). Note that the host never limits
+// memory to less than 256MB, since Go modules need at least that much to run reliably. The task returns as soon as
+// ctx is done, the module itself keeps running until it is out of fuel or hits the timeout, since the host cannot
+// interrupt it earlier.
+//
+// Return types:
+//
+//	the value returned by the compute function, unwrapped from values.Value
+type WASMTask struct {
+	BaseTask    `mapstructure:",squash"`
+	Module      string `json:"module"`
+	ModulePath  string `json:"modulePath"`
+	Compressed  string `json:"compressed"`
+	Function    string `json:"function"`
+	Config      string `json:"config"`
+	Input       string `json:"input"`
+	MaxFuel     string `json:"maxFuel"`
+	MaxMemoryMB string `json:"maxMemoryMB"`
+
+	modules *wasmModuleCache
+}
+
+var _ Task = (*WASMTask)(nil)
+
+func (t *WASMTask) Type() TaskType {
+	return TaskTypeWASM
+}
+
+func (t *WASMTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	vals, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		module      StringParam
+		modulePath  StringParam
+		compressed  BoolParam
+		function    StringParam
+		config      StringParam
+		input       interface{}
+		maxFuel     Uint64Param
+		maxMemoryMB Uint64Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&module, From(VarExpr(t.Module, vars), NonemptyString(t.Module), "")), "module"),
+		errors.Wrap(ResolveParam(&modulePath, From(NonemptyString(t.ModulePath), "")), "modulePath"),
+		errors.Wrap(ResolveParam(&compressed, From(NonemptyString(t.Compressed), false)), "compressed"),
+		errors.Wrap(ResolveParam(&function, From(NonemptyString(t.Function), defaultWASMFunction)), "function"),
+		errors.Wrap(ResolveParam(&config, From(VarExpr(t.Config, vars), NonemptyString(t.Config), "")), "config"),
+		errors.Wrap(ResolveParam(&maxFuel, From(NonemptyString(t.MaxFuel), defaultWASMMaxFuel)), "maxFuel"),
+		errors.Wrap(ResolveParam(&maxMemoryMB, From(NonemptyString(t.MaxMemoryMB), defaultWASMMaxMemoryMB)), "maxMemoryMB"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	switch {
+	case t.Input != "":
+		input, err = VarExpr(t.Input, vars)()
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "input")}, runInfo
+		}
+	case len(vals) == 1:
+		input = vals[0]
+	default:
+		input = vals
+	}
+
+	binary, err := loadWASMModule(string(module), string(modulePath))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	arg, err := json.Marshal(input)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "failed to marshal input as JSON")}, runInfo
+	}
+
+	timeout, isSet := t.TaskTimeout()
+	if !isSet {
+		timeout = defaultWASMTimeout
+	}
+
+	value, err := runWASMModule(ctx, lggr, t.modules, wasmModuleConfig{
+		binary:         binary,
+		isUncompressed: !bool(compressed),
+		maxFuel:        uint64(maxFuel),
+		maxMemoryMB:    int64(maxMemoryMB),
+		timeout:        timeout,
+	}, string(function), []byte(config), string(arg))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
+
+func loadWASMModule(module, modulePath string) ([]byte, error) {
+	switch {
+	case module != "" && modulePath != "":
+		return nil, errors.New("must provide only one of either 'module' or 'modulePath'")
+	case module != "":
+		binary, err := base64.StdEncoding.DecodeString(module)
+		return binary, errors.Wrap(err, "failed to decode base64 module")
+	case modulePath != "":
+		binary, err := os.ReadFile(modulePath)
+		return binary, errors.Wrap(err, "failed to read module")
+	default:
+		return nil, errors.New("must provide either 'module' or 'modulePath'")
+	}
+}
+
+type wasmModuleConfig struct {
+	binary         []byte
+	isUncompressed bool
+	maxFuel        uint64
+	maxMemoryMB    int64
+	timeout        time.Duration
+}
+
+// key identifies a compiled module, including the limits it was compiled with.
+func (c wasmModuleConfig) key() [32]byte {
+	h := sha256.New()
+	h.Write(c.binary)
+	fmt.Fprintf(h, "%t,%d,%d,%d", c.isUncompressed, c.maxFuel, c.maxMemoryMB, c.timeout)
+	var key [32]byte
+	copy(key[:], h.Sum(nil))
+	return key
+}
+
+// wasmModuleCache caches compiled modules, since compiling a module takes much longer than running it. It holds up
+// to maxCachedWASMModules modules and evicts the least recently used one beyond that. Evicted modules are closed as
+// soon as no run uses them anymore. Modules are compiled outside of mu, so that compiling a module only blocks the runs
+// of that module.
+type wasmModuleCache struct {
+	mu      sync.Mutex
+	modules map[[32]byte]*cachedWASMModule
+	lru     *list.List // of *cachedWASMModule, most recently used first
+	closed  bool
+}
+
+type cachedWASMModule struct {
+	// ready is closed once mod, or err, is set.
+	ready   chan struct{}
+	mod     *host.Module
+	err     error
+	key     [32]byte
+	elem    *list.Element
+	refs    int
+	evicted bool
+}
+
+func newWASMModuleCache() *wasmModuleCache {
+	return &wasmModuleCache{
+		modules: map[[32]byte]*cachedWASMModule{},
+		lru:     list.New(),
+	}
+}
+
+func newWASMModule(lggr logger.Logger, cfg wasmModuleConfig) (*host.Module, error) {
+	timeout := cfg.timeout
+	mod, err := host.NewModule(&host.ModuleConfig{
+		Logger:         lggr.Named("WASM"),
+		IsUncompressed: cfg.isUncompressed,
+		InitialFuel:    cfg.maxFuel,
+		MaxMemoryMBs:   cfg.maxMemoryMB,
+		Timeout:        &timeout,
+	}, cfg.binary, host.WithDeterminism())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to instantiate WASM module")
+	}
+	mod.Start()
+	return mod, nil
+}
+
+// get returns the compiled module for cfg, compiling it if needed. The returned func must be called once the module
+// is no longer used.
+func (c *wasmModuleCache) get(lggr logger.Logger, cfg wasmModuleConfig) (*host.Module, func(), error) {
+	key := cfg.key()
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil, nil, errors.New("WASM module cache is closed")
+	}
+
+	cached, ok := c.modules[key]
+	if ok {
+		c.lru.MoveToFront(cached.elem)
+	} else {
+		// Insert a placeholder, so that concurrent runs of the same module wait for this compilation instead of
+		// starting their own.
+		cached = &cachedWASMModule{ready: make(chan struct{}), key: key}
+		cached.elem = c.lru.PushFront(cached)
+		c.modules[key] = cached
+		for c.lru.Len() > maxCachedWASMModules {
+			c.evict(c.lru.Back().Value.(*cachedWASMModule))
+		}
+	}
+	cached.refs++
+	c.mu.Unlock()
+
+	if ok {
+		<-cached.ready
+	} else {
+		mod, err := newWASMModule(lggr, cfg)
+		c.mu.Lock()
+		cached.mod, cached.err = mod, err
+		if err != nil && !cached.evicted {
+			// Do not cache failures, the next run compiles the module again.
+			c.evict(cached)
+		}
+		close(cached.ready)
+		c.mu.Unlock()
+	}
+
+	var once sync.Once
+	done := func() { once.Do(func() { c.release(cached) }) }
+	if cached.err != nil {
+		done()
+		return nil, nil, cached.err
+	}
+	return cached.mod, done, nil
+}
+
+func (c *wasmModuleCache) release(cached *cachedWASMModule) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached.refs--
+	if cached.evicted && cached.refs == 0 && cached.mod != nil {
+		cached.mod.Close()
+	}
+}
+
+// evict removes cached from the cache and closes it if it is not in use. Modules still being compiled are always in use.
+// c.mu must be held.
+func (c *wasmModuleCache) evict(cached *cachedWASMModule) {
+	c.lru.Remove(cached.elem)
+	delete(c.modules, cached.key)
+	cached.evicted = true
+	if cached.refs == 0 {
+		cached.mod.Close()
+	}
+}
+
+// Close closes all cached modules. Modules still used by a run are closed once the run is done.
+func (c *wasmModuleCache) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	for c.lru.Len() > 0 {
+		c.evict(c.lru.Front().Value.(*cachedWASMModule))
+	}
+}
+
+// runWASMModule calls the compute function of the module with arg and returns the unwrapped output value. The module
+// is taken from modules, or compiled for this run only if modules is nil.
+func runWASMModule(ctx context.Context, lggr logger.Logger, modules *wasmModuleCache, cfg wasmModuleConfig, function string, config []byte, arg string) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "WASM task cancelled")
+	}
+
+	req, err := values.WrapMap(map[string]any{"Arg0": arg})
+	if err != nil {
+		return nil, err
+	}
+
+	type runResult struct {
+		resp *wasmpb.Response
+		err  error
+	}
+	// Neither compiling nor running a module can be interrupted, so both happen in the background. The module is
+	// released once it has finished, which happens at the latest when it hits its timeout.
+	chResult := make(chan runResult, 1)
+	go func() {
+		var (
+			mod  *host.Module
+			done func()
+			err  error
+		)
+		if modules != nil {
+			mod, done, err = modules.get(lggr, cfg)
+		} else {
+			mod, err = newWASMModule(lggr, cfg)
+			done = func() { mod.Close() }
+		}
+		if err != nil {
+			chResult <- runResult{nil, err}
+			return
+		}
+		defer done()
+
+		resp, err := mod.Run(&wasmpb.Request{
+			Id:     uuid.New().String(),
+			Config: config,
+			Message: &wasmpb.Request_ComputeRequest{
+				ComputeRequest: &wasmpb.ComputeRequest{
+					Request: capabilitiespb.CapabilityRequestToProto(capabilities.CapabilityRequest{
+						Metadata: capabilities.RequestMetadata{ReferenceID: function},
+						Inputs:   req,
+						Config:   values.EmptyMap(),
+					}),
+				},
+			},
+		})
+		chResult <- runResult{resp, errors.Wrap(err, "error running module")}
+	}()
+
+	var resp *wasmpb.Response
+	select {
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "WASM task cancelled")
+	case res := <-chResult:
+		if res.err != nil {
+			return nil, res.err
+		}
+		resp = res.resp
+	}
+
+	cresppb := resp.GetComputeResponse().GetResponse()
+	if cresppb == nil {
+		return nil, errors.Errorf("got nil compute response: %s", resp.ErrMsg)
+	}
+	cresp, err := capabilitiespb.CapabilityResponseFromProto(cresppb)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert response proto into response")
+	}
+	if cresp.Value == nil {
+		return nil, nil
+	}
+	output, ok := cresp.Value.Underlying["Value"]
+	if !ok {
+		return nil, errors.New("compute response has no output value")
+	}
+	return values.Unwrap(output)
+}
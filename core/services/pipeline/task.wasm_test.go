@@ -0,0 +1,160 @@
+package pipeline_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/wasmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const wasmBinaryCmd = "core/services/pipeline/test/wasm/cmd"
+
+func TestWASMTask(t *testing.T) {
+	t.Parallel()
+
+	binaryPath := filepath.Join(t.TempDir(), "testmodule.wasm")
+	binary := wasmtest.CreateTestBinary(wasmBinaryCmd, binaryPath, false, t)
+
+	var compressed bytes.Buffer
+	bw := brotli.NewWriter(&compressed)
+	_, err := bw.Write(binary)
+	require.NoError(t, err)
+	require.NoError(t, bw.Close())
+	compressedPath := filepath.Join(t.TempDir(), "testmodule.wasm.br")
+	require.NoError(t, os.WriteFile(compressedPath, compressed.Bytes(), 0600))
+
+	inputs := []pipeline.Result{{Value: 1}, {Value: 2.5}, {Value: 3}}
+
+	tests := []struct {
+		name   string
+		task   pipeline.WASMTask
+		vars   pipeline.Vars
+		inputs []pipeline.Result
+		result interface{}
+		error  string
+	}{
+		{
+			"inline module",
+			pipeline.WASMTask{Module: base64.StdEncoding.EncodeToString(binary), Function: "sum"},
+			pipeline.NewVarsFrom(nil),
+			inputs,
+			6.5,
+			"",
+		},
+		{
+			"compressed module from file with config",
+			pipeline.WASMTask{ModulePath: compressedPath, Compressed: "true", Function: "sum", Config: "2"},
+			pipeline.NewVarsFrom(nil),
+			inputs,
+			13.0,
+			"",
+		},
+		{
+			"input from vars",
+			pipeline.WASMTask{ModulePath: binaryPath, Function: "sum", Input: "$(foo.nums)"},
+			pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"nums": []interface{}{4, 5}}}),
+			nil,
+			9.0,
+			"",
+		},
+		{
+			"input errored",
+			pipeline.WASMTask{ModulePath: binaryPath, Function: "sum"},
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: 1}, {Error: pipeline.ErrTooManyErrors}},
+			nil,
+			"task inputs",
+		},
+		{
+			"no module",
+			pipeline.WASMTask{Function: "sum"},
+			pipeline.NewVarsFrom(nil),
+			inputs,
+			nil,
+			"must provide either 'module' or 'modulePath'",
+		},
+		{
+			"both module and module path",
+			pipeline.WASMTask{Module: base64.StdEncoding.EncodeToString(binary), ModulePath: binaryPath, Function: "sum"},
+			pipeline.NewVarsFrom(nil),
+			inputs,
+			nil,
+			"must provide only one of either 'module' or 'modulePath'",
+		},
+		{
+			"unknown function",
+			pipeline.WASMTask{ModulePath: binaryPath, Function: "product"},
+			pipeline.NewVarsFrom(nil),
+			inputs,
+			nil,
+			"could not find compute function for id product",
+		},
+		{
+			"enough fuel to decode the request",
+			pipeline.WASMTask{ModulePath: binaryPath, Function: "sum", MaxFuel: "200000000"},
+			pipeline.NewVarsFrom(nil),
+			inputs,
+			6.5,
+			"",
+		},
+		{
+			"out of fuel",
+			pipeline.WASMTask{ModulePath: binaryPath, Function: "loop", MaxFuel: "200000000"},
+			pipeline.NewVarsFrom(nil),
+			inputs,
+			nil,
+			"all fuel consumed",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, runInfo := test.task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.error == "" {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.result, result.Value)
+			} else {
+				require.ErrorContains(t, result.Error, test.error)
+			}
+		})
+	}
+}
+
+func TestWASMTask_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	binaryPath := filepath.Join(t.TempDir(), "testmodule.wasm")
+	wasmtest.CreateTestBinary(wasmBinaryCmd, binaryPath, false, t)
+
+	timeout := 10 * time.Second
+	task := pipeline.WASMTask{
+		BaseTask:   pipeline.BaseTask{Timeout: &timeout},
+		ModulePath: binaryPath,
+		Function:   "loop",
+		MaxFuel:    "100000000000000",
+	}
+
+	ctx, cancel := context.WithTimeout(testutils.Context(t), 500*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "[]"}})
+	require.ErrorIs(t, result.Error, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -0,0 +1,116 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// WeightedMedianTask returns the weighted median of its values, where Weights holds one non-negative weight per
+// value, in the same order as the values. The weights of faulty values are ignored. When the values split the total
+// weight exactly in half, the two middle values are averaged, so equal weights give the same result as median.
+//
+// Return types:
+//
+//	*decimal.Decimal
+type WeightedMedianTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*WeightedMedianTask)(nil)
+
+func (t *WeightedMedianTask) Type() TaskType {
+	return TaskTypeWeightedMedian
+}
+
+func (t *WeightedMedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		weights            DecimalSliceParam
+		allowedFaults      int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weights, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false))), "weights"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if len(weights) != len(valuesAndErrs) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "got %v weights for %v values", len(weights), len(valuesAndErrs))}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	type weightedValue struct {
+		value  decimal.Decimal
+		weight decimal.Decimal
+	}
+	var (
+		weighted    []weightedValue
+		totalWeight = decimal.Zero
+		faults      int
+	)
+	for i, val := range valuesAndErrs {
+		if weights[i].IsNegative() {
+			return Result{Error: errors.Wrapf(ErrBadInput, "weights: weight %v of value %v is negative", weights[i], i)}, runInfo
+		}
+		if _, is := val.(error); is {
+			faults++
+			continue
+		}
+		var d DecimalParam
+		if err = d.UnmarshalPipelineParam(val); err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
+		}
+		weighted = append(weighted, weightedValue{d.Decimal(), weights[i]})
+		totalWeight = totalWeight.Add(weights[i])
+	}
+
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to weighted median task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(weighted) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to medianize")}, runInfo
+	} else if !totalWeight.IsPositive() {
+		return Result{Error: errors.Wrap(ErrBadInput, "weights: total weight of non-faulty values must be positive")}, runInfo
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].value.LessThan(weighted[j].value)
+	})
+
+	half := totalWeight.Div(decimal.NewFromInt(2))
+	cumulative := decimal.Zero
+	for i, wv := range weighted {
+		cumulative = cumulative.Add(wv.weight)
+		if cumulative.LessThan(half) {
+			continue
+		}
+		if cumulative.Equal(half) {
+			// the next value with a non-zero weight is the upper weighted median
+			for _, next := range weighted[i+1:] {
+				if next.weight.IsPositive() {
+					return Result{Value: wv.value.Add(next.value).Div(decimal.NewFromInt(2))}, runInfo
+				}
+			}
+		}
+		return Result{Value: wv.value}, runInfo
+	}
+	// unreachable, the cumulative weight always reaches the total weight
+	return Result{Value: weighted[len(weighted)-1].value}, runInfo
+}
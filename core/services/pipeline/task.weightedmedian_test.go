@@ -0,0 +1,127 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestWeightedMedianTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		weights       string
+		allowedFaults string
+		want          pipeline.Result
+	}{
+		{
+			"equal weights are the median",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"[1, 1, 1, 1]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2.5")},
+		},
+		{
+			"heavy source",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"[1, 1, 1, 5]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "4")},
+		},
+		{
+			"unsorted values",
+			[]pipeline.Result{{Value: mustDecimal(t, "30")}, {Value: mustDecimal(t, "10")}, {Value: mustDecimal(t, "20")}},
+			"[0.2, 0.5, 0.3]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "15")},
+		},
+		{
+			"zero weights are skipped at the midpoint",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			"[1, 0, 1]",
+			"",
+			pipeline.Result{Value: mustDecimal(t, "2")},
+		},
+		{
+			"weights of faulty values are ignored",
+			[]pipeline.Result{{Error: errors.New("")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"[10, 1, 1, 1]",
+			"1",
+			pipeline.Result{Value: mustDecimal(t, "3")},
+		},
+		{
+			"more errors than threshold",
+			[]pipeline.Result{{Error: errors.New("")}, {Error: errors.New("")}, {Value: mustDecimal(t, "3")}},
+			"[1, 1, 1]",
+			"1",
+			pipeline.Result{Error: pipeline.ErrTooManyErrors},
+		},
+		{
+			"weights and values length mismatch",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[1]",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"negative weight",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[1, -1]",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+		{
+			"zero total weight",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[0, 0]",
+			"",
+			pipeline.Result{Error: pipeline.ErrBadInput},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.WeightedMedianTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Weights:       test.weights,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.want.Error != nil {
+				require.Equal(t, test.want.Error, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want.Value.(*decimal.Decimal).String(), output.Value.(decimal.Decimal).String())
+			}
+		})
+	}
+
+	t.Run("with vars", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"foo": map[string]interface{}{"values": []interface{}{1, 2, 3}, "weights": []interface{}{3, 1, 1}},
+		})
+		task := pipeline.WeightedMedianTask{
+			BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Values:   "$(foo.values)",
+			Weights:  "$(foo.weights)",
+		}
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, output.Error)
+		require.Equal(t, "1", output.Value.(decimal.Decimal).String())
+	})
+}
@@ -0,0 +1,69 @@
+//go:build wasip1
+
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities/cli/cmd/testdata/fixtures/capabilities/basictrigger"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+)
+
+func BuildWorkflow(config []byte) *sdk.WorkflowSpecFactory {
+	workflow := sdk.NewWorkflowSpecFactory(
+		sdk.NewWorkflowParams{
+			Name:  "tester",
+			Owner: "ryan",
+		},
+	)
+
+	triggerCfg := basictrigger.TriggerConfig{Name: "trigger", Number: 100}
+	trigger := triggerCfg.New(workflow)
+
+	// sum adds up a JSON array of numbers, scaled by the factor given in the config
+	sdk.Compute1(
+		workflow,
+		"sum",
+		sdk.Compute1Inputs[string]{Arg0: trigger.CoolOutput()},
+		func(_ sdk.Runtime, input string) (float64, error) {
+			var nums []float64
+			if err := json.Unmarshal([]byte(input), &nums); err != nil {
+				return 0, err
+			}
+			factor := 1.0
+			if len(config) > 0 {
+				if err := json.Unmarshal(config, &factor); err != nil {
+					return 0, err
+				}
+			}
+			var sum float64
+			for _, n := range nums {
+				sum += n * factor
+			}
+			return sum, nil
+		})
+
+	// loop never returns, so it runs until the module is out of fuel or times out
+	sdk.Compute1(
+		workflow,
+		"loop",
+		sdk.Compute1Inputs[string]{Arg0: trigger.CoolOutput()},
+		func(_ sdk.Runtime, input string) (string, error) {
+			for {
+				input += "."
+				if len(input) > 1<<20 {
+					input = ""
+				}
+			}
+		})
+
+	return workflow
+}
+
+func main() {
+	runner := wasm.NewRunner()
+	workflow := BuildWorkflow(runner.Config())
+	runner.Run(workflow)
+}
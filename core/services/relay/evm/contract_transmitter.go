@@ -237,8 +237,20 @@ func (oc *contractTransmitter) FromAccount(ctx context.Context) (ocrtypes.Accoun
 	return ocrtypes.Account(oc.transmitter.FromAddress(ctx).String()), nil
 }
 
-func (oc *contractTransmitter) Start(ctx context.Context) error { return nil }
-func (oc *contractTransmitter) Close() error                    { return nil }
+// Start starts the underlying transmitter, if it needs starting.
+func (oc *contractTransmitter) Start(ctx context.Context) error {
+	if s, ok := oc.transmitter.(services.StartClose); ok {
+		return s.Start(ctx)
+	}
+	return nil
+}
+
+func (oc *contractTransmitter) Close() error {
+	if s, ok := oc.transmitter.(services.StartClose); ok {
+		return s.Close()
+	}
+	return nil
+}
 
 // Has no state/lifecycle so it's always healthy and ready
 func (oc *contractTransmitter) Ready() error { return nil }
@@ -36,8 +36,10 @@ import (
 	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/userops"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
@@ -316,7 +318,7 @@ func (r *Relayer) NewOCR3CapabilityProvider(ctx context.Context, rargs commontyp
 		return nil, err
 	}
 
-	transmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{}, OCR2AggregatorTransmissionContractABI)
+	transmitter, err := newOnChainContractTransmitter(ctx, r.lggr, r.ds, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{}, OCR2AggregatorTransmissionContractABI)
 	if err != nil {
 		return nil, err
 	}
@@ -362,7 +364,7 @@ func (r *Relayer) NewPluginProvider(ctx context.Context, rargs commontypes.Relay
 		return nil, err
 	}
 
-	transmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{}, OCR2AggregatorTransmissionContractABI)
+	transmitter, err := newOnChainContractTransmitter(ctx, r.lggr, r.ds, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{}, OCR2AggregatorTransmissionContractABI)
 	if err != nil {
 		return nil, err
 	}
@@ -417,6 +419,9 @@ func (r *Relayer) NewMercuryProvider(ctx context.Context, rargs commontypes.Rela
 	if err != nil {
 		return nil, pkgerrors.Wrap(err, "failed to get CSA key for mercury connection")
 	}
+	if _, remote := privKey.SignerKeyID(); remote {
+		return nil, fmt.Errorf("%w: wsrpc connections need a local CSA key", keystore.ErrRemoteKey)
+	}
 
 	clients := make(map[string]wsrpc.Client)
 	for _, server := range mercuryConfig.GetServers() {
@@ -531,6 +536,9 @@ func (r *Relayer) NewLLOProvider(ctx context.Context, rargs commontypes.RelayArg
 	if err != nil {
 		return nil, pkgerrors.Wrap(err, "failed to get CSA key for mercury connection")
 	}
+	if _, remote := privKey.SignerKeyID(); remote {
+		return nil, fmt.Errorf("%w: wsrpc connections need a local CSA key", keystore.ErrRemoteKey)
+	}
 
 	// FIXME: Remove after benchmarking is done
 	// https://smartcontract-it.atlassian.net/browse/MERC-3487
@@ -717,11 +725,13 @@ type configTransmitterOpts struct {
 	pluginGasLimit *uint32
 	// subjectID overrides the queueing subject id (the job external id will be used by default).
 	subjectID *uuid.UUID
+	// priority overrides the priority of the transmissions (high by default).
+	priority *txmgrtypes.TxPriority
 }
 
 // newOnChainContractTransmitter creates a new contract transmitter.
-func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts, transmissionContractABI abi.ABI, ocrTransmitterOpts ...OCRTransmitterOption) (*contractTransmitter, error) {
-	transmitter, err := generateTransmitterFrom(ctx, rargs, ethKeystore, configWatcher, opts)
+func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, ds sqlutil.DataSource, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts, transmissionContractABI abi.ABI, ocrTransmitterOpts ...OCRTransmitterOption) (*contractTransmitter, error) {
+	transmitter, err := generateTransmitterFrom(ctx, lggr, ds, rargs, ethKeystore, configWatcher, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -738,7 +748,7 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 	)
 }
 
-func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts) (Transmitter, error) {
+func generateTransmitterFrom(ctx context.Context, lggr logger.Logger, ds sqlutil.DataSource, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts) (Transmitter, error) {
 	var relayConfig types.RelayConfig
 	if err := json.Unmarshal(rargs.RelayConfig, &relayConfig); err != nil {
 		return nil, err
@@ -787,6 +797,15 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 		gasLimit = uint64(*opts.pluginGasLimit)
 	}
 
+	priority := txmgrtypes.TxPriorityHigh
+	if opts.priority != nil {
+		priority = *opts.priority
+	}
+
+	if relayConfig.UserOperations != nil {
+		return newUserOpTransmitter(ctx, lggr, ds, ethKeystore, configWatcher, *relayConfig.UserOperations, subject, fromAddresses, effectiveTransmitterAddress, gasLimit)
+	}
+
 	var transmitter Transmitter
 	var err error
 
@@ -800,6 +819,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			effectiveTransmitterAddress,
 			strategy,
 			checker,
+			priority,
 			configWatcher.chain.ID(),
 			ethKeystore,
 		)
@@ -822,6 +842,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			effectiveTransmitterAddress,
 			strategy,
 			checker,
+			priority,
 			configWatcher.chain.ID(),
 			ethKeystore,
 		)
@@ -832,6 +853,38 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 	return transmitter, nil
 }
 
+// newUserOpTransmitter returns a transmitter that sends reports as user operations of account. Each job uses its own
+// nonce lane of the account, derived from subject.
+func newUserOpTransmitter(ctx context.Context, lggr logger.Logger, ds sqlutil.DataSource, ethKeystore keystore.Eth, configWatcher *configWatcher, cfg types.UserOperationsConfig, subject uuid.UUID, fromAddresses []common.Address, account common.Address, gasLimit uint64) (Transmitter, error) {
+	if cfg.BundlerURL == "" {
+		return nil, pkgerrors.New("userOperations.bundlerURL must be specified")
+	}
+	if cfg.EntryPoint == (common.Address{}) {
+		return nil, pkgerrors.New("userOperations.entryPoint must be specified")
+	}
+	bundler, err := userops.NewBundlerClient(ctx, cfg.BundlerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := configWatcher.chain
+	manager := userops.NewManager(lggr, userops.Config{
+		EntryPoint:    cfg.EntryPoint,
+		Account:       account,
+		NonceKey:      new(big.Int).SetBytes(subject[:]),
+		MaxFeePrice:   chain.Config().EVM().GasEstimator().PriceMaxKey(account),
+		PollInterval:  userops.DefaultPollInterval,
+		SubmitTimeout: userops.DefaultSubmitTimeout,
+	}, chain.ID(), userops.NewORM(ds), bundler, chain.Client(), chain.GasEstimator(), ethKeystore)
+
+	transmitter, err := ocrcommon.NewUserOpTransmitter(manager, fromAddresses, gasLimit, chain.ID(), ethKeystore)
+	if err != nil {
+		bundler.Close()
+		return nil, pkgerrors.Wrap(err, "failed to create transmitter")
+	}
+	return transmitter, nil
+}
+
 func (r *Relayer) NewChainWriter(_ context.Context, config []byte) (commontypes.ChainWriter, error) {
 	var cfg types.ChainWriterConfig
 	if err := json.Unmarshal(config, &cfg); err != nil {
@@ -873,7 +926,7 @@ func (r *Relayer) NewMedianProvider(ctx context.Context, rargs commontypes.Relay
 
 	reportCodec := evmreportcodec.ReportCodec{}
 
-	contractTransmitter, err := newOnChainContractTransmitter(ctx, lggr, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{}, OCR2AggregatorTransmissionContractABI)
+	contractTransmitter, err := newOnChainContractTransmitter(ctx, lggr, r.ds, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{}, OCR2AggregatorTransmissionContractABI)
 	if err != nil {
 		return nil, err
 	}
@@ -982,7 +1035,7 @@ func (r *Relayer) NewCCIPCommitProvider(ctx context.Context, rargs commontypes.R
 		return nil, err
 	}
 	subjectID := chainToUUID(configWatcher.chain.ID())
-	contractTransmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{
+	contractTransmitter, err := newOnChainContractTransmitter(ctx, r.lggr, r.ds, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{
 		subjectID: &subjectID,
 	}, OCR2AggregatorTransmissionContractABI, WithReportToEthMetadata(fn), WithRetention(0))
 	if err != nil {
@@ -1052,7 +1105,7 @@ func (r *Relayer) NewCCIPExecProvider(ctx context.Context, rargs commontypes.Rel
 		return nil, err
 	}
 	subjectID := chainToUUID(configWatcher.chain.ID())
-	contractTransmitter, err := newOnChainContractTransmitter(ctx, r.lggr, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{
+	contractTransmitter, err := newOnChainContractTransmitter(ctx, r.lggr, r.ds, rargs, r.ks.Eth(), configWatcher, configTransmitterOpts{
 		subjectID: &subjectID,
 	}, OCR2AggregatorTransmissionContractABI, WithReportToEthMetadata(fn), WithRetention(0))
 	if err != nil {
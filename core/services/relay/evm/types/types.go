@@ -205,6 +205,9 @@ type RelayConfig struct {
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
+	// UserOperations, if set, makes the contract transmitter send reports as ERC-4337 user operations of the smart
+	// account given by EffectiveTransmitterID. SendingKeys are then session keys of that account.
+	UserOperations *UserOperationsConfig `json:"userOperations"`
 
 	// Mercury-specific
 	FeedID                  *common.Hash `json:"feedID"`
@@ -215,6 +218,14 @@ type RelayConfig struct {
 	LLOConfigMode LLOConfigMode `json:"lloConfigMode" toml:"lloConfigMode"`
 }
 
+// UserOperationsConfig configures how user operations are sent.
+type UserOperationsConfig struct {
+	// BundlerURL is the RPC endpoint of the ERC-4337 bundler.
+	BundlerURL string `json:"bundlerURL"`
+	// EntryPoint is the address of the v0.6 EntryPoint contract that the bundler submits to.
+	EntryPoint common.Address `json:"entryPoint"`
+}
+
 var ErrBadRelayConfig = errors.New("bad relay config")
 
 type RelayOpts struct {
@@ -183,6 +183,9 @@ func (tc *telemetryIngressBatchClient) getCSAPrivateKey() (privkey []byte, err e
 		return privkey, errors.New("CSA key does not exist")
 	}
 
+	if _, remote := keys[0].SignerKeyID(); remote {
+		return privkey, fmt.Errorf("%w: wsrpc connections need a local CSA key", keystore.ErrRemoteKey)
+	}
 	return keys[0].Raw(), nil
 }
 
@@ -3,6 +3,7 @@ package synchronization
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
 	"sync/atomic"
 	"time"
@@ -163,6 +164,9 @@ func (tc *telemetryIngressClient) getCSAPrivateKey() (privkey []byte, err error)
 		return privkey, errors.New("CSA key does not exist")
 	}
 
+	if _, remote := keys[0].SignerKeyID(); remote {
+		return privkey, fmt.Errorf("%w: wsrpc connections need a local CSA key", keystore.ErrRemoteKey)
+	}
 	return keys[0].Raw(), nil
 }
 
@@ -0,0 +1,140 @@
+package sessions
+
+import (
+	"crypto/subtle"
+	"net/netip"
+	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// MaxAPITokenNameLength is the maximum length of the name of an APIToken.
+const MaxAPITokenNameLength = 64
+
+// ErrAPITokenExists is returned when creating an APIToken with the name of another token of the same user.
+var ErrAPITokenExists = pkgerrors.New("an API token with this name already exists")
+
+// APIToken is a named API token of a user. Unlike the user's own API token, a user can hold any number of them, and
+// each expires, may have a lower role than the user, and may only be used from a list of source IPs.
+type APIToken struct {
+	ID                int64
+	UserEmail         string
+	Name              string
+	Role              UserRole
+	TokenKey          string
+	TokenSalt         string
+	TokenHashedSecret string
+	AllowedIPs        pq.StringArray `db:"allowed_ips"`
+	ExpiresAt         time.Time
+	LastUsed          null.Time
+	CreatedAt         time.Time
+}
+
+// CreateAPITokenRequest is sent to create a named API token for the current user.
+type CreateAPITokenRequest struct {
+	Name string   `json:"name"`
+	Role UserRole `json:"role"`
+	// ExpiresAt is required, API tokens never outlive it.
+	ExpiresAt time.Time `json:"expiresAt"`
+	// AllowedIPs optionally restricts the token to source IPs or CIDR ranges, e.g. "10.0.0.1" or "10.0.0.0/8".
+	AllowedIPs []string `json:"allowedIPs"`
+	Password   string   `json:"password"`
+}
+
+// NewAPIToken validates the request of user, and returns the new APIToken along with its credentials. Only the hash
+// of the secret is kept in the APIToken, so the credentials cannot be retrieved later.
+func NewAPIToken(user User, request CreateAPITokenRequest, now time.Time) (APIToken, *auth.Token, error) {
+	if request.Name == "" {
+		return APIToken{}, nil, pkgerrors.New("API token name must be specified")
+	}
+	if len(request.Name) > MaxAPITokenNameLength {
+		return APIToken{}, nil, pkgerrors.Errorf("API token name must be at most %d characters", MaxAPITokenNameLength)
+	}
+	role, err := GetUserRole(string(request.Role))
+	if err != nil {
+		return APIToken{}, nil, err
+	}
+	if !user.Role.IsAtLeast(role) {
+		return APIToken{}, nil, pkgerrors.Errorf("API token role %s cannot be higher than the user's role %s", role, user.Role)
+	}
+	if !request.ExpiresAt.After(now) {
+		return APIToken{}, nil, pkgerrors.New("API token expiry must be in the future")
+	}
+	allowedIPs := pq.StringArray{}
+	for _, ip := range request.AllowedIPs {
+		prefix, err := parseAllowedIP(ip)
+		if err != nil {
+			return APIToken{}, nil, err
+		}
+		allowedIPs = append(allowedIPs, prefix.String())
+	}
+
+	token := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(token, salt)
+	if err != nil {
+		return APIToken{}, nil, pkgerrors.Wrap(err, "API token")
+	}
+	return APIToken{
+		UserEmail:         user.Email,
+		Name:              request.Name,
+		Role:              role,
+		TokenKey:          token.AccessKey,
+		TokenSalt:         salt,
+		TokenHashedSecret: hashedSecret,
+		AllowedIPs:        allowedIPs,
+		ExpiresAt:         request.ExpiresAt,
+	}, token, nil
+}
+
+// parseAllowedIP parses an IP or CIDR range of an API token allowlist.
+func parseAllowedIP(ip string) (netip.Prefix, error) {
+	if addr, err := netip.ParseAddr(ip); err == nil {
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(ip)
+	if err != nil {
+		return netip.Prefix{}, pkgerrors.Errorf("invalid allowed IP %q, must be an IP or CIDR range", ip)
+	}
+	return prefix.Masked(), nil
+}
+
+// Expired returns true if the token can no longer be used at now.
+func (t *APIToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// AllowsIP returns true if the token can be used from the source ip.
+func (t *APIToken) AllowsIP(ip string) bool {
+	if len(t.AllowedIPs) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, allowed := range t.AllowedIPs {
+		prefix, err := netip.ParsePrefix(allowed)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthenticateAPIToken returns true on successful authentication of the
+// named API token against the given Authentication Token.
+func AuthenticateAPIToken(token *auth.Token, apiToken *APIToken) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(token, apiToken.TokenSalt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(apiToken.TokenHashedSecret)) == 1, nil
+}
@@ -0,0 +1,95 @@
+package sessions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestUserRole_IsAtLeast(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, sessions.UserRoleAdmin.IsAtLeast(sessions.UserRoleAdmin))
+	assert.True(t, sessions.UserRoleAdmin.IsAtLeast(sessions.UserRoleView))
+	assert.True(t, sessions.UserRoleEdit.IsAtLeast(sessions.UserRoleRun))
+	assert.False(t, sessions.UserRoleRun.IsAtLeast(sessions.UserRoleEdit))
+	assert.False(t, sessions.UserRoleView.IsAtLeast(sessions.UserRoleRun))
+}
+
+func TestNewAPIToken(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	user := sessions.User{Email: "bot@example.com", Role: sessions.UserRoleEdit}
+	valid := sessions.CreateAPITokenRequest{
+		Name:       "ci",
+		Role:       sessions.UserRoleRun,
+		ExpiresAt:  now.Add(time.Hour),
+		AllowedIPs: []string{"10.0.0.1", "192.168.1.7/24", "::ffff:172.16.0.1"},
+	}
+
+	apiToken, token, err := sessions.NewAPIToken(user, valid, now)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, apiToken.UserEmail)
+	assert.Equal(t, "ci", apiToken.Name)
+	assert.Equal(t, sessions.UserRoleRun, apiToken.Role)
+	assert.Equal(t, token.AccessKey, apiToken.TokenKey)
+	assert.Equal(t, []string{"10.0.0.1/32", "192.168.1.0/24", "172.16.0.1/32"}, []string(apiToken.AllowedIPs))
+	assert.NotContains(t, apiToken.TokenHashedSecret, token.Secret)
+
+	ok, err := sessions.AuthenticateAPIToken(token, &apiToken)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = sessions.AuthenticateAPIToken(&auth.Token{AccessKey: token.AccessKey, Secret: "wrong"}, &apiToken)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	tests := []struct {
+		name   string
+		modify func(r *sessions.CreateAPITokenRequest)
+		err    string
+	}{
+		{"no name", func(r *sessions.CreateAPITokenRequest) { r.Name = "" }, "API token name must be specified"},
+		{"invalid role", func(r *sessions.CreateAPITokenRequest) { r.Role = "root" }, "Invalid role: root"},
+		{"role above user", func(r *sessions.CreateAPITokenRequest) { r.Role = sessions.UserRoleAdmin }, "API token role admin cannot be higher than the user's role edit"},
+		{"expired", func(r *sessions.CreateAPITokenRequest) { r.ExpiresAt = now }, "API token expiry must be in the future"},
+		{"invalid IP", func(r *sessions.CreateAPITokenRequest) { r.AllowedIPs = []string{"10.0.0"} }, `invalid allowed IP "10.0.0"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := valid
+			tt.modify(&request)
+			_, _, err := sessions.NewAPIToken(user, request, now)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestAPIToken_Expired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	apiToken := sessions.APIToken{ExpiresAt: now}
+	assert.False(t, apiToken.Expired(now.Add(-time.Second)))
+	assert.True(t, apiToken.Expired(now))
+}
+
+func TestAPIToken_AllowsIP(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, (&sessions.APIToken{}).AllowsIP("1.2.3.4"))
+
+	apiToken := sessions.APIToken{AllowedIPs: []string{"10.0.0.1/32", "192.168.1.0/24", "2001:db8::/32"}}
+	assert.True(t, apiToken.AllowsIP("10.0.0.1"))
+	assert.True(t, apiToken.AllowsIP("::ffff:10.0.0.1"))
+	assert.True(t, apiToken.AllowsIP("192.168.1.200"))
+	assert.True(t, apiToken.AllowsIP("2001:db8::1"))
+	assert.False(t, apiToken.AllowsIP("10.0.0.2"))
+	assert.False(t, apiToken.AllowsIP("192.168.2.1"))
+	assert.False(t, apiToken.AllowsIP("not an ip"))
+}
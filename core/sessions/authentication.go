@@ -53,6 +53,11 @@ type AuthenticationProvider interface {
 	SetAuthToken(ctx context.Context, user *User, token *auth.Token) error
 	CreateAndSetAuthToken(ctx context.Context, user *User) (*auth.Token, error)
 	DeleteAuthToken(ctx context.Context, user *User) error
+	CreateAPIToken(ctx context.Context, token *APIToken) error
+	ListAPITokens(ctx context.Context, email string) ([]APIToken, error)
+	FindAPIToken(ctx context.Context, tokenKey string) (APIToken, error)
+	MarkAPITokenUsed(ctx context.Context, id int64) error
+	RevokeAPIToken(ctx context.Context, email, name string) (APIToken, error)
 	SetPassword(ctx context.Context, user *User, newPassword string) error
 	TestPassword(ctx context.Context, email, password string) error
 	Sessions(ctx context.Context, offset, limit int) ([]Session, error)
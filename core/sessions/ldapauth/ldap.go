@@ -592,6 +592,31 @@ func (l *ldapAuthenticator) DeleteAuthToken(ctx context.Context, user *sessions.
 	return err
 }
 
+// CreateAPIToken is not supported for LDAP, which keeps a single API token per user
+func (l *ldapAuthenticator) CreateAPIToken(ctx context.Context, token *sessions.APIToken) error {
+	return sessions.ErrNotSupported
+}
+
+// ListAPITokens is not supported for LDAP, which keeps a single API token per user
+func (l *ldapAuthenticator) ListAPITokens(ctx context.Context, email string) ([]sessions.APIToken, error) {
+	return nil, sessions.ErrNotSupported
+}
+
+// FindAPIToken is not supported for LDAP, which keeps a single API token per user
+func (l *ldapAuthenticator) FindAPIToken(ctx context.Context, tokenKey string) (sessions.APIToken, error) {
+	return sessions.APIToken{}, sessions.ErrNotSupported
+}
+
+// MarkAPITokenUsed is not supported for LDAP, which keeps a single API token per user
+func (l *ldapAuthenticator) MarkAPITokenUsed(ctx context.Context, id int64) error {
+	return sessions.ErrNotSupported
+}
+
+// RevokeAPIToken is not supported for LDAP, which keeps a single API token per user
+func (l *ldapAuthenticator) RevokeAPIToken(ctx context.Context, email, name string) (sessions.APIToken, error) {
+	return sessions.APIToken{}, sessions.ErrNotSupported
+}
+
 // SaveWebAuthn is not supported for read only LDAP
 func (l *ldapAuthenticator) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
 	return sessions.ErrNotSupported
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jackc/pgconn"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
@@ -340,6 +341,45 @@ func (o *orm) DeleteAuthToken(ctx context.Context, user *sessions.User) error {
 	return o.ds.GetContext(ctx, user, sql, user.Email)
 }
 
+// CreateAPIToken stores a new named API token of a user.
+func (o *orm) CreateAPIToken(ctx context.Context, token *sessions.APIToken) error {
+	sql := `INSERT INTO user_api_tokens (user_email, name, role, token_key, token_salt, token_hashed_secret, allowed_ips, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now()) RETURNING *`
+	err := o.ds.GetContext(ctx, token, sql, token.UserEmail, token.Name, token.Role, token.TokenKey, token.TokenSalt,
+		token.TokenHashedSecret, token.AllowedIPs, token.ExpiresAt)
+	var pqErr *pgconn.PgError
+	if pkgerrors.As(err, &pqErr) && pqErr.ConstraintName == "user_api_tokens_user_email_name_key" {
+		return pkgerrors.Wrap(sessions.ErrAPITokenExists, token.Name)
+	}
+	return err
+}
+
+// ListAPITokens returns the named API tokens of the user, including expired ones.
+func (o *orm) ListAPITokens(ctx context.Context, email string) (tokens []sessions.APIToken, err error) {
+	sql := "SELECT * FROM user_api_tokens WHERE user_email = lower($1) ORDER BY name ASC"
+	err = o.ds.SelectContext(ctx, &tokens, sql, email)
+	return
+}
+
+// FindAPIToken returns the named API token with the access key tokenKey.
+func (o *orm) FindAPIToken(ctx context.Context, tokenKey string) (token sessions.APIToken, err error) {
+	err = o.ds.GetContext(ctx, &token, "SELECT * FROM user_api_tokens WHERE token_key = $1", tokenKey)
+	return
+}
+
+// MarkAPITokenUsed sets the last_used time of the named API token to now().
+func (o *orm) MarkAPITokenUsed(ctx context.Context, id int64) error {
+	_, err := o.ds.ExecContext(ctx, "UPDATE user_api_tokens SET last_used = now() WHERE id = $1", id)
+	return err
+}
+
+// RevokeAPIToken deletes the named API token of the user, and returns it.
+func (o *orm) RevokeAPIToken(ctx context.Context, email, name string) (token sessions.APIToken, err error) {
+	sql := "DELETE FROM user_api_tokens WHERE user_email = lower($1) AND name = $2 RETURNING *"
+	err = o.ds.GetContext(ctx, &token, sql, email, name)
+	return
+}
+
 // SaveWebAuthn saves new WebAuthn token information.
 func (o *orm) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
 	sql := "INSERT INTO web_authns (email, public_key_data) VALUES ($1, $2)"
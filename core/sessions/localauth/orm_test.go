@@ -1,6 +1,7 @@
 package localauth_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
@@ -309,3 +310,55 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	assert.Empty(t, dbUser.TokenSalt.ValueOrZero())
 	assert.Empty(t, dbUser.TokenHashedSecret.ValueOrZero())
 }
+
+func TestORM_APITokens(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, orm := setupORM(t)
+
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, orm.CreateUser(ctx, &user))
+
+	apiToken, token, err := sessions.NewAPIToken(user, sessions.CreateAPITokenRequest{
+		Name:       "monitoring",
+		Role:       sessions.UserRoleView,
+		ExpiresAt:  time.Now().Add(time.Hour),
+		AllowedIPs: []string{"10.0.0.0/8"},
+	}, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateAPIToken(ctx, &apiToken))
+	assert.NotZero(t, apiToken.ID)
+
+	duplicate, _, err := sessions.NewAPIToken(user, sessions.CreateAPITokenRequest{
+		Name:      "monitoring",
+		Role:      sessions.UserRoleView,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, time.Now())
+	require.NoError(t, err)
+	require.ErrorIs(t, orm.CreateAPIToken(ctx, &duplicate), sessions.ErrAPITokenExists)
+
+	found, err := orm.FindAPIToken(ctx, token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, apiToken.ID, found.ID)
+	assert.Equal(t, sessions.UserRoleView, found.Role)
+	assert.Equal(t, []string{"10.0.0.0/8"}, []string(found.AllowedIPs))
+	assert.False(t, found.LastUsed.Valid)
+	ok, err := sessions.AuthenticateAPIToken(token, &found)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	require.NoError(t, orm.MarkAPITokenUsed(ctx, found.ID))
+	tokens, err := orm.ListAPITokens(ctx, user.Email)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.True(t, tokens[0].LastUsed.Valid)
+
+	revoked, err := orm.RevokeAPIToken(ctx, user.Email, "monitoring")
+	require.NoError(t, err)
+	assert.Equal(t, apiToken.ID, revoked.ID)
+	_, err = orm.FindAPIToken(ctx, token.AccessKey)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = orm.RevokeAPIToken(ctx, user.Email, "monitoring")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
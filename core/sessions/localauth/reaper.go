@@ -42,6 +42,10 @@ func (sr *sessionReaper) Work() {
 	if err != nil {
 		sr.lggr.Error("unable to reap stale sessions: ", err)
 	}
+	err = sr.deleteExpiredAPITokens(ctx, recordCreationStaleThreshold)
+	if err != nil {
+		sr.lggr.Error("unable to reap expired API tokens: ", err)
+	}
 }
 
 // DeleteStaleSessions deletes all sessions before the passed time.
@@ -49,3 +53,9 @@ func (sr *sessionReaper) deleteStaleSessions(ctx context.Context, before time.Ti
 	_, err := sr.ds.ExecContext(ctx, "DELETE FROM sessions WHERE last_used < $1", before)
 	return err
 }
+
+// deleteExpiredAPITokens deletes all named API tokens that expired before the passed time.
+func (sr *sessionReaper) deleteExpiredAPITokens(ctx context.Context, before time.Time) error {
+	_, err := sr.ds.ExecContext(ctx, "DELETE FROM user_api_tokens WHERE expires_at < $1", before)
+	return err
+}
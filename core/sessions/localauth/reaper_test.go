@@ -83,3 +83,55 @@ func TestSessionReaper_ReapSessions(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionReaper_ReapAPITokens(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	config := sessionReaperConfig{}
+	lggr := logger.TestLogger(t)
+	orm := localauth.NewORM(db, config.SessionTimeout().Duration(), lggr, audit.NoopLogger)
+
+	r := localauth.NewSessionReaper(db, config, lggr)
+	t.Cleanup(func() {
+		assert.NoError(t, r.Stop())
+	})
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		wantReap  bool
+	}{
+		{"current", time.Now().Add(time.Hour), false},
+		{"expired", time.Now(), false},
+		{"stale", time.Now().Add(-config.SessionReaperExpiration().Duration()).
+			Add(-config.SessionTimeout().Duration()), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := testutils.Context(t)
+			t.Cleanup(func() {
+				_, err2 := db.Exec("DELETE FROM user_api_tokens where user_email = $1", cltest.APIEmailAdmin)
+				require.NoError(t, err2)
+			})
+
+			_, err := db.Exec(`INSERT INTO user_api_tokens (user_email, name, role, token_key, token_salt, token_hashed_secret, expires_at, created_at)
+				VALUES ($1, $2, 'view', $2, 'salt', 'secret', $3, now())`, cltest.APIEmailAdmin, test.name, test.expiresAt)
+			require.NoError(t, err)
+
+			r.WakeUp()
+
+			tokens := func() []sessions.APIToken {
+				tokens, err := orm.ListAPITokens(ctx, cltest.APIEmailAdmin)
+				assert.NoError(t, err)
+				return tokens
+			}
+			if test.wantReap {
+				gomega.NewWithT(t).Eventually(tokens).Should(gomega.HaveLen(0))
+			} else {
+				gomega.NewWithT(t).Consistently(tokens).Should(gomega.HaveLen(1))
+			}
+		})
+	}
+}
@@ -130,6 +130,53 @@ func (_c *AuthenticationProvider_ClearNonCurrentSessions_Call) RunAndReturn(run
 	return _c
 }
 
+// CreateAPIToken provides a mock function with given fields: ctx, token
+func (_m *AuthenticationProvider) CreateAPIToken(ctx context.Context, token *sessions.APIToken) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sessions.APIToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_CreateAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIToken'
+type AuthenticationProvider_CreateAPIToken_Call struct {
+	*mock.Call
+}
+
+// CreateAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *sessions.APIToken
+func (_e *AuthenticationProvider_Expecter) CreateAPIToken(ctx interface{}, token interface{}) *AuthenticationProvider_CreateAPIToken_Call {
+	return &AuthenticationProvider_CreateAPIToken_Call{Call: _e.mock.On("CreateAPIToken", ctx, token)}
+}
+
+func (_c *AuthenticationProvider_CreateAPIToken_Call) Run(run func(ctx context.Context, token *sessions.APIToken)) *AuthenticationProvider_CreateAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*sessions.APIToken))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_CreateAPIToken_Call) Return(_a0 error) *AuthenticationProvider_CreateAPIToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_CreateAPIToken_Call) RunAndReturn(run func(context.Context, *sessions.APIToken) error) *AuthenticationProvider_CreateAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAndSetAuthToken provides a mock function with given fields: ctx, user
 func (_m *AuthenticationProvider) CreateAndSetAuthToken(ctx context.Context, user *sessions.User) (*auth.Token, error) {
 	ret := _m.Called(ctx, user)
@@ -434,6 +481,63 @@ func (_c *AuthenticationProvider_DeleteUserSession_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// FindAPIToken provides a mock function with given fields: ctx, tokenKey
+func (_m *AuthenticationProvider) FindAPIToken(ctx context.Context, tokenKey string) (sessions.APIToken, error) {
+	ret := _m.Called(ctx, tokenKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIToken")
+	}
+
+	var r0 sessions.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sessions.APIToken, error)); ok {
+		return rf(ctx, tokenKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sessions.APIToken); ok {
+		r0 = rf(ctx, tokenKey)
+	} else {
+		r0 = ret.Get(0).(sessions.APIToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_FindAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIToken'
+type AuthenticationProvider_FindAPIToken_Call struct {
+	*mock.Call
+}
+
+// FindAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenKey string
+func (_e *AuthenticationProvider_Expecter) FindAPIToken(ctx interface{}, tokenKey interface{}) *AuthenticationProvider_FindAPIToken_Call {
+	return &AuthenticationProvider_FindAPIToken_Call{Call: _e.mock.On("FindAPIToken", ctx, tokenKey)}
+}
+
+func (_c *AuthenticationProvider_FindAPIToken_Call) Run(run func(ctx context.Context, tokenKey string)) *AuthenticationProvider_FindAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_FindAPIToken_Call) Return(_a0 sessions.APIToken, _a1 error) *AuthenticationProvider_FindAPIToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_FindAPIToken_Call) RunAndReturn(run func(context.Context, string) (sessions.APIToken, error)) *AuthenticationProvider_FindAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindExternalInitiator provides a mock function with given fields: ctx, eia
 func (_m *AuthenticationProvider) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	ret := _m.Called(ctx, eia)
@@ -666,6 +770,65 @@ func (_c *AuthenticationProvider_GetUserWebAuthn_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ListAPITokens provides a mock function with given fields: ctx, email
+func (_m *AuthenticationProvider) ListAPITokens(ctx context.Context, email string) ([]sessions.APIToken, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPITokens")
+	}
+
+	var r0 []sessions.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]sessions.APIToken, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []sessions.APIToken); ok {
+		r0 = rf(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.APIToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_ListAPITokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPITokens'
+type AuthenticationProvider_ListAPITokens_Call struct {
+	*mock.Call
+}
+
+// ListAPITokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *AuthenticationProvider_Expecter) ListAPITokens(ctx interface{}, email interface{}) *AuthenticationProvider_ListAPITokens_Call {
+	return &AuthenticationProvider_ListAPITokens_Call{Call: _e.mock.On("ListAPITokens", ctx, email)}
+}
+
+func (_c *AuthenticationProvider_ListAPITokens_Call) Run(run func(ctx context.Context, email string)) *AuthenticationProvider_ListAPITokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_ListAPITokens_Call) Return(_a0 []sessions.APIToken, _a1 error) *AuthenticationProvider_ListAPITokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_ListAPITokens_Call) RunAndReturn(run func(context.Context, string) ([]sessions.APIToken, error)) *AuthenticationProvider_ListAPITokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUsers provides a mock function with given fields: ctx
 func (_m *AuthenticationProvider) ListUsers(ctx context.Context) ([]sessions.User, error) {
 	ret := _m.Called(ctx)
@@ -724,6 +887,111 @@ func (_c *AuthenticationProvider_ListUsers_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// MarkAPITokenUsed provides a mock function with given fields: ctx, id
+func (_m *AuthenticationProvider) MarkAPITokenUsed(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAPITokenUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationProvider_MarkAPITokenUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAPITokenUsed'
+type AuthenticationProvider_MarkAPITokenUsed_Call struct {
+	*mock.Call
+}
+
+// MarkAPITokenUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *AuthenticationProvider_Expecter) MarkAPITokenUsed(ctx interface{}, id interface{}) *AuthenticationProvider_MarkAPITokenUsed_Call {
+	return &AuthenticationProvider_MarkAPITokenUsed_Call{Call: _e.mock.On("MarkAPITokenUsed", ctx, id)}
+}
+
+func (_c *AuthenticationProvider_MarkAPITokenUsed_Call) Run(run func(ctx context.Context, id int64)) *AuthenticationProvider_MarkAPITokenUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_MarkAPITokenUsed_Call) Return(_a0 error) *AuthenticationProvider_MarkAPITokenUsed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthenticationProvider_MarkAPITokenUsed_Call) RunAndReturn(run func(context.Context, int64) error) *AuthenticationProvider_MarkAPITokenUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIToken provides a mock function with given fields: ctx, email, name
+func (_m *AuthenticationProvider) RevokeAPIToken(ctx context.Context, email string, name string) (sessions.APIToken, error) {
+	ret := _m.Called(ctx, email, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIToken")
+	}
+
+	var r0 sessions.APIToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (sessions.APIToken, error)); ok {
+		return rf(ctx, email, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) sessions.APIToken); ok {
+		r0 = rf(ctx, email, name)
+	} else {
+		r0 = ret.Get(0).(sessions.APIToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, email, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationProvider_RevokeAPIToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIToken'
+type AuthenticationProvider_RevokeAPIToken_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - name string
+func (_e *AuthenticationProvider_Expecter) RevokeAPIToken(ctx interface{}, email interface{}, name interface{}) *AuthenticationProvider_RevokeAPIToken_Call {
+	return &AuthenticationProvider_RevokeAPIToken_Call{Call: _e.mock.On("RevokeAPIToken", ctx, email, name)}
+}
+
+func (_c *AuthenticationProvider_RevokeAPIToken_Call) Run(run func(ctx context.Context, email string, name string)) *AuthenticationProvider_RevokeAPIToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthenticationProvider_RevokeAPIToken_Call) Return(_a0 sessions.APIToken, _a1 error) *AuthenticationProvider_RevokeAPIToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthenticationProvider_RevokeAPIToken_Call) RunAndReturn(run func(context.Context, string, string) (sessions.APIToken, error)) *AuthenticationProvider_RevokeAPIToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveWebAuthn provides a mock function with given fields: ctx, token
 func (_m *AuthenticationProvider) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
 	ret := _m.Called(ctx, token)
@@ -32,6 +32,18 @@ const (
 	UserRoleView  UserRole = "view"
 )
 
+var userRoleRanks = map[UserRole]int{
+	UserRoleView:  1,
+	UserRoleRun:   2,
+	UserRoleEdit:  3,
+	UserRoleAdmin: 4,
+}
+
+// IsAtLeast returns true if role r grants every permission of role other.
+func (r UserRole) IsAtLeast(other UserRole) bool {
+	return userRoleRanks[r] >= userRoleRanks[other]
+}
+
 // https://security.stackexchange.com/questions/39849/does-bcrypt-have-a-maximum-password-length
 const (
 	MaxBcryptPasswordLength = 50
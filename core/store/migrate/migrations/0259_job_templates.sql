@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE job_templates (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    template TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE job_template_jobs (
+    job_id INT PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE,
+    job_template_id INT NOT NULL REFERENCES job_templates (id) ON DELETE CASCADE,
+    params JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_job_template_jobs_job_template_id ON job_template_jobs (job_template_id);
+
+-- +goose Down
+DROP TABLE job_template_jobs;
+DROP TABLE job_templates;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Versions are kept when a job is deleted, since updating a job deletes and recreates it with the same ID.
+CREATE TABLE job_spec_versions (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INT NOT NULL,
+    version INT NOT NULL,
+    spec TEXT NOT NULL,
+    author TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (job_id, version)
+);
+
+-- +goose Down
+DROP TABLE job_spec_versions;
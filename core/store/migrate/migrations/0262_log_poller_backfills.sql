@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE evm.log_poller_backfills (
+    evm_chain_id NUMERIC(78,0) PRIMARY KEY,
+    from_block BIGINT NOT NULL,
+    to_block BIGINT NOT NULL,
+    completed_block BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_log_poller_backfills_range CHECK (from_block <= to_block AND completed_block >= from_block - 1 AND completed_block <= to_block)
+);
+
+-- +goose Down
+DROP TABLE evm.log_poller_backfills;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE evm.txes ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0;
+CREATE INDEX idx_eth_txes_unstarted_priority_evm_chain_id ON evm.txes(evm_chain_id, from_address, priority DESC) WHERE state = 'unstarted'::evm.txes_state;
+
+-- +goose Down
+DROP INDEX IF EXISTS evm.idx_eth_txes_unstarted_priority_evm_chain_id;
+ALTER TABLE evm.txes DROP COLUMN priority;
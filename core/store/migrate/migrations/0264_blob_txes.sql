@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE evm.txes ADD COLUMN blobs BYTEA[];
+ALTER TABLE evm.tx_attempts ADD COLUMN blob_fee_cap NUMERIC(78,0);
+ALTER TABLE evm.tx_attempts DROP CONSTRAINT chk_legacy_or_dynamic;
+ALTER TABLE evm.tx_attempts ADD CONSTRAINT chk_legacy_or_dynamic CHECK (
+	(tx_type = 0 AND gas_price IS NOT NULL AND gas_tip_cap IS NULL AND gas_fee_cap IS NULL AND blob_fee_cap IS NULL)
+	OR
+	(tx_type = 2 AND gas_price IS NULL AND gas_tip_cap IS NOT NULL AND gas_fee_cap IS NOT NULL AND blob_fee_cap IS NULL)
+	OR
+	(tx_type = 3 AND gas_price IS NULL AND gas_tip_cap IS NOT NULL AND gas_fee_cap IS NOT NULL AND blob_fee_cap IS NOT NULL)
+);
+
+-- +goose Down
+DELETE FROM evm.tx_attempts WHERE tx_type = 3;
+ALTER TABLE evm.tx_attempts DROP CONSTRAINT chk_legacy_or_dynamic;
+ALTER TABLE evm.tx_attempts ADD CONSTRAINT chk_legacy_or_dynamic CHECK (
+	(tx_type = 0 AND gas_price IS NOT NULL AND gas_tip_cap IS NULL AND gas_fee_cap IS NULL)
+	OR
+	(tx_type = 2 AND gas_price IS NULL AND gas_tip_cap IS NOT NULL AND gas_fee_cap IS NOT NULL)
+);
+ALTER TABLE evm.tx_attempts DROP COLUMN blob_fee_cap;
+ALTER TABLE evm.txes DROP COLUMN blobs;
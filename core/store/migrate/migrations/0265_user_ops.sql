@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE evm.user_ops (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    user_op_hash BYTEA NOT NULL,
+    entry_point BYTEA NOT NULL,
+    sender BYTEA NOT NULL,
+    nonce NUMERIC(78,0) NOT NULL,
+    to_address BYTEA NOT NULL,
+    user_op JSONB NOT NULL,
+    state TEXT NOT NULL,
+    tx_hash BYTEA,
+    block_number BIGINT,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_user_ops_state CHECK (state IN ('pending', 'included', 'failed')),
+    CONSTRAINT chk_user_ops_included CHECK (state <> 'included' OR (tx_hash IS NOT NULL AND block_number IS NOT NULL))
+);
+CREATE UNIQUE INDEX idx_user_ops_hash ON evm.user_ops (evm_chain_id, user_op_hash);
+CREATE INDEX idx_user_ops_pending ON evm.user_ops (evm_chain_id, entry_point, sender, nonce) WHERE state = 'pending';
+
+-- +goose Down
+DROP TABLE evm.user_ops;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE evm.reorgs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    depth BIGINT NOT NULL,
+    common_ancestor_number BIGINT NOT NULL,
+    common_ancestor_hash BYTEA NOT NULL,
+    old_hashes BYTEA[] NOT NULL,
+    new_hashes BYTEA[] NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT chk_reorgs_depth CHECK (depth > 0)
+);
+CREATE INDEX idx_reorgs_chain_detected_at ON evm.reorgs (evm_chain_id, detected_at DESC);
+
+-- +goose Down
+DROP TABLE evm.reorgs;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE user_api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_email text NOT NULL REFERENCES users (email) ON DELETE CASCADE,
+    name text NOT NULL,
+    role user_roles NOT NULL,
+    token_key text NOT NULL UNIQUE,
+    token_salt text NOT NULL,
+    token_hashed_secret text NOT NULL,
+    allowed_ips text[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT user_api_tokens_user_email_name_key UNIQUE (user_email, name),
+    CONSTRAINT chk_user_api_tokens_name CHECK (name <> '')
+);
+
+-- +goose Down
+DROP TABLE user_api_tokens;
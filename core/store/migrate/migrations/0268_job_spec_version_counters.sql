@@ -0,0 +1,12 @@
+-- +goose Up
+-- Numbers spec versions without racing concurrent updates of the same job.
+CREATE TABLE job_spec_version_counters (
+    job_id INT PRIMARY KEY,
+    last_version INT NOT NULL
+);
+
+INSERT INTO job_spec_version_counters (job_id, last_version)
+SELECT job_id, MAX(version) FROM job_spec_versions GROUP BY job_id;
+
+-- +goose Down
+DROP TABLE job_spec_version_counters;
@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE jobs
+    ADD COLUMN max_concurrent_runs BIGINT DEFAULT NULL,
+    ADD COLUMN max_runs_per_minute BIGINT DEFAULT NULL,
+    ADD COLUMN max_task_time_per_minute BIGINT DEFAULT NULL;
+-- +goose Down
+ALTER TABLE jobs
+    DROP COLUMN max_concurrent_runs,
+    DROP COLUMN max_runs_per_minute,
+    DROP COLUMN max_task_time_per_minute;
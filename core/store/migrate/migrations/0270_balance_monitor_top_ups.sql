@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE evm.balance_monitor_top_ups (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    eth_tx_id BIGINT NOT NULL,
+    from_address BYTEA NOT NULL,
+    to_address BYTEA NOT NULL,
+    balance NUMERIC(78,0) NOT NULL,
+    value NUMERIC(78,0) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_balance_monitor_top_ups_chain_created_at ON evm.balance_monitor_top_ups (evm_chain_id, created_at);
+
+-- +goose Down
+DROP TABLE evm.balance_monitor_top_ups;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- Preempted txes keep their nonce and attempts until it is known whether they or the tx that took over their nonce is mined.
+ALTER TABLE evm.txes ADD COLUMN preempted_by_tx_id BIGINT REFERENCES evm.txes(id) ON DELETE SET NULL;
+DROP INDEX evm.idx_eth_txes_nonce_from_address_per_evm_chain_id;
+CREATE UNIQUE INDEX idx_eth_txes_nonce_from_address_per_evm_chain_id ON evm.txes(evm_chain_id, from_address, nonce) WHERE preempted_by_tx_id IS NULL;
+CREATE INDEX idx_eth_txes_preempted_by_tx_id ON evm.txes(preempted_by_tx_id) WHERE preempted_by_tx_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX evm.idx_eth_txes_preempted_by_tx_id;
+DROP INDEX evm.idx_eth_txes_nonce_from_address_per_evm_chain_id;
+ALTER TABLE evm.txes DROP COLUMN preempted_by_tx_id;
+CREATE UNIQUE INDEX idx_eth_txes_nonce_from_address_per_evm_chain_id ON evm.txes(evm_chain_id, from_address, nonce);
@@ -0,0 +1,6 @@
+-- +goose Up
+-- The session key is needed to sign a replacement when a pending user operation is resubmitted with a higher fee.
+ALTER TABLE evm.user_ops ADD COLUMN session_key BYTEA;
+
+-- +goose Down
+ALTER TABLE evm.user_ops DROP COLUMN session_key;
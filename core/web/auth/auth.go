@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -42,6 +43,8 @@ type Authenticator interface {
 	FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error)
 	FindUser(ctx context.Context, email string) (clsessions.User, error)
 	FindUserByAPIToken(ctx context.Context, apiToken string) (clsessions.User, error)
+	FindAPIToken(ctx context.Context, tokenKey string) (clsessions.APIToken, error)
+	MarkAPITokenUsed(ctx context.Context, id int64) error
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -72,7 +75,7 @@ func AuthenticateBySession(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateBySession
 
-// AuthenticateByToken authenticates a User by their API token.
+// AuthenticateByToken authenticates a User by their API token, or by one of their named API tokens.
 //
 // Implements authMethod
 func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
@@ -91,6 +94,9 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 	// We need to first load the user row so we can compare tokens using the stored salt
 	user, err := authr.FindUserByAPIToken(ctx, token.AccessKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return authenticateByNamedToken(c, authr, token)
+	}
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, clsessions.ErrUserSessionExpired) {
 			return auth.ErrorAuthFailed
@@ -113,6 +119,46 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateByToken
 
+// authenticateByNamedToken authenticates a User by one of their named API tokens. The request is granted the role of
+// the token, or the role of the user if it has since been lowered below it.
+func authenticateByNamedToken(c *gin.Context, authr Authenticator, token *auth.Token) error {
+	ctx := c.Request.Context()
+	apiToken, err := authr.FindAPIToken(ctx, token.AccessKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, clsessions.ErrNotSupported) {
+			return auth.ErrorAuthFailed
+		}
+		return err
+	}
+
+	ok, err := clsessions.AuthenticateAPIToken(token, &apiToken)
+	if err != nil {
+		return err
+	}
+	if !ok || apiToken.Expired(time.Now()) || !apiToken.AllowsIP(c.ClientIP()) {
+		return auth.ErrorAuthFailed
+	}
+
+	user, err := authr.FindUser(ctx, apiToken.UserEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return auth.ErrorAuthFailed
+		}
+		return err
+	}
+	if user.Role.IsAtLeast(apiToken.Role) {
+		user.Role = apiToken.Role
+	}
+
+	if err = authr.MarkAPITokenUsed(ctx, apiToken.ID); err != nil {
+		return err
+	}
+
+	c.Set(SessionUserKey, &user)
+
+	return nil
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -2,11 +2,13 @@ package auth_test
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -86,6 +88,90 @@ func TestAuthenticateByToken_Success(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusOK), http.StatusText(w.Code))
 }
 
+type apiTokenFinder struct {
+	sessions.AuthenticationProvider
+	user     sessions.User
+	apiToken sessions.APIToken
+	used     int
+}
+
+func (a *apiTokenFinder) FindUser(ctx context.Context, email string) (sessions.User, error) {
+	return a.user, nil
+}
+
+func (a *apiTokenFinder) FindUserByAPIToken(ctx context.Context, token string) (sessions.User, error) {
+	return sessions.User{}, sql.ErrNoRows
+}
+
+func (a *apiTokenFinder) FindAPIToken(ctx context.Context, tokenKey string) (sessions.APIToken, error) {
+	if tokenKey != a.apiToken.TokenKey {
+		return sessions.APIToken{}, sql.ErrNoRows
+	}
+	return a.apiToken, nil
+}
+
+func (a *apiTokenFinder) MarkAPITokenUsed(ctx context.Context, id int64) error {
+	a.used++
+	return nil
+}
+
+func TestAuthenticateByToken_NamedToken(t *testing.T) {
+	user := cltest.MustRandomUser(t)
+	user.Role = sessions.UserRoleEdit
+	apiToken, token, err := sessions.NewAPIToken(user, sessions.CreateAPITokenRequest{
+		Name:       "ci",
+		Role:       sessions.UserRoleRun,
+		ExpiresAt:  time.Now().Add(time.Hour),
+		AllowedIPs: []string{"192.0.2.0/24"}, // httptest requests come from 192.0.2.1
+	}, time.Now())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		modify   func(a *apiTokenFinder, token *auth.Token)
+		wantRole sessions.UserRole
+	}{
+		{"valid", func(a *apiTokenFinder, token *auth.Token) {}, sessions.UserRoleRun},
+		{"user role lowered", func(a *apiTokenFinder, token *auth.Token) { a.user.Role = sessions.UserRoleView }, sessions.UserRoleView},
+		{"wrong secret", func(a *apiTokenFinder, token *auth.Token) { token.Secret = "wrong" }, ""},
+		{"unknown key", func(a *apiTokenFinder, token *auth.Token) { token.AccessKey = "unknown" }, ""},
+		{"expired", func(a *apiTokenFinder, token *auth.Token) { a.apiToken.ExpiresAt = time.Now() }, ""},
+		{"IP not allowed", func(a *apiTokenFinder, token *auth.Token) { a.apiToken.AllowedIPs = []string{"10.0.0.0/8"} }, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authr := &apiTokenFinder{user: user, apiToken: apiToken}
+			credentials := *token
+			tt.modify(authr, &credentials)
+
+			var role sessions.UserRole
+			router := gin.New()
+			router.Use(webauth.Authenticate(authr, webauth.AuthenticateByToken))
+			router.GET("/", func(c *gin.Context) {
+				u, ok := webauth.GetAuthenticatedUser(c)
+				require.True(t, ok)
+				role = u.Role
+				c.String(http.StatusOK, "")
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/", nil)
+			req.Header.Set(webauth.APIKey, credentials.AccessKey)
+			req.Header.Set(webauth.APISecret, credentials.Secret)
+			router.ServeHTTP(w, req)
+
+			if tt.wantRole == "" {
+				assert.Equal(t, http.StatusUnauthorized, w.Code)
+				assert.Zero(t, authr.used)
+				return
+			}
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.wantRole, role)
+			assert.Equal(t, 1, authr.used)
+		})
+	}
+}
+
 func TestAuthenticateByToken_AuthFailed(t *testing.T) {
 	authr := userFindFailer{err: auth.ErrorAuthFailed}
 
@@ -225,6 +311,9 @@ var routesRolesMap = [...]routeRules{
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
+	{"GET", "/v2/user/tokens", true, true, true},
+	{"POST", "/v2/user/tokens", true, true, true},
+	{"DELETE", "/v2/user/tokens/MOCK", true, true, true},
 	{"GET", "/v2/enroll_webauthn", true, true, true},
 	{"POST", "/v2/enroll_webauthn", true, true, true},
 	{"GET", "/v2/external_initiators", true, true, true},
@@ -245,9 +334,11 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/tx_attempts/evm", true, true, true},
 	{"GET", "/v2/transactions/evm", true, true, true},
 	{"GET", "/v2/transactions/evm/MOCK", true, true, true},
+	{"POST", "/v2/transactions/evm/heal", false, false, false},
 	{"GET", "/v2/transactions", true, true, true},
 	{"GET", "/v2/transactions/MOCK", true, true, true},
 	{"POST", "/v2/replay_from_block/MOCK", false, true, true},
+	{"GET", "/v2/replay_progress", true, true, true},
 	{"GET", "/v2/keys/csa", true, true, true},
 	{"POST", "/v2/keys/csa", false, false, true},
 	{"POST", "/v2/keys/csa/import", false, false, false},
@@ -257,6 +348,8 @@ var routesRolesMap = [...]routeRules{
 	{"DELETE", "/v2/keys/eth/MOCK", false, false, false},
 	{"POST", "/v2/keys/eth/import", false, false, false},
 	{"POST", "/v2/keys/eth/export/MOCK", false, false, false},
+	{"POST", "/v2/keys/backup/export", false, false, false},
+	{"POST", "/v2/keys/backup/export-shares", false, false, false},
 	{"GET", "/v2/keys/ocr", true, true, true},
 	{"POST", "/v2/keys/ocr", false, false, true},
 	{"DELETE", "/v2/keys/ocr/:MOCKkeyID", false, false, false},
@@ -312,6 +405,7 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/chains/solana", true, true, true},
 	{"GET", "/v2/chains/cosmos", true, true, true},
 	{"GET", "/v2/chains/evm/MOCK", true, true, true},
+	{"GET", "/v2/chains/evm/MOCK/reorgs", true, true, true},
 	{"GET", "/v2/chains/cosmos/MOCK", true, true, true},
 	{"GET", "/v2/nodes/", true, true, true},
 	{"GET", "/v2/nodes/evm", true, true, true},
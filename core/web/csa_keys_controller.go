@@ -10,6 +10,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -30,14 +32,22 @@ func (ctrl *CSAKeysController) Index(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewCSAKeyResources(keys), "csaKeys")
 }
 
-// Create and return a CSA key
+// Create and return a CSA key. If signerKeyID is set, the key held by the remote signer under that ID is added instead
+// of generating one.
 // Example:
 // "POST <application>/keys/csa"
+// "POST <application>/keys/csa?signerKeyID=my-key"
 func (ctrl *CSAKeysController) Create(c *gin.Context) {
 	ctx := c.Request.Context()
-	key, err := ctrl.App.GetKeyStore().CSA().Create(ctx)
+	var key csakey.KeyV2
+	var err error
+	if signerKeyID := c.Query("signerKeyID"); signerKeyID != "" {
+		key, err = ctrl.App.GetKeyStore().CSA().AddRemote(ctx, signerKeyID)
+	} else {
+		key, err = ctrl.App.GetKeyStore().CSA().Create(ctx)
+	}
 	if err != nil {
-		if errors.Is(err, keystore.ErrCSAKeyExists) {
+		if errors.Is(err, keystore.ErrCSAKeyExists) || errors.Is(err, signer.ErrNotConfigured) || errors.Is(err, signer.ErrKeyNotFound) {
 			jsonAPIError(c, http.StatusBadRequest, err)
 			return
 		}
@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -117,10 +118,12 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, resources, "keys", http.StatusOK)
 }
 
-// Create adds a new account
+// Create adds a new account. If signerKeyID is set, the key held by the remote signer under that ID is added instead
+// of generating one.
 // Example:
 //
 //	"<application>/keys/eth"
+//	"<application>/keys/eth?signerKeyID=my-key"
 func (ekc *ETHKeysController) Create(c *gin.Context) {
 	ethKeyStore := ekc.app.GetKeyStore().Eth()
 
@@ -135,7 +138,21 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	key, err := ethKeyStore.Create(c.Request.Context(), chain.ID())
+	var key ethkey.KeyV2
+	var err error
+	if signerKeyID := c.Query("signerKeyID"); signerKeyID != "" {
+		key, err = ethKeyStore.AddRemote(c.Request.Context(), signerKeyID, chain.ID())
+		switch {
+		case errors.Is(err, signer.ErrNotConfigured), errors.Is(err, signer.ErrKeyNotFound):
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		case errors.Is(err, keystore.ErrKeyExists):
+			jsonAPIError(c, http.StatusConflict, err)
+			return
+		}
+	} else {
+		key, err = ethKeyStore.Create(c.Request.Context(), chain.ID())
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
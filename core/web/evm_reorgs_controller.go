@@ -0,0 +1,43 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// EVMReorgsController exposes the history of reorgs detected on EVM chains.
+type EVMReorgsController struct {
+	App chainlink.Application
+}
+
+// Index lists the reorgs detected on an EVM chain, most recent first.
+// Example:
+// "GET <application>/chains/evm/:ID/reorgs"
+func (rc *EVMReorgsController) Index(c *gin.Context, size, page, offset int) {
+	chainID, ok := new(big.Int).SetString(c.Param("ID"), 10)
+	if !ok {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid chain id: %q", c.Param("ID")))
+		return
+	}
+
+	orm := headtracker.NewReorgORM(*chainID, rc.App.GetDB())
+	reorgs, count, err := orm.FindReorgs(c.Request.Context(), offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.EVMReorgResource{}
+	for _, reorg := range reorgs {
+		resources = append(resources, presenters.NewEVMReorgResource(reorg))
+	}
+
+	paginatedResponse(c, "reorg", size, page, resources, count, err)
+}
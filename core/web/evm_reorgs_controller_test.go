@@ -0,0 +1,75 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func Test_EVMReorgsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	chainID := testutils.NewRandomEVMChainID()
+	orm := headtracker.NewReorgORM(*chainID, app.GetDB())
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, orm.SaveReorg(ctx, httypes.Reorg{
+			Depth:                i,
+			CommonAncestorNumber: 100 * i,
+			CommonAncestorHash:   utils.NewHash(),
+			OldHashes:            newHashes(int(i)),
+			NewHashes:            newHashes(int(i) + 1),
+			DetectedAt:           time.Now().Add(time.Duration(i) * time.Second),
+		}))
+	}
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/chains/evm/%s/reorgs?size=2", chainID))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var links jsonapi.Links
+	var resources []presenters.EVMReorgResource
+	err := web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &resources, &links)
+	require.NoError(t, err)
+	assert.NotEmpty(t, links["next"].Href)
+	require.Len(t, resources, 2)
+
+	// most recent first
+	assert.Equal(t, int64(3), resources[0].Depth)
+	assert.Equal(t, int64(300), resources[0].CommonAncestorNumber)
+	assert.Equal(t, int64(303), resources[0].OldHeadNumber)
+	assert.Equal(t, int64(304), resources[0].NewHeadNumber)
+	assert.Len(t, resources[0].OldHashes, 3)
+	assert.Len(t, resources[0].NewHashes, 4)
+	assert.Equal(t, int64(2), resources[1].Depth)
+
+	resp, cleanup = client.Get("/v2/chains/evm/not-a-chain/reorgs")
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func newHashes(n int) []common.Hash {
+	hashes := make([]common.Hash, n)
+	for i := range hashes {
+		hashes[i] = utils.NewHash()
+	}
+	return hashes
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"net/http"
 
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -47,3 +49,48 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Heal fills any nonce gaps for the given address by sending transactions to self.
+// Example:
+//
+//	"<application>/transactions/evm/heal?address=0x...&evmChainID=1"
+func (tc *TransactionsController) Heal(c *gin.Context) {
+	addressStr := c.Query("address")
+	if !common.IsHexAddress(addressStr) {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("invalid address: %s, must be hex address", addressStr))
+		return
+	}
+	address := common.HexToAddress(addressStr)
+
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		} else if errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	heals, err := chain.TxManager().HealSequenceGaps(c.Request.Context(), address)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chainID := ubig.New(chain.ID())
+	resources := make([]presenters.NonceGapHealResource, len(heals))
+	for i, heal := range heals {
+		resources[i] = presenters.NewNonceGapHealResource(heal, chainID)
+		tc.App.GetAuditLogger().Audit(audit.EthNonceGapHealed, map[string]interface{}{
+			"fromAddress": heal.FromAddress,
+			"nonce":       heal.Sequence,
+			"txHash":      heal.TxHash,
+			"evmChainID":  chainID.String(),
+		})
+	}
+	jsonAPIResponse(c, resources, "evm_nonce_gap_heals")
+}
@@ -0,0 +1,197 @@
+package web
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// JobTemplatesController manages job templates, and the jobs rendered from them.
+type JobTemplatesController struct {
+	App chainlink.Application
+}
+
+// Index lists all job templates.
+// Example:
+// "GET <application>/job_templates"
+func (tc *JobTemplatesController) Index(c *gin.Context) {
+	ctx := c.Request.Context()
+	orm := job.NewTemplateORM(tc.App.GetDB())
+	tmpls, err := orm.FindTemplates(ctx)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	resources := []presenters.JobTemplateResource{}
+	for _, jt := range tmpls {
+		jobs, err := orm.FindTemplateJobs(ctx, jt.ID)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		resources = append(resources, *presenters.NewJobTemplateResource(jt, jobs))
+	}
+	jsonAPIResponse(c, resources, "job_templates")
+}
+
+// Show returns a job template and its jobs.
+// Example:
+// "GET <application>/job_templates/:ID"
+func (tc *JobTemplatesController) Show(c *gin.Context) {
+	id, err := parseJobTemplateID(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	ctx := c.Request.Context()
+	orm := job.NewTemplateORM(tc.App.GetDB())
+	jt, err := orm.FindTemplate(ctx, id)
+	if err != nil {
+		jobTemplateError(c, err)
+		return
+	}
+	jobs, err := orm.FindTemplateJobs(ctx, id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewJobTemplateResource(jt, jobs), "job_templates")
+}
+
+// CreateJobTemplateRequest is a request to save a job template and create one job per parameter set.
+type CreateJobTemplateRequest struct {
+	Name          string           `json:"name"`
+	Template      string           `json:"template"`
+	ParameterSets []job.JSONConfig `json:"parameterSets"`
+}
+
+// Create saves a job template, and validates, saves and starts one job per parameter set.
+// Example:
+// "POST <application>/job_templates"
+func (tc *JobTemplatesController) Create(c *gin.Context) {
+	request := CreateJobTemplateRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Name == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("name is required"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+	jt, jobs, err := tc.manager().CreateTemplate(ctx, request.Name, request.Template, request.ParameterSets)
+	if err != nil {
+		jobTemplateError(c, err)
+		return
+	}
+
+	tjs, err := job.NewTemplateORM(tc.App.GetDB()).FindTemplateJobs(c.Request.Context(), jt.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.JobTemplateCreated, map[string]interface{}{"id": jt.ID, "name": jt.Name, "jobIDs": jobIDs(jobs)})
+	jsonAPIResponseWithStatus(c, presenters.NewJobTemplateResource(jt, tjs), "job_templates", http.StatusCreated)
+}
+
+// UpdateJobTemplateRequest is a request to replace a job template, and optionally its parameter sets.
+type UpdateJobTemplateRequest struct {
+	Template string `json:"template"`
+	// ParameterSets replace the parameter sets of the existing jobs if set.
+	ParameterSets []job.JSONConfig `json:"parameterSets"`
+}
+
+// Update replaces a job template, and re-renders and replaces all of its jobs.
+// Example:
+// "PUT <application>/job_templates/:ID"
+func (tc *JobTemplatesController) Update(c *gin.Context) {
+	id, err := parseJobTemplateID(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	request := UpdateJobTemplateRequest{}
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+	jt, jobs, err := tc.manager().UpdateTemplate(ctx, id, request.Template, request.ParameterSets)
+	if err != nil {
+		jobTemplateError(c, err)
+		return
+	}
+	tjs, err := job.NewTemplateORM(tc.App.GetDB()).FindTemplateJobs(c.Request.Context(), id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.JobTemplateUpdated, map[string]interface{}{"id": jt.ID, "name": jt.Name, "jobIDs": jobIDs(jobs)})
+	jsonAPIResponse(c, presenters.NewJobTemplateResource(jt, tjs), "job_templates")
+}
+
+// Delete deletes a job template. Its jobs are kept.
+// Example:
+// "DELETE <application>/job_templates/:ID"
+func (tc *JobTemplatesController) Delete(c *gin.Context) {
+	id, err := parseJobTemplateID(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err = job.NewTemplateORM(tc.App.GetDB()).DeleteTemplate(c.Request.Context(), id); err != nil {
+		jobTemplateError(c, err)
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.JobTemplateDeleted, map[string]interface{}{"id": id})
+	jsonAPIResponseWithStatus(c, nil, "job_templates", http.StatusNoContent)
+}
+
+func (tc *JobTemplatesController) manager() *job.TemplateManager {
+	jc := JobsController{App: tc.App}
+	return job.NewTemplateManager(tc.App.GetDB(), tc.App.JobSpawner(), func(ctx context.Context, toml string) (job.Job, error) {
+		jb, _, err := jc.validateJobSpec(ctx, toml)
+		return jb, err
+	})
+}
+
+func parseJobTemplateID(c *gin.Context) (int32, error) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 32)
+	return int32(id), err
+}
+
+func jobTemplateError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		jsonAPIError(c, http.StatusNotFound, errors.New("job template not found"))
+	case errors.Is(err, job.ErrInvalidJobTemplate):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+	}
+}
+
+func jobIDs(jobs []job.Job) []int32 {
+	ids := make([]int32, len(jobs))
+	for i, jb := range jobs {
+		ids[i] = jb.ID
+	}
+	return ids
+}
@@ -0,0 +1,119 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+const webhookJobTemplate = `
+type            = "webhook"
+schemaVersion   = 1
+name            = "{{ .name }}"
+observationSource   = """
+    fetch          [type=bridge name="{{ .bridge }}"]
+    parse_request  [type=jsonparse path="data,result"];
+    multiply       [type=multiply times="{{ .times }}"];
+
+    fetch -> parse_request -> multiply;
+"""
+`
+
+func TestJobTemplatesController_CreateUpdateDelete(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, bridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	client := app.NewHTTPClient(nil)
+
+	paramSets := []job.JSONConfig{
+		{"name": "feed-a", "bridge": bridge.Name.String(), "times": 100},
+		{"name": "feed-b", "bridge": bridge.Name.String(), "times": 1000},
+	}
+	body, err := json.Marshal(web.CreateJobTemplateRequest{Name: "feeds", Template: webhookJobTemplate, ParameterSets: paramSets})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/job_templates", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	var created presenters.JobTemplateResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &created))
+	assert.Equal(t, "feeds", created.Name)
+	require.Len(t, created.Jobs, 2)
+	for i, tj := range created.Jobs {
+		jb, err2 := app.JobORM().FindJob(ctx, tj.JobID)
+		require.NoError(t, err2)
+		assert.Equal(t, paramSets[i]["name"], jb.Name.String)
+		assert.Contains(t, jb.PipelineSpec.DotDagSource, fmt.Sprintf(`times="%v"`, paramSets[i]["times"]))
+	}
+
+	t.Run("invalid parameter sets create no jobs", func(t *testing.T) {
+		body, err := json.Marshal(web.CreateJobTemplateRequest{Name: "broken", Template: webhookJobTemplate, ParameterSets: []job.JSONConfig{
+			{"name": "feed-c", "bridge": bridge.Name.String(), "times": 100},
+			{"name": "feed-d", "bridge": bridge.Name.String()},
+		}})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/job_templates", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+		jobs, _, err := app.JobORM().FindJobs(ctx, 0, 100)
+		require.NoError(t, err)
+		assert.Len(t, jobs, 2)
+	})
+
+	t.Run("updating the template replaces its jobs", func(t *testing.T) {
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/pause", created.Jobs[0].JobID), nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		body, err := json.Marshal(web.UpdateJobTemplateRequest{Template: webhookJobTemplate + "# updated\n"})
+		require.NoError(t, err)
+		resp, cleanup = client.Put("/v2/job_templates/"+created.ID, bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var updated presenters.JobTemplateResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &updated))
+		require.Len(t, updated.Jobs, 2)
+		for i, tj := range updated.Jobs {
+			assert.NotEqual(t, created.Jobs[i].JobID, tj.JobID)
+			assert.Equal(t, paramSets[i]["name"], tj.Params["name"])
+			_, err = app.JobORM().FindJob(ctx, created.Jobs[i].JobID)
+			require.Error(t, err)
+		}
+
+		// the paused job stays paused, and its services are not started
+		assert.True(t, updated.Jobs[0].Paused)
+		assert.False(t, updated.Jobs[1].Paused)
+		active := app.JobSpawner().ActiveJobs()
+		assert.NotContains(t, active, updated.Jobs[0].JobID)
+		assert.Contains(t, active, updated.Jobs[1].JobID)
+	})
+
+	t.Run("deleting the template keeps its jobs", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/job_templates/" + created.ID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+		jobs, _, err := app.JobORM().FindJobs(ctx, 0, 100)
+		require.NoError(t, err)
+		assert.Len(t, jobs, 2)
+
+		resp, cleanup = client.Get("/v2/job_templates/" + created.ID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
@@ -0,0 +1,150 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// JobVersionsController manages the spec versions of locally managed jobs.
+type JobVersionsController struct {
+	App chainlink.Application
+}
+
+// Index lists the spec versions of a job.
+// Example:
+// "GET <application>/jobs/:ID/versions"
+func (vc *JobVersionsController) Index(c *gin.Context) {
+	jobID, err := parseJobID(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	versions, err := job.NewVersionORM(vc.App.GetDB()).FindVersions(c.Request.Context(), jobID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	resources := []presenters.JobSpecVersionResource{}
+	for _, v := range versions {
+		resources = append(resources, *presenters.NewJobSpecVersionResource(v))
+	}
+	jsonAPIResponse(c, resources, "job_spec_versions")
+}
+
+// Diff compares the specs of two versions of a job. The from and to query parameters default to the previous and the
+// latest version.
+// Example:
+// "GET <application>/jobs/:ID/versions/diff?from=1&to=2"
+func (vc *JobVersionsController) Diff(c *gin.Context) {
+	jobID, err := parseJobID(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	ctx := c.Request.Context()
+	orm := job.NewVersionORM(vc.App.GetDB())
+
+	var to job.SpecVersion
+	if s := c.Query("to"); s != "" {
+		version, perr := parseVersion(s)
+		if perr != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(perr, "invalid to version"))
+			return
+		}
+		to, err = orm.FindVersion(ctx, jobID, version)
+	} else {
+		to, err = orm.FindLatestVersion(ctx, jobID)
+	}
+	if err != nil {
+		jobVersionError(c, err)
+		return
+	}
+
+	fromVersion := to.Version - 1
+	if s := c.Query("from"); s != "" {
+		if fromVersion, err = parseVersion(s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid from version"))
+			return
+		}
+	}
+	var from job.SpecVersion
+	// the first version is compared to an empty spec
+	if fromVersion > 0 {
+		if from, err = orm.FindVersion(ctx, jobID, fromVersion); err != nil {
+			jobVersionError(c, err)
+			return
+		}
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecVersionDiffResource(jobID, from.Version, to.Version, job.DiffSpecVersions(from, to)), "job_spec_version_diffs")
+}
+
+// RollbackJobRequest is a request to roll a job back to one of its spec versions.
+type RollbackJobRequest struct {
+	Version int32 `json:"version"`
+}
+
+// Rollback replaces a job with the spec of one of its versions, which is saved as a new version.
+// Example:
+// "POST <application>/jobs/:ID/rollback"
+func (vc *JobVersionsController) Rollback(c *gin.Context) {
+	jobID, err := parseJobID(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	request := RollbackJobRequest{}
+	if err = c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	v, err := job.NewVersionORM(vc.App.GetDB()).FindVersion(c.Request.Context(), jobID, request.Version)
+	if err != nil {
+		jobVersionError(c, err)
+		return
+	}
+
+	jc := JobsController{App: vc.App}
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), v.Spec)
+	if err != nil {
+		jsonAPIError(c, status, errors.Wrapf(err, "version %d is no longer valid", v.Version))
+		return
+	}
+	jb.ID = jobID
+	jb.SpecVersion = jc.newSpecVersion(c, v.Spec)
+	if !jc.replaceJob(c, &jb) {
+		return
+	}
+
+	vc.App.GetAuditLogger().Audit(audit.JobRolledBack, map[string]interface{}{"id": jobID, "version": v.Version})
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+func parseJobID(c *gin.Context) (int32, error) {
+	var jb job.Job
+	err := jb.SetID(c.Param("ID"))
+	return jb.ID, err
+}
+
+func parseVersion(s string) (int32, error) {
+	version, err := strconv.ParseInt(s, 10, 32)
+	return int32(version), err
+}
+
+func jobVersionError(c *gin.Context, err error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job spec version not found"))
+		return
+	}
+	jsonAPIError(c, http.StatusInternalServerError, err)
+}
@@ -0,0 +1,96 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestJobVersionsController_DiffAndRollback(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	client := app.NewHTTPClient(nil)
+
+	externalJobID := uuid.New()
+	v1 := testspecs.GetWebhookSpecNoBody(externalJobID, fetchBridge.Name.String(), submitBridge.Name.String())
+	body, err := json.Marshal(web.CreateJobRequest{TOML: v1})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var created presenters.JobResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &created))
+
+	v2 := testspecs.GetWebhookSpecNoBody(externalJobID, submitBridge.Name.String(), fetchBridge.Name.String())
+	body, err = json.Marshal(web.UpdateJobRequest{TOML: v2})
+	require.NoError(t, err)
+	resp, cleanup = client.Put("/v2/jobs/"+created.ID, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = client.Get("/v2/jobs/" + created.ID + "/versions")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var versions []presenters.JobSpecVersionResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &versions))
+	require.Len(t, versions, 2)
+	assert.Equal(t, int32(1), versions[0].Version)
+	assert.Equal(t, v1, versions[0].Spec)
+	assert.Equal(t, int32(2), versions[1].Version)
+	assert.Equal(t, v2, versions[1].Spec)
+	assert.Equal(t, cltest.APIEmailAdmin, versions[1].Author)
+
+	resp, cleanup = client.Get("/v2/jobs/" + created.ID + "/versions/diff")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var d presenters.JobSpecVersionDiffResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &d))
+	assert.Equal(t, int32(1), d.From)
+	assert.Equal(t, int32(2), d.To)
+	assert.Contains(t, d.Diff, fmt.Sprintf(`-    fetch          [type=bridge name="%s"]`, fetchBridge.Name.String()))
+
+	t.Run("rollback to a missing version", func(t *testing.T) {
+		body, err := json.Marshal(web.RollbackJobRequest{Version: 5})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/jobs/"+created.ID+"/rollback", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("rollback saves a new version", func(t *testing.T) {
+		body, err := json.Marshal(web.RollbackJobRequest{Version: 1})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/jobs/"+created.ID+"/rollback", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var rolledBack presenters.JobResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &rolledBack))
+		assert.Equal(t, created.ID, rolledBack.ID)
+		assert.Equal(t, created.PipelineSpec.DotDAGSource, rolledBack.PipelineSpec.DotDAGSource)
+
+		resp, cleanup = client.Get("/v2/jobs/" + created.ID + "/versions")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var versions []presenters.JobSpecVersionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &versions))
+		require.Len(t, versions, 3)
+		assert.Equal(t, v1, versions[2].Spec)
+	})
+}
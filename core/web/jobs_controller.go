@@ -32,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -113,6 +114,8 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
+	jb.SpecVersion = jc.newSpecVersion(c, request.TOML)
+
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 	err = jc.App.AddJobV2(ctx, &jb)
@@ -161,6 +164,55 @@ func (jc *JobsController) Delete(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
 
+// Pause stops the services of a job without deleting it, until it is resumed.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	jc.setPaused(c, true)
+}
+
+// Resume starts the services of a paused job.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	jc.setPaused(c, false)
+}
+
+func (jc *JobsController) setPaused(c *gin.Context, paused bool) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	event := audit.JobPaused
+	if paused {
+		err = jc.App.PauseJob(ctx, j.ID)
+	} else {
+		event = audit.JobResumed
+		err = jc.App.ResumeJob(ctx, j.ID)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	j, err = jc.App.JobORM().FindJob(ctx, j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.App.GetAuditLogger().Audit(event, map[string]interface{}{"id": j.ID})
+	jsonAPIResponse(c, presenters.NewJobResource(j), "jobs")
+}
+
 // UpdateJobRequest represents a request to update a job with new toml and start a job (V2).
 type UpdateJobRequest struct {
 	TOML string `json:"toml"`
@@ -188,32 +240,62 @@ func (jc *JobsController) Update(c *gin.Context) {
 		return
 	}
 
+	jb.SpecVersion = jc.newSpecVersion(c, request.TOML)
+	if !jc.replaceJob(c, &jb) {
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// replaceJob stops and deletes the existing job with the ID of jb, then saves and starts jb. A paused job stays
+// paused, so its services are not started. It responds with an error and returns false if the job could not be
+// replaced.
+func (jc *JobsController) replaceJob(c *gin.Context, jb *job.Job) bool {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
+	existing, err := jc.App.JobORM().FindJobWithoutSpecErrors(ctx, jb.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
+		return false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return false
+	}
+	jb.Paused = existing.Paused
+
 	// If the provided job id is not matching any job, delete will fail with 404 leaving state unchanged.
 	err = jc.App.DeleteJob(ctx, jb.ID)
 	// Error can be either come from ORM or from the activeJobs map.
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || strings.Contains(err.Error(), "job not found") {
 			jsonAPIError(c, http.StatusNotFound, errors.Wrap(err, "failed to update job"))
-			return
+			return false
 		}
 		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+		return false
 	}
 
-	err = jc.App.AddJobV2(ctx, &jb)
+	err = jc.App.AddJobV2(ctx, jb)
 	if err != nil {
 		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) || errors.Is(errors.Cause(err), job.ErrNoSuchSendingKey) {
 			jsonAPIError(c, http.StatusBadRequest, err)
-			return
+			return false
 		}
 		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+		return false
 	}
+	return true
+}
 
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+// newSpecVersion returns the spec version saved along with a created or updated job, authored by the session user.
+func (jc *JobsController) newSpecVersion(c *gin.Context, toml string) *job.SpecVersion {
+	v := &job.SpecVersion{Spec: toml}
+	if user, ok := auth.GetAuthenticatedUser(c); ok {
+		v.Author = user.Email
+	}
+	return v
 }
 
 func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, statusCode int, err error) {
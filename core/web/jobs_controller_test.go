@@ -859,3 +859,32 @@ func setupJobSpecsControllerTestsWithJobs(t *testing.T) (*cltest.TestApplication
 
 	return app, client, jb, jb.ID, erejb, erejb.ID
 }
+
+func TestJobsController_PauseResume(t *testing.T) {
+	ctx := testutils.Context(t)
+	app, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+	id := fmt.Sprintf("%d", jobID)
+
+	response, cleanup := client.Post("/v2/jobs/"+id+"/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	resource := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.True(t, resource.Paused)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post("/v2/jobs/"+id+"/resume", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+	assert.False(t, resource.Paused)
+	assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	jb, err := app.JobORM().FindJob(ctx, jobID)
+	require.NoError(t, err)
+	assert.False(t, jb.Paused)
+
+	response, cleanup = client.Post("/v2/jobs/999999/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
@@ -0,0 +1,72 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeystoreBackupController exports backups of the whole keystore. Backups are only restored offline, with the
+// "node restore-keystore-backup" command, since they are restored into an empty keystore and a running node always
+// has a CSA key.
+type KeystoreBackupController struct {
+	App chainlink.Application
+}
+
+// Export returns every key, and the Eth key states, encrypted with newpassword
+// Example:
+// "POST <application>/keys/backup/export?newpassword=secret"
+func (kbc *KeystoreBackupController) Export(c *gin.Context) {
+	defer kbc.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Export request body")
+
+	backup, err := kbc.App.GetKeyStore().ExportBackup(c.Request.Context(), c.Query("newpassword"))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	kbc.App.GetAuditLogger().Audit(audit.KeystoreBackupExported, map[string]interface{}{})
+	c.Data(http.StatusOK, MediaType, backup)
+}
+
+// ExportKeystoreBackupSharesRequest defines the request to export a keystore backup split into shares, one per
+// password, each encrypted with its password.
+type ExportKeystoreBackupSharesRequest struct {
+	Passwords []string `json:"passwords"`
+	Threshold int      `json:"threshold"`
+}
+
+// ExportShares returns every key, and the Eth key states, encrypted with a secret split into one share per holder
+// password, any threshold of which can restore the backup. Each share is encrypted with the password of its holder.
+// Example:
+// "POST <application>/keys/backup/export-shares"
+func (kbc *KeystoreBackupController) ExportShares(c *gin.Context) {
+	defer kbc.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing ExportShares request body")
+
+	var request ExportKeystoreBackupSharesRequest
+	if err := json.NewDecoder(c.Request.Body).Decode(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	backup, shares, err := kbc.App.GetKeyStore().ExportBackupShares(c.Request.Context(), request.Passwords, request.Threshold)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	export := presenters.KeystoreBackupShares{Backup: backup}
+	for _, share := range shares {
+		export.Shares = append(export.Shares, share)
+	}
+	kbc.App.GetAuditLogger().Audit(audit.KeystoreBackupExported, map[string]interface{}{
+		"shares":    len(shares),
+		"threshold": request.Threshold,
+	})
+	c.JSON(http.StatusOK, export)
+}
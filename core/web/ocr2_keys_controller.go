@@ -10,6 +10,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ocr2key"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/signer"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -30,16 +32,38 @@ func (ocr2kc *OCR2KeysController) Index(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewOCR2KeysBundleResources(ekbs), "offChainReporting2KeyBundle")
 }
 
-// Create and return an OCR2 key bundle
+// Create and return an OCR2 key bundle. If the signer key IDs are set, an EVM bundle made of keys held by the remote
+// signer is added instead of generating one.
 // Example:
 // "POST <application>/keys/ocr"
+// "POST <application>/keys/ocr/evm?onchainSigningKeyID=a&offchainSigningKeyID=b&configEncryptionKeyID=c"
 func (ocr2kc *OCR2KeysController) Create(c *gin.Context) {
 	ctx := c.Request.Context()
 	chainType := chaintype.ChainType(c.Param("chainType"))
-	key, err := ocr2kc.App.GetKeyStore().OCR2().Create(ctx, chainType)
-	if errors.Is(errors.Cause(err), chaintype.ErrInvalidChainType) {
-		jsonAPIError(c, http.StatusBadRequest, err)
-		return
+	onchainID, offchainID, encryptionID := c.Query("onchainSigningKeyID"), c.Query("offchainSigningKeyID"), c.Query("configEncryptionKeyID")
+
+	var key ocr2key.KeyBundle
+	var err error
+	if onchainID != "" || offchainID != "" || encryptionID != "" {
+		if chainType != chaintype.EVM {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("remote signer keys are only supported for chain type %s", chaintype.EVM))
+			return
+		}
+		if onchainID == "" || offchainID == "" || encryptionID == "" {
+			jsonAPIError(c, http.StatusBadRequest, errors.New("onchainSigningKeyID, offchainSigningKeyID and configEncryptionKeyID must all be set"))
+			return
+		}
+		key, err = ocr2kc.App.GetKeyStore().OCR2().AddRemote(ctx, onchainID, offchainID, encryptionID)
+		if errors.Is(err, signer.ErrNotConfigured) || errors.Is(err, signer.ErrKeyNotFound) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+	} else {
+		key, err = ocr2kc.App.GetKeyStore().OCR2().Create(ctx, chainType)
+		if errors.Is(errors.Cause(err), chaintype.ErrInvalidChainType) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
 	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -155,6 +156,121 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// SimulatePipelineRunRequest represents a request to simulate a pipeline run with stubbed task responses.
+// TOML is only used when simulating a job that has not been created yet.
+type SimulatePipelineRunRequest struct {
+	TOML  string                   `json:"toml"`
+	Vars  map[string]interface{}   `json:"vars"`
+	Stubs pipeline.SimulationStubs `json:"stubs"`
+}
+
+// Simulate executes a job's pipeline in-memory, with http, bridge, ethcall and ethtx tasks returning the
+// stubbed responses from the request. The run is not persisted.
+// Example:
+// "POST <application>/jobs/:ID/simulate"
+// "POST <application>/pipeline/simulate"
+func (prc *PipelineRunsController) Simulate(c *gin.Context) {
+	ctx := c.Request.Context()
+	request := SimulatePipelineRunRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var jb job.Job
+	if idStr := c.Param("ID"); idStr != "" {
+		if err := jb.SetID(idStr); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		var err error
+		jb, err = prc.App.JobORM().FindJob(ctx, jb.ID)
+		if err != nil {
+			if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+				jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+			} else {
+				jsonAPIError(c, http.StatusInternalServerError, err)
+			}
+			return
+		}
+	} else {
+		jc := JobsController{App: prc.App}
+		var status int
+		var err error
+		jb, status, err = jc.validateJobSpec(ctx, request.TOML)
+		if err != nil {
+			jsonAPIError(c, status, err)
+			return
+		}
+	}
+
+	run, err := prc.App.SimulateJobV2(ctx, jb, request.Vars, request.Stubs)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunResource(*run, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
+// ReplayPipelineRunRequest represents a request to replay a pipeline run. DotDagSource replaces the DAG of the
+// original run if set.
+type ReplayPipelineRunRequest struct {
+	DotDagSource string `json:"dotDagSource"`
+}
+
+// Replay reruns a finished pipeline run in-memory with its original inputs and returns the differences from the
+// original run. Tasks that are unchanged reuse their stored outputs. The replay is not persisted.
+// Example:
+// "POST <application>/jobs/:ID/runs/:runID/replay"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	ctx := c.Request.Context()
+	jobSpec := job.Job{}
+	err := jobSpec.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	pipelineRun := pipeline.Run{}
+	err = pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Only replay runs of the job in the URL.
+	pipelineRun, err = prc.App.PipelineORM().FindRun(ctx, pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && pipelineRun.PipelineSpec.JobID != jobSpec.ID) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	request := ReplayPipelineRunRequest{}
+	if c.Request.ContentLength != 0 {
+		if err = c.ShouldBindJSON(&request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	replay, err := prc.App.ReplayPipelineRun(ctx, pipelineRun.ID, request.DotDagSource)
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		} else {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		}
+		return
+	}
+
+	res := presenters.NewPipelineRunReplayResource(*replay, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRunReplay")
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
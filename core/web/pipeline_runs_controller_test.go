@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -250,6 +252,132 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Simulate_HappyPath(t *testing.T) {
+	client, jobID, _ := setupPipelineRunsControllerTests(t)
+
+	t.Run("existing job", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/"+fmt.Sprintf("%v", jobID)+"/simulate", strings.NewReader(`{}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunResource
+		err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+		require.NoError(t, err)
+		require.Len(t, parsedResponse.TaskRuns, 8)
+		require.Len(t, parsedResponse.Outputs, 1)
+		assert.Equal(t, "3", *parsedResponse.Outputs[0])
+
+		// the simulated run is not persisted
+		response, cleanup = client.Get("/v2/jobs/" + fmt.Sprintf("%v", jobID) + "/runs")
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var runs []presenters.PipelineRunResource
+		err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &runs)
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+	})
+
+	t.Run("job spec with stubbed http task", func(t *testing.T) {
+		request, err := json.Marshal(web.SimulatePipelineRunRequest{
+			TOML: `
+type          = "webhook"
+schemaVersion = 1
+observationSource = """
+	ds    [type=http method=GET url="https://chain.link/price"];
+	parse [type=jsonparse path="USD"];
+	ds -> parse;
+"""
+`,
+			Stubs: map[string]pipeline.TaskStub{"ds": {Value: json.RawMessage(`"{\"USD\": 42}"`)}},
+		})
+		require.NoError(t, err)
+
+		response, cleanup := client.Post("/v2/pipeline/simulate", strings.NewReader(string(request)))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunResource
+		err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+		require.NoError(t, err)
+		require.Len(t, parsedResponse.TaskRuns, 2)
+		require.Len(t, parsedResponse.Outputs, 1)
+		assert.Equal(t, "42", *parsedResponse.Outputs[0])
+	})
+
+	t.Run("stub for unknown task", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/"+fmt.Sprintf("%v", jobID)+"/simulate", strings.NewReader(`{"stubs": {"ds9": {"value": 1}}}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+}
+
+func TestPipelineRunsController_Replay_HappyPath(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+	url := fmt.Sprintf("/v2/jobs/%v/runs/%v/replay", jobID, runIDs[0])
+
+	t.Run("original spec", func(t *testing.T) {
+		response, cleanup := client.Post(url, nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunReplayResource
+		err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%v", runIDs[0]), parsedResponse.ID)
+		require.Len(t, parsedResponse.Tasks, 8)
+		for _, task := range parsedResponse.Tasks {
+			assert.Equal(t, pipeline.TaskReplayCached, task.Status, task.DotID)
+			assert.False(t, task.Changed, task.DotID)
+		}
+		assert.False(t, parsedResponse.OutputsChanged)
+	})
+
+	t.Run("edited spec", func(t *testing.T) {
+		request, err := json.Marshal(web.ReplayPipelineRunRequest{DotDagSource: `
+ds1          [type=memo value=<"{\"USD\": 1}">];
+ds1_parse    [type=jsonparse path="USD"];
+ds1_multiply [type=multiply times=5];
+
+ds2          [type=memo value=<"{\"USD\": 1}">];
+ds2_parse    [type=jsonparse path="USD"];
+ds2_multiply [type=multiply times=3];
+
+ds3          [type=fail msg="uh oh"];
+
+ds1 -> ds1_parse -> ds1_multiply -> answer;
+ds2 -> ds2_parse -> ds2_multiply -> answer;
+ds3 -> answer;
+
+answer [type=median index=0];
+`})
+		require.NoError(t, err)
+
+		response, cleanup := client.Post(url, bytes.NewReader(request))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunReplayResource
+		err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+		require.NoError(t, err)
+		assert.True(t, parsedResponse.OutputsChanged)
+		require.Len(t, parsedResponse.Replay.Outputs, 1)
+		assert.Equal(t, "4", *parsedResponse.Replay.Outputs[0])
+	})
+
+	t.Run("unknown run", func(t *testing.T) {
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/runs/%v/replay", jobID, runIDs[1]+100), nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+
+	t.Run("run of another job", func(t *testing.T) {
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/runs/%v/replay", jobID+1, runIDs[0]), nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusNotFound)
+	})
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ctx := testutils.Context(t)
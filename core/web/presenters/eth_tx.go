@@ -77,3 +77,30 @@ func NewEthTxResourceFromAttempt(txa txmgr.TxAttempt) EthTxResource {
 	}
 	return r
 }
+
+// NonceGapHealResource represents a nonce gap which was filled by sending a transaction to self.
+type NonceGapHealResource struct {
+	JAID
+	From       common.Address `json:"from"`
+	Nonce      string         `json:"nonce"`
+	Hash       common.Hash    `json:"hash"`
+	Fee        string         `json:"fee"`
+	EVMChainID big.Big        `json:"evmChainID"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (NonceGapHealResource) GetName() string {
+	return "evm_nonce_gap_heals"
+}
+
+// NewNonceGapHealResource generates a NonceGapHealResource from a txmgr.SequenceGapHeal.
+func NewNonceGapHealResource(heal txmgr.SequenceGapHeal, chainID *big.Big) NonceGapHealResource {
+	return NonceGapHealResource{
+		JAID:       NewPrefixedJAID(heal.TxHash.String(), chainID.String()),
+		From:       heal.FromAddress,
+		Nonce:      heal.Sequence.String(),
+		Hash:       heal.TxHash,
+		Fee:        heal.Fee.String(),
+		EVMChainID: *chainID,
+	}
+}
@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// EVMReorgResource is a JSONAPI resource for a reorg detected on an EVM chain.
+type EVMReorgResource struct {
+	JAID
+	EVMChainID           big.Big       `json:"evmChainId"`
+	Depth                int64         `json:"depth"`
+	CommonAncestorNumber int64         `json:"commonAncestorNumber"`
+	CommonAncestorHash   common.Hash   `json:"commonAncestorHash"`
+	OldHeadNumber        int64         `json:"oldHeadNumber"`
+	NewHeadNumber        int64         `json:"newHeadNumber"`
+	OldHashes            []common.Hash `json:"oldHashes"`
+	NewHashes            []common.Hash `json:"newHashes"`
+	DetectedAt           time.Time     `json:"detectedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMReorgResource) GetName() string {
+	return "evm_reorg"
+}
+
+// NewEVMReorgResource returns a new EVMReorgResource for reorg.
+func NewEVMReorgResource(reorg headtracker.ReorgRecord) EVMReorgResource {
+	return EVMReorgResource{
+		JAID:                 NewJAIDInt64(reorg.ID),
+		EVMChainID:           reorg.EVMChainID,
+		Depth:                reorg.Depth,
+		CommonAncestorNumber: reorg.CommonAncestorNumber,
+		CommonAncestorHash:   reorg.CommonAncestorHash,
+		OldHeadNumber:        reorg.OldHeadNumber(),
+		NewHeadNumber:        reorg.NewHeadNumber(),
+		OldHashes:            reorg.OldHashes,
+		NewHashes:            reorg.NewHashes,
+		DetectedAt:           reorg.DetectedAt,
+	}
+}
@@ -1,6 +1,7 @@
 package presenters
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -516,6 +517,7 @@ type JobResource struct {
 	SchemaVersion            uint32                    `json:"schemaVersion"`
 	GasLimit                 clnull.Uint32             `json:"gasLimit"`
 	ForwardingAllowed        bool                      `json:"forwardingAllowed"`
+	Paused                   bool                      `json:"paused"`
 	MaxTaskDuration          models.Interval           `json:"maxTaskDuration"`
 	ExternalJobID            uuid.UUID                 `json:"externalJobID"`
 	DirectRequestSpec        *DirectRequestSpec        `json:"directRequestSpec"`
@@ -547,6 +549,7 @@ func NewJobResource(j job.Job) *JobResource {
 		SchemaVersion:     j.SchemaVersion,
 		GasLimit:          j.GasLimit,
 		ForwardingAllowed: j.ForwardingAllowed,
+		Paused:            j.Paused,
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
@@ -602,3 +605,56 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobSpecVersionResource is a JSONAPI resource of a spec version of a job.
+type JobSpecVersionResource struct {
+	JAID
+	JobID     int32     `json:"jobId"`
+	Version   int32     `json:"version"`
+	Spec      string    `json:"spec"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecVersionResource) GetName() string {
+	return "job_spec_versions"
+}
+
+// NewJobSpecVersionResource returns a new JobSpecVersionResource.
+func NewJobSpecVersionResource(v job.SpecVersion) *JobSpecVersionResource {
+	return &JobSpecVersionResource{
+		JAID:      NewJAIDInt64(v.ID),
+		JobID:     v.JobID,
+		Version:   v.Version,
+		Spec:      v.Spec,
+		Author:    v.Author,
+		CreatedAt: v.CreatedAt,
+	}
+}
+
+// JobSpecVersionDiffResource is a JSONAPI resource of the diff between two spec versions of a job.
+type JobSpecVersionDiffResource struct {
+	JAID
+	JobID int32 `json:"jobId"`
+	// From is zero when comparing the first version to an empty spec.
+	From int32  `json:"from"`
+	To   int32  `json:"to"`
+	Diff string `json:"diff"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecVersionDiffResource) GetName() string {
+	return "job_spec_version_diffs"
+}
+
+// NewJobSpecVersionDiffResource returns a new JobSpecVersionDiffResource.
+func NewJobSpecVersionDiffResource(jobID, from, to int32, diff string) *JobSpecVersionDiffResource {
+	return &JobSpecVersionDiffResource{
+		JAID:  NewJAID(fmt.Sprintf("%d-%d-%d", jobID, from, to)),
+		JobID: jobID,
+		From:  from,
+		To:    to,
+		Diff:  diff,
+	}
+}
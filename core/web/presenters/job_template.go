@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+// JobTemplateJobResource is a job rendered from a job template.
+type JobTemplateJobResource struct {
+	JobID  int32          `json:"jobId"`
+	Params job.JSONConfig `json:"params"`
+	Paused bool           `json:"paused"`
+}
+
+// JobTemplateResource is a job template JSONAPI resource.
+type JobTemplateResource struct {
+	JAID
+	Name      string                   `json:"name"`
+	Template  string                   `json:"template"`
+	Jobs      []JobTemplateJobResource `json:"jobs"`
+	CreatedAt time.Time                `json:"createdAt"`
+	UpdatedAt time.Time                `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobTemplateResource) GetName() string {
+	return "job_templates"
+}
+
+// NewJobTemplateResource returns a new JobTemplateResource for a template and its jobs.
+func NewJobTemplateResource(jt job.JobTemplate, jobs []job.TemplateJob) *JobTemplateResource {
+	resource := &JobTemplateResource{
+		JAID:      NewJAIDInt32(jt.ID),
+		Name:      jt.Name,
+		Template:  jt.Template,
+		Jobs:      []JobTemplateJobResource{},
+		CreatedAt: jt.CreatedAt,
+		UpdatedAt: jt.UpdatedAt,
+	}
+	for _, tj := range jobs {
+		resource.Jobs = append(resource.Jobs, JobTemplateJobResource{JobID: tj.JobID, Params: tj.Params, Paused: tj.Paused})
+	}
+	return resource
+}
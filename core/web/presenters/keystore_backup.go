@@ -0,0 +1,12 @@
+package presenters
+
+import (
+	"encoding/json"
+)
+
+// KeystoreBackupShares is a keystore backup along with the secret shares it was encrypted with, each encrypted with the
+// password of its holder.
+type KeystoreBackupShares struct {
+	Backup json.RawMessage   `json:"backup"`
+	Shares []json.RawMessage `json:"shares"`
+}
@@ -67,6 +67,8 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	// AttemptErrors is only set for retried tasks, with one entry per attempt
+	AttemptErrors []*string `json:"attemptErrors,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -85,13 +87,23 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 	if tr.Error.Valid {
 		errString = &tr.Error.String
 	}
+	var attemptErrors []*string
+	for _, err := range tr.AttemptErrors {
+		if err.Valid {
+			s := err.String
+			attemptErrors = append(attemptErrors, &s)
+		} else {
+			attemptErrors = append(attemptErrors, nil)
+		}
+	}
 	return PipelineTaskRunResource{
-		Type:       tr.Type,
-		CreatedAt:  tr.CreatedAt,
-		FinishedAt: tr.FinishedAt,
-		Output:     output,
-		Error:      errString,
-		DotID:      tr.GetDotID(),
+		Type:          tr.Type,
+		CreatedAt:     tr.CreatedAt,
+		FinishedAt:    tr.FinishedAt,
+		Output:        output,
+		Error:         errString,
+		DotID:         tr.GetDotID(),
+		AttemptErrors: attemptErrors,
 	}
 }
 
@@ -104,3 +116,27 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineRunReplayResource compares a replayed pipeline run to the original run.
+type PipelineRunReplayResource struct {
+	JAID
+	Original       PipelineRunResource    `json:"original"`
+	Replay         PipelineRunResource    `json:"replay"`
+	Tasks          []pipeline.TaskRunDiff `json:"tasks"`
+	OutputsChanged bool                   `json:"outputsChanged"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunReplayResource) GetName() string {
+	return "pipelineRunReplay"
+}
+
+func NewPipelineRunReplayResource(replay pipeline.RunReplay, lggr logger.Logger) PipelineRunReplayResource {
+	return PipelineRunReplayResource{
+		JAID:           NewJAIDInt64(replay.Original.ID),
+		Original:       NewPipelineRunResource(*replay.Original, lggr),
+		Replay:         NewPipelineRunResource(*replay.Replay, lggr),
+		Tasks:          replay.Tasks,
+		OutputsChanged: replay.OutputsChanged,
+	}
+}
@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// UserAPITokenResource represents a named API token JSONAPI resource.
+type UserAPITokenResource struct {
+	JAID
+	Name       string            `json:"name"`
+	Role       sessions.UserRole `json:"role"`
+	AccessKey  string            `json:"accessKey"`
+	AllowedIPs []string          `json:"allowedIPs"`
+	ExpiresAt  time.Time         `json:"expiresAt"`
+	LastUsed   *time.Time        `json:"lastUsed"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	// Secret is only returned when the token is created.
+	Secret string `json:"secret,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UserAPITokenResource) GetName() string {
+	return "userAPITokens"
+}
+
+// NewUserAPITokenResource constructs a new UserAPITokenResource.
+//
+// Token names are unique per user, so the name is used as the ID.
+func NewUserAPITokenResource(t sessions.APIToken) *UserAPITokenResource {
+	return &UserAPITokenResource{
+		JAID:       NewJAID(t.Name),
+		Name:       t.Name,
+		Role:       t.Role,
+		AccessKey:  t.TokenKey,
+		AllowedIPs: t.AllowedIPs,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsed:   t.LastUsed.Ptr(),
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+// NewCreatedUserAPITokenResource constructs a new UserAPITokenResource for a token that was just created, including
+// its secret.
+func NewCreatedUserAPITokenResource(t sessions.APIToken, token *auth.Token) *UserAPITokenResource {
+	r := NewUserAPITokenResource(t)
+	r.Secret = token.Secret
+	return r
+}
+
+// NewUserAPITokenResources constructs UserAPITokenResources for tokens.
+func NewUserAPITokenResources(tokens []sessions.APIToken) []UserAPITokenResource {
+	rs := []UserAPITokenResource{}
+	for _, t := range tokens {
+		rs = append(rs, *NewUserAPITokenResource(t))
+	}
+	return rs
+}
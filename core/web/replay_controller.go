@@ -3,6 +3,7 @@ package web
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
@@ -69,6 +70,46 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	jsonAPIResponse(c, &response, "response")
 }
 
+// ReplayProgress returns the progress of the replay in progress, if any
+// Example:
+//
+//	"<application>/v2/replay_progress"
+func (bdc *ReplayController) ReplayProgress(c *gin.Context) {
+	chain, err := getChain(bdc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	chainID := chain.ID()
+
+	progress, err := bdc.App.ReplayProgress(c.Request.Context(), chainID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := ReplayProgressResponse{
+		EVMChainID: big.New(chainID),
+	}
+	if progress != nil {
+		response.InProgress = true
+		response.FromBlock = progress.FromBlock
+		response.ToBlock = progress.ToBlock
+		response.CompletedBlock = progress.CompletedBlock
+		response.RemainingBlocks = progress.RemainingBlocks()
+		response.StartedAt = &progress.CreatedAt
+		response.UpdatedAt = &progress.UpdatedAt
+		if eta, ok := progress.ETA(); ok {
+			response.ETA = eta.Round(time.Second).String()
+		}
+	}
+	jsonAPIResponse(c, &response, "response")
+}
+
 type ReplayResponse struct {
 	Message    string   `json:"message"`
 	EVMChainID *big.Big `json:"evmChainID"`
@@ -89,3 +130,31 @@ func (ReplayResponse) GetName() string {
 func (*ReplayResponse) SetID(string) error {
 	return nil
 }
+
+type ReplayProgressResponse struct {
+	EVMChainID      *big.Big   `json:"evmChainID"`
+	InProgress      bool       `json:"inProgress"`
+	FromBlock       int64      `json:"fromBlock"`
+	ToBlock         int64      `json:"toBlock"`
+	CompletedBlock  int64      `json:"completedBlock"`
+	RemainingBlocks int64      `json:"remainingBlocks"`
+	StartedAt       *time.Time `json:"startedAt"`
+	UpdatedAt       *time.Time `json:"updatedAt"`
+	ETA             string     `json:"eta"`
+}
+
+// GetID returns the jsonapi ID.
+func (s ReplayProgressResponse) GetID() string {
+	return "replayProgressID"
+}
+
+// GetName returns the collection name for jsonapi.
+func (ReplayProgressResponse) GetName() string {
+	return "replay_progress"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (*ReplayProgressResponse) SetID(string) error {
+	return nil
+}
@@ -0,0 +1,103 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+// EVMReorgResolver resolves the EVMReorg type.
+type EVMReorgResolver struct {
+	reorg headtracker.ReorgRecord
+}
+
+func NewEVMReorg(reorg headtracker.ReorgRecord) *EVMReorgResolver {
+	return &EVMReorgResolver{reorg: reorg}
+}
+
+func NewEVMReorgs(reorgs []headtracker.ReorgRecord) []*EVMReorgResolver {
+	var resolvers []*EVMReorgResolver
+	for _, r := range reorgs {
+		resolvers = append(resolvers, NewEVMReorg(r))
+	}
+
+	return resolvers
+}
+
+// ID resolves the reorg's unique identifier.
+func (r *EVMReorgResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt64(r.reorg.ID))
+}
+
+// EVMChainID resolves the id of the chain the reorg was detected on.
+func (r *EVMReorgResolver) EVMChainID() graphql.ID {
+	return graphql.ID(r.reorg.EVMChainID.String())
+}
+
+// Depth resolves the number of blocks that were replaced.
+func (r *EVMReorgResolver) Depth() int32 {
+	return int32(r.reorg.Depth)
+}
+
+// CommonAncestorNumber resolves the block number of the common ancestor of the old and the new chain.
+func (r *EVMReorgResolver) CommonAncestorNumber() string {
+	return stringutils.FromInt64(r.reorg.CommonAncestorNumber)
+}
+
+// CommonAncestorHash resolves the block hash of the common ancestor of the old and the new chain.
+func (r *EVMReorgResolver) CommonAncestorHash() string {
+	return r.reorg.CommonAncestorHash.Hex()
+}
+
+// OldHeadNumber resolves the block number of the head of the old chain.
+func (r *EVMReorgResolver) OldHeadNumber() string {
+	return stringutils.FromInt64(r.reorg.OldHeadNumber())
+}
+
+// NewHeadNumber resolves the block number of the head of the new chain.
+func (r *EVMReorgResolver) NewHeadNumber() string {
+	return stringutils.FromInt64(r.reorg.NewHeadNumber())
+}
+
+// OldHashes resolves the hashes of the replaced blocks, from the old head down.
+func (r *EVMReorgResolver) OldHashes() []string {
+	hashes := make([]string, len(r.reorg.OldHashes))
+	for i, h := range r.reorg.OldHashes {
+		hashes[i] = h.Hex()
+	}
+	return hashes
+}
+
+// NewHashes resolves the hashes of the blocks that replaced them, from the new head down.
+func (r *EVMReorgResolver) NewHashes() []string {
+	hashes := make([]string, len(r.reorg.NewHashes))
+	for i, h := range r.reorg.NewHashes {
+		hashes[i] = h.Hex()
+	}
+	return hashes
+}
+
+// DetectedAt resolves the time the reorg was detected.
+func (r *EVMReorgResolver) DetectedAt() graphql.Time {
+	return graphql.Time{Time: r.reorg.DetectedAt}
+}
+
+// -- EVMReorgs Query --
+
+type EVMReorgsPayloadResolver struct {
+	reorgs []headtracker.ReorgRecord
+	total  int32
+}
+
+func NewEVMReorgsPayload(reorgs []headtracker.ReorgRecord, total int32) *EVMReorgsPayloadResolver {
+	return &EVMReorgsPayloadResolver{reorgs: reorgs, total: total}
+}
+
+func (r *EVMReorgsPayloadResolver) Results() []*EVMReorgResolver {
+	return NewEVMReorgs(r.reorgs)
+}
+
+func (r *EVMReorgsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
@@ -0,0 +1,96 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	httypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+func TestResolver_EVMReorgs(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetEVMReorgs($chainID: ID!) {
+			evmReorgs(chainID: $chainID, limit: 1) {
+				results {
+					evmChainID
+					depth
+					commonAncestorNumber
+					commonAncestorHash
+					oldHeadNumber
+					newHeadNumber
+					oldHashes
+					newHashes
+					detectedAt
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	chainID := testutils.NewRandomEVMChainID()
+	variables := map[string]interface{}{"chainID": chainID.String()}
+	detectedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	ancestor, old, replacing := common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "evmReorgs"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				db := pgtest.NewSqlxDB(f.t)
+				orm := headtracker.NewReorgORM(*chainID, db)
+				require.NoError(f.t, orm.SaveReorg(ctx, httypes.Reorg{
+					Depth:                1,
+					CommonAncestorNumber: 41,
+					CommonAncestorHash:   common.HexToHash("0x04"),
+					OldHashes:            []common.Hash{common.HexToHash("0x05")},
+					NewHashes:            []common.Hash{common.HexToHash("0x06")},
+					DetectedAt:           detectedAt.Add(-time.Hour),
+				}))
+				require.NoError(f.t, orm.SaveReorg(ctx, httypes.Reorg{
+					Depth:                1,
+					CommonAncestorNumber: 99,
+					CommonAncestorHash:   ancestor,
+					OldHashes:            []common.Hash{old},
+					NewHashes:            []common.Hash{replacing, replacing},
+					DetectedAt:           detectedAt,
+				}))
+				f.App.On("GetDB").Return(db)
+			},
+			query:     query,
+			variables: variables,
+			result: fmt.Sprintf(`
+				{
+					"evmReorgs": {
+						"results": [{
+							"evmChainID": "%s",
+							"depth": 1,
+							"commonAncestorNumber": "99",
+							"commonAncestorHash": "%s",
+							"oldHeadNumber": "100",
+							"newHeadNumber": "101",
+							"oldHashes": ["%s"],
+							"newHashes": ["%s", "%s"],
+							"detectedAt": "2024-05-01T12:00:00Z"
+						}],
+						"metadata": {
+							"total": 2
+						}
+					}
+				}`, chainID, ancestor.Hex(), old.Hex(), replacing.Hex(), replacing.Hex()),
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
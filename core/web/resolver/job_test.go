@@ -329,6 +329,7 @@ func TestResolver_CreateJob(t *testing.T) {
 	}
 	jb, err := directrequest.ValidatedDirectRequestSpec(spec)
 	assert.NoError(t, err)
+	jb.SpecVersion = &job.SpecVersion{Spec: spec, Author: "gqltester@chain.link"}
 
 	d, err := json.Marshal(map[string]interface{}{
 		"createJob": map[string]interface{}{
@@ -563,3 +564,130 @@ func TestResolver_DeleteJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_PauseResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	pauseMutation := `
+		mutation PauseJob($id: ID!) {
+			pauseJob(id: $id) {
+				... on PauseJobSuccess {
+					job {
+						id
+						paused
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	resumeMutation := `
+		mutation ResumeJob($id: ID!) {
+			resumeJob(id: $id) {
+				... on ResumeJobSuccess {
+					job {
+						id
+						paused
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: pauseMutation, variables: variables}, "pauseJob"),
+		unauthorizedTestCase(GQLTestCase{query: resumeMutation, variables: variables}, "resumeJob"),
+		{
+			name:          "pause success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("PauseJob", mock.Anything, id).Return(nil)
+			},
+			query:     pauseMutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"job": {
+							"id": "123",
+							"paused": true
+						}
+					}
+				}
+			`,
+		},
+		{
+			name:          "pause not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     pauseMutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}
+			`,
+		},
+		{
+			name:          "generic error on PauseJob()",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("PauseJob", mock.Anything, id).Return(gError)
+			},
+			query:     pauseMutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"pauseJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+		{
+			name:          "resume success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", mock.Anything, id).Return(job.Job{ID: id, Paused: true}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("ResumeJob", mock.Anything, id).Return(nil)
+			},
+			query:     resumeMutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"job": {
+							"id": "123",
+							"paused": false
+						}
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
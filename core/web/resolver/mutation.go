@@ -1079,6 +1079,11 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	jb.SpecVersion = &job.SpecVersion{Spec: args.Input.TOML}
+	if session, ok := webauth.GetGQLAuthenticatedSession(ctx); ok {
+		jb.SpecVersion.Author = session.User.Email
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
@@ -1127,6 +1132,66 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	if err = r.App.PauseJob(ctx, id); err != nil {
+		return nil, err
+	}
+	j.Paused = true
+
+	r.App.GetAuditLogger().Audit(audit.JobPaused, map[string]interface{}{"id": args.ID})
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	if err = r.App.ResumeJob(ctx, id); err != nil {
+		return nil, err
+	}
+	j.Paused = false
+
+	r.App.GetAuditLogger().Audit(audit.JobResumed, map[string]interface{}{"id": args.ID})
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -13,6 +14,7 @@ import (
 	commonTypes "github.com/smartcontractkit/chainlink/v2/common/types"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
@@ -546,6 +548,32 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	return NewEthTransactionsAttemptsPayload(attempts, int32(count)), nil
 }
 
+// EVMReorgs retrieves a paginated list of the reorgs detected on an EVM chain, most recent first.
+func (r *Resolver) EVMReorgs(ctx context.Context, args struct {
+	ChainID graphql.ID
+	Offset  *int32
+	Limit   *int32
+}) (*EVMReorgsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	chainID, ok := new(big.Int).SetString(string(args.ChainID), 10)
+	if !ok {
+		return nil, errors.Errorf("invalid chain id: %q", args.ChainID)
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	reorgs, count, err := headtracker.NewReorgORM(*chainID, r.App.GetDB()).FindReorgs(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEVMReorgsPayload(reorgs, int32(count)), nil
+}
+
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
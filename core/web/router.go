@@ -251,6 +251,11 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		utc := UserAPITokensController{app}
+		authv2.GET("/user/tokens", utc.Index)
+		authv2.POST("/user/tokens", utc.Create)
+		authv2.DELETE("/user/tokens/:name", utc.Delete)
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
@@ -286,11 +291,13 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/heal", auth.RequiresAdminRole(txs.Heal))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
+		authv2.GET("/replay_progress", rc.ReplayProgress)
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
 
@@ -300,6 +307,10 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
 		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
 
+		kbc := KeystoreBackupController{app}
+		authv2.POST("/keys/backup/export", auth.RequiresAdminRole(kbc.Export))
+		authv2.POST("/keys/backup/export-shares", auth.RequiresAdminRole(kbc.ExportShares))
+
 		ekc := NewETHKeysController(app)
 		authv2.GET("/keys/eth", ekc.Index)
 		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
@@ -372,11 +383,28 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs/:ID/pause", auth.RequiresEditRole(jc.Pause))
+		authv2.POST("/jobs/:ID/resume", auth.RequiresEditRole(jc.Resume))
+
+		jvc := JobVersionsController{app}
+		authv2.GET("/jobs/:ID/versions", jvc.Index)
+		authv2.GET("/jobs/:ID/versions/diff", jvc.Diff)
+		authv2.POST("/jobs/:ID/rollback", auth.RequiresEditRole(jvc.Rollback))
+
+		jtc := JobTemplatesController{app}
+		authv2.GET("/job_templates", jtc.Index)
+		authv2.GET("/job_templates/:ID", jtc.Show)
+		authv2.POST("/job_templates", auth.RequiresEditRole(jtc.Create))
+		authv2.PUT("/job_templates/:ID", auth.RequiresEditRole(jtc.Update))
+		authv2.DELETE("/job_templates/:ID", auth.RequiresEditRole(jtc.Delete))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/jobs/:ID/runs/:runID/replay", auth.RequiresRunRole(prc.Replay))
+		authv2.POST("/jobs/:ID/simulate", auth.RequiresRunRole(prc.Simulate))
+		authv2.POST("/pipeline/simulate", auth.RequiresRunRole(prc.Simulate))
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -421,6 +449,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			chains.GET(chain.path+"/:ID/nodes", paginatedRequest(chain.nc.Index))
 		}
 
+		erc := EVMReorgsController{app}
+		chains.GET("evm/:ID/reorgs", paginatedRequest(erc.Index))
+
 		efc := EVMForwardersController{app}
 		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
 		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
@@ -0,0 +1,143 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsession "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// UserAPITokensController manages the named API tokens of the current Session's User.
+type UserAPITokensController struct {
+	App chainlink.Application
+}
+
+// Index lists the named API tokens of the current user.
+// Example:
+// "GET <application>/user/tokens"
+func (utc *UserAPITokensController) Index(c *gin.Context) {
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	tokens, err := utc.App.AuthenticationProvider().ListAPITokens(c.Request.Context(), sessionUser.Email)
+	if err != nil {
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUserAPITokenResources(tokens), "userAPIToken")
+}
+
+// Create creates a named API token for the current user, with a role no higher than the role of the current session.
+// The secret of the token is only returned in this response.
+// Example:
+// "POST <application>/user/tokens"
+func (utc *UserAPITokensController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request clsession.CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	// In order to create an API token, login validation with provided password must succeed
+	if err := utc.App.AuthenticationProvider().TestPassword(ctx, sessionUser.Email, request.Password); err != nil {
+		utc.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": sessionUser.Email})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	apiToken, token, err := clsession.NewAPIToken(*sessionUser, request, time.Now())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err = utc.App.AuthenticationProvider().CreateAPIToken(ctx, &apiToken); err != nil {
+		switch {
+		case errors.Is(err, clsession.ErrNotSupported):
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+		case errors.Is(err, clsession.ErrAPITokenExists):
+			jsonAPIError(c, http.StatusConflict, err)
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	utc.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{
+		"user":       sessionUser.Email,
+		"name":       apiToken.Name,
+		"role":       apiToken.Role,
+		"allowedIPs": apiToken.AllowedIPs,
+		"expiresAt":  apiToken.ExpiresAt,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewCreatedUserAPITokenResource(apiToken, token), "userAPIToken", http.StatusCreated)
+}
+
+// Delete revokes a named API token of the current user, with a role no higher than the role of the current session, so
+// that a named API token cannot revoke the tokens of a higher role.
+// Example:
+// "DELETE <application>/user/tokens/:name"
+func (utc *UserAPITokensController) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+
+	tokens, err := utc.App.AuthenticationProvider().ListAPITokens(ctx, sessionUser.Email)
+	if err != nil {
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	for _, token := range tokens {
+		if token.Name == c.Param("name") && !sessionUser.Role.IsAtLeast(token.Role) {
+			jsonAPIError(c, http.StatusForbidden, errors.Errorf("API token role %s is higher than the session's role %s", token.Role, sessionUser.Role))
+			return
+		}
+	}
+
+	apiToken, err := utc.App.AuthenticationProvider().RevokeAPIToken(ctx, sessionUser.Email, c.Param("name"))
+	if err != nil {
+		switch {
+		case errors.Is(err, clsession.ErrNotSupported):
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+		case errors.Is(err, sql.ErrNoRows):
+			jsonAPIError(c, http.StatusNotFound, errors.New("API token not found"))
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	utc.App.GetAuditLogger().Audit(audit.APITokenDeleted, map[string]interface{}{
+		"user": sessionUser.Email,
+		"name": apiToken.Name,
+	})
+	jsonAPIResponse(c, presenters.NewUserAPITokenResource(apiToken), "userAPIToken")
+}
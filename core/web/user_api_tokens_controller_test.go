@@ -0,0 +1,117 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestUserAPITokensController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	createToken := func(t *testing.T, request sessions.CreateAPITokenRequest) *http.Response {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+	request := sessions.CreateAPITokenRequest{
+		Name:      "monitoring",
+		Role:      sessions.UserRoleView,
+		ExpiresAt: time.Now().Add(time.Hour),
+		Password:  cltest.Password,
+	}
+
+	var created presenters.UserAPITokenResource
+	resp := createToken(t, request)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, "monitoring", created.Name)
+	assert.Equal(t, sessions.UserRoleView, created.Role)
+	assert.NotEmpty(t, created.AccessKey)
+	assert.NotEmpty(t, created.Secret)
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		assert.Equal(t, http.StatusConflict, createToken(t, request).StatusCode)
+
+		wrongPassword := request
+		wrongPassword.Name, wrongPassword.Password = "other", "wrong-password"
+		assert.Equal(t, http.StatusUnauthorized, createToken(t, wrongPassword).StatusCode)
+
+		expired := request
+		expired.Name, expired.ExpiresAt = "other", time.Now().Add(-time.Hour)
+		assert.Equal(t, http.StatusUnprocessableEntity, createToken(t, expired).StatusCode)
+	})
+
+	headers := map[string]string{
+		webauth.APIKey:    created.AccessKey,
+		webauth.APISecret: created.Secret,
+	}
+
+	t.Run("authenticates with the role of the token", func(t *testing.T) {
+		resp, cleanup := cltest.UnauthenticatedGet(t, app.Server.URL+"/v2/user/tokens", headers)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		resp, cleanup = cltest.UnauthenticatedPost(t, app.Server.URL+"/v2/keys/csa", nil, headers)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+	})
+
+	t.Run("lists tokens with their last use", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/user/tokens")
+		defer cleanup()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var tokens []presenters.UserAPITokenResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tokens))
+		require.Len(t, tokens, 1)
+		assert.Equal(t, "monitoring", tokens[0].Name)
+		assert.NotNil(t, tokens[0].LastUsed)
+		assert.Empty(t, tokens[0].Secret)
+	})
+
+	t.Run("refuses to revoke tokens of a higher role", func(t *testing.T) {
+		admin := request
+		admin.Name, admin.Role = "deployer", sessions.UserRoleAdmin
+		require.Equal(t, http.StatusCreated, createToken(t, admin).StatusCode)
+
+		resp, cleanup := cltest.UnauthenticatedDelete(t, app.Server.URL+"/v2/user/tokens/deployer", headers)
+		defer cleanup()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp, cleanup = client.Delete("/v2/user/tokens/deployer")
+		defer cleanup()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("revokes tokens", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/user/tokens/monitoring")
+		defer cleanup()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, cleanup = cltest.UnauthenticatedGet(t, app.Server.URL+"/v2/user/tokens", headers)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+
+		resp, cleanup = client.Delete("/v2/user/tokens/monitoring")
+		defer cleanup()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}